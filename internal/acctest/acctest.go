@@ -272,6 +272,10 @@ func ProtoV5FactoriesMultipleRegions(ctx context.Context, t *testing.T, n int) m
 func PreCheck(ctx context.Context, t *testing.T) {
 	t.Helper()
 
+	if isVCREnabled() {
+		vcrPreCheck(t)
+	}
+
 	// Since we are outside the scope of the Terraform configuration we must
 	// call Configure() to properly initialize the provider configuration.
 	testAccProviderConfigure.Do(func() {
@@ -291,7 +295,12 @@ func PreCheck(ctx context.Context, t *testing.T) {
 		region := Region()
 		os.Setenv(envvar.DefaultRegion, region)
 
-		diags := Provider.Configure(ctx, terraformsdk.NewResourceConfigRaw(nil))
+		var diags diag.Diagnostics
+		if isVCREnabled() {
+			diags = vcrConfigureProvider(ctx, Provider)
+		} else {
+			diags = Provider.Configure(ctx, terraformsdk.NewResourceConfigRaw(nil))
+		}
 		if err := sdkdiag.DiagnosticsError(diags); err != nil {
 			t.Fatalf("configuring provider: %s", err)
 		}
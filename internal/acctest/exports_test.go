@@ -3,7 +3,18 @@
 
 package acctest
 
+import (
+	"net/http"
+)
+
 // Exports for use in tests only.
 var (
-	CloseVCRRecorder = closeVCRRecorder
+	CloseVCRRecorder     = closeVCRRecorder
+	VCRHTTPClient        = vcrHTTPClient
+	VCRMatcher           = vcrMatcher
+	VCRRedactInteraction = vcrRedactInteraction
 )
+
+func StopVCRHTTPClient(httpClient *http.Client) error {
+	return httpClient.Transport.(*vcrTransport).recorder.Stop()
+}
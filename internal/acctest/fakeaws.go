@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+
+	terraformsdk "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/fakeaws"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// Static credentials accepted by the local AWS API stand-in.
+	fakeAWSAccessKeyID     = "AKIAFAKEAWSEXAMPLE00"
+	fakeAWSSecretAccessKey = "fakeaws/secret/access/key/EXAMPLEKEY00"
+)
+
+// FakeAWSTest runs a test case offline, against a local, in-memory AWS API stand-in (see package fakeaws),
+// instead of against AWS. Neither TF_ACC nor AWS credentials are required.
+//
+// Each step's configuration is prefixed with a provider configuration that sends requests for the stand-in's
+// services to it, and ProviderMeta returns a client configured in the same way for use in Check functions.
+// After the test case's own CheckDestroy, if any, the stand-in is checked to hold no remaining resources.
+//
+// Only resources whose create, read, update and delete use the operations implemented by the stand-in can be tested.
+//
+// The test is skipped if no Terraform CLI is found, rather than downloading one: set TF_ACC_TERRAFORM_PATH or
+// add terraform to PATH to run it.
+func FakeAWSTest(ctx context.Context, t *testing.T, c resource.TestCase) {
+	t.Helper()
+
+	if !terraformCLIFound() {
+		t.Skip("Terraform CLI not found, set TF_ACC_TERRAFORM_PATH or add terraform to PATH to run offline tests")
+	}
+
+	server := fakeaws.New(t)
+
+	registerFakeAWSProviderMeta(ctx, t, server)
+
+	if c.ProtoV5ProviderFactories == nil {
+		c.ProtoV5ProviderFactories = ProtoV5ProviderFactories
+	}
+
+	providerConfig := ConfigFakeAWSProvider(server)
+	for i, step := range c.Steps {
+		if step.Config != "" {
+			c.Steps[i].Config = ConfigCompose(providerConfig, step.Config)
+		}
+	}
+
+	if c.CheckDestroy == nil {
+		c.CheckDestroy = checkFakeAWSDestroy(server)
+	} else {
+		c.CheckDestroy = resource.ComposeTestCheckFunc(c.CheckDestroy, checkFakeAWSDestroy(server))
+	}
+
+	resource.UnitTest(t, c)
+}
+
+// terraformCLIFound returns whether a Terraform CLI is available without downloading one.
+func terraformCLIFound() bool {
+	if v := os.Getenv("TF_ACC_TERRAFORM_PATH"); v != "" {
+		_, err := os.Stat(v)
+		return err == nil
+	}
+
+	_, err := exec.LookPath("terraform")
+
+	return err == nil
+}
+
+// ConfigFakeAWSProvider returns a provider configuration that sends requests for the services implemented by
+// the local AWS API stand-in to the specified server.
+func ConfigFakeAWSProvider(server *fakeaws.Server) string {
+	var endpoints strings.Builder
+
+	for _, service := range fakeaws.Services {
+		fmt.Fprintf(&endpoints, "    %[1]s = %[2]q\n", service, server.URL())
+	}
+
+	//lintignore:AT004
+	return fmt.Sprintf(`
+provider %[1]q {
+  access_key                  = %[2]q
+  secret_key                  = %[3]q
+  region                      = %[4]q
+  s3_use_path_style           = true
+  skip_metadata_api_check     = true
+  skip_region_validation      = true
+
+  endpoints {
+%[5]s  }
+}
+`, ProviderName, fakeAWSAccessKeyID, fakeAWSSecretAccessKey, names.USWest2RegionID, endpoints.String())
+}
+
+// registerFakeAWSProviderMeta configures a provider in the same way as ConfigFakeAWSProvider and registers its
+// client as the test's provider meta.
+func registerFakeAWSProviderMeta(ctx context.Context, t *testing.T, server *fakeaws.Server) {
+	t.Helper()
+
+	endpoints := make(map[string]any, len(fakeaws.Services))
+	for _, service := range fakeaws.Services {
+		endpoints[service] = server.URL()
+	}
+
+	p, err := provider.New(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	config := map[string]any{
+		"access_key":              fakeAWSAccessKeyID,
+		"endpoints":               []any{endpoints},
+		"region":                  names.USWest2RegionID,
+		"s3_use_path_style":       true,
+		"secret_key":              fakeAWSSecretAccessKey,
+		"skip_metadata_api_check": "true",
+		"skip_region_validation":  true,
+	}
+
+	if err := sdkdiag.DiagnosticsError(p.Configure(ctx, terraformsdk.NewResourceConfigRaw(config))); err != nil {
+		t.Fatalf("configuring provider: %s", err)
+	}
+
+	providerMetas.Lock()
+	providerMetas[t.Name()] = p.Meta().(*conns.AWSClient)
+	providerMetas.Unlock()
+
+	t.Cleanup(func() {
+		providerMetas.Lock()
+		delete(providerMetas, t.Name())
+		providerMetas.Unlock()
+	})
+}
+
+func checkFakeAWSDestroy(server *fakeaws.Server) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		if arns := server.Resources(); len(arns) > 0 {
+			return fmt.Errorf("resources remain after destroy: %s", strings.Join(arns, ", "))
+		}
+
+		return nil
+	}
+}
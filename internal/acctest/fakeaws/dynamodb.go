@@ -0,0 +1,594 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeaws
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// dynamoDBService implements Amazon DynamoDB tables.
+// Tables and indexes become active, and are deleted, immediately.
+type dynamoDBService struct {
+	mu     sync.Mutex
+	tables map[string]*dynamoDBTable // Keyed by Region and name.
+}
+
+type dynamoDBTable struct {
+	arn                       string
+	attributeDefinitions      []any
+	billingMode               string
+	created                   time.Time
+	deletionProtectionEnabled bool
+	globalSecondaryIndexes    []map[string]any
+	keySchema                 []any
+	localSecondaryIndexes     []map[string]any
+	name                      string
+	pointInTimeRecovery       bool
+	provisionedThroughput     map[string]any
+	sse                       *dynamoDBSSESpecification
+	stream                    *dynamoDBStreamSpecification
+	streamLabel               string
+	tableClass                string
+	tags                      tags
+	ttlAttributeName          string
+}
+
+type dynamoDBSSESpecification struct {
+	Enabled        bool
+	KMSMasterKeyId string
+	SSEType        string
+}
+
+type dynamoDBStreamSpecification struct {
+	StreamEnabled  bool
+	StreamViewType string
+}
+
+func newDynamoDBService() *dynamoDBService {
+	return &dynamoDBService{
+		tables: make(map[string]*dynamoDBTable),
+	}
+}
+
+func (s *dynamoDBService) handlers() map[string]jsonHandler {
+	return map[string]jsonHandler{
+		"CreateTable":               jsonOperation(s.createTable),
+		"DeleteTable":               jsonOperation(s.deleteTable),
+		"DescribeContinuousBackups": jsonOperation(s.describeContinuousBackups),
+		"DescribeTable":             jsonOperation(s.describeTable),
+		"DescribeTimeToLive":        jsonOperation(s.describeTimeToLive),
+		"ListTables":                jsonOperation(s.listTables),
+		"ListTagsOfResource":        jsonOperation(s.listTagsOfResource),
+		"TagResource":               jsonOperation(s.tagResource),
+		"UntagResource":             jsonOperation(s.untagResource),
+		"UpdateContinuousBackups":   jsonOperation(s.updateContinuousBackups),
+		"UpdateTable":               jsonOperation(s.updateTable),
+		"UpdateTimeToLive":          jsonOperation(s.updateTimeToLive),
+	}
+}
+
+func dynamoDBResourceNotFound(format string, a ...any) *apiError {
+	return newError(http.StatusBadRequest, "com.amazonaws.dynamodb.v20120810#ResourceNotFoundException", format, a...)
+}
+
+func (s *dynamoDBService) resources() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var arns []string
+	for _, t := range s.tables {
+		arns = append(arns, t.arn)
+	}
+
+	return arns
+}
+
+// table returns the named table. The caller must hold the lock.
+func (s *dynamoDBService) table(c *call, name string) (*dynamoDBTable, *apiError) {
+	t, ok := s.tables[c.region+"/"+name]
+	if !ok {
+		return nil, dynamoDBResourceNotFound("Requested resource not found: Table: %s not found", name)
+	}
+
+	return t, nil
+}
+
+// tableByARN returns the table with the specified ARN. The caller must hold the lock.
+func (s *dynamoDBService) tableByARN(c *call, arn string) (*dynamoDBTable, *apiError) {
+	_, name, _ := strings.Cut(arn, ":table/")
+
+	t, err := s.table(c, name)
+	if err != nil || t.arn != arn {
+		return nil, dynamoDBResourceNotFound("Requested resource not found: ResourcArn: %s not found", arn)
+	}
+
+	return t, nil
+}
+
+func (t *dynamoDBTable) setProvisionedThroughput(v map[string]any) {
+	t.provisionedThroughput = map[string]any{
+		"NumberOfDecreasesToday": 0,
+		"ReadCapacityUnits":      0,
+		"WriteCapacityUnits":     0,
+	}
+	if t.billingMode == "PROVISIONED" {
+		for _, k := range []string{"ReadCapacityUnits", "WriteCapacityUnits"} {
+			if v, ok := v[k]; ok {
+				t.provisionedThroughput[k] = v
+			}
+		}
+	}
+}
+
+func (t *dynamoDBTable) setStream(v *dynamoDBStreamSpecification) {
+	if v == nil || !v.StreamEnabled {
+		t.stream = nil
+		return
+	}
+
+	t.stream = v
+	t.streamLabel = time.Now().UTC().Format("2006-01-02T15:04:05.000")
+}
+
+// description returns the table's TableDescription.
+func (t *dynamoDBTable) description(status string) map[string]any {
+	v := map[string]any{
+		"AttributeDefinitions":      t.attributeDefinitions,
+		"BillingModeSummary":        map[string]any{"BillingMode": t.billingMode, "LastUpdateToPayPerRequestDateTime": epochSeconds(t.created)},
+		"CreationDateTime":          epochSeconds(t.created),
+		"DeletionProtectionEnabled": t.deletionProtectionEnabled,
+		"ItemCount":                 0,
+		"KeySchema":                 t.keySchema,
+		"ProvisionedThroughput":     t.provisionedThroughput,
+		"TableArn":                  t.arn,
+		"TableId":                   "00000000-0000-0000-0000-000000000000",
+		"TableName":                 t.name,
+		"TableSizeBytes":            0,
+		"TableStatus":               status,
+	}
+
+	if len(t.globalSecondaryIndexes) > 0 {
+		var indexes []map[string]any
+		for _, gsi := range t.globalSecondaryIndexes {
+			index := map[string]any{
+				"IndexArn":       t.arn + "/index/" + gsi["IndexName"].(string),
+				"IndexName":      gsi["IndexName"],
+				"IndexSizeBytes": 0,
+				"IndexStatus":    "ACTIVE",
+				"ItemCount":      0,
+				"KeySchema":      gsi["KeySchema"],
+				"Projection":     gsi["Projection"],
+			}
+			throughput := map[string]any{
+				"NumberOfDecreasesToday": 0,
+				"ReadCapacityUnits":      0,
+				"WriteCapacityUnits":     0,
+			}
+			if v, ok := gsi["ProvisionedThroughput"].(map[string]any); ok && t.billingMode == "PROVISIONED" {
+				throughput["ReadCapacityUnits"] = v["ReadCapacityUnits"]
+				throughput["WriteCapacityUnits"] = v["WriteCapacityUnits"]
+			}
+			index["ProvisionedThroughput"] = throughput
+			indexes = append(indexes, index)
+		}
+		v["GlobalSecondaryIndexes"] = indexes
+	}
+
+	if len(t.localSecondaryIndexes) > 0 {
+		var indexes []map[string]any
+		for _, lsi := range t.localSecondaryIndexes {
+			indexes = append(indexes, map[string]any{
+				"IndexArn":       t.arn + "/index/" + lsi["IndexName"].(string),
+				"IndexName":      lsi["IndexName"],
+				"IndexSizeBytes": 0,
+				"ItemCount":      0,
+				"KeySchema":      lsi["KeySchema"],
+				"Projection":     lsi["Projection"],
+			})
+		}
+		v["LocalSecondaryIndexes"] = indexes
+	}
+
+	if t.stream != nil {
+		v["LatestStreamArn"] = t.arn + "/stream/" + t.streamLabel
+		v["LatestStreamLabel"] = t.streamLabel
+		v["StreamSpecification"] = t.stream
+	}
+
+	if t.sse != nil && t.sse.Enabled {
+		keyARN := t.sse.KMSMasterKeyId
+		if keyARN == "" {
+			keyARN = strings.Replace(t.arn, ":dynamodb:", ":kms:", 1)
+			keyARN = keyARN[:strings.Index(keyARN, ":table/")] + ":key/00000000-0000-0000-0000-000000000000"
+		}
+		v["SSEDescription"] = map[string]any{
+			"KMSMasterKeyArn": keyARN,
+			"SSEType":         "KMS",
+			"Status":          "ENABLED",
+		}
+	}
+
+	if t.tableClass != "" {
+		v["TableClassSummary"] = map[string]any{"TableClass": t.tableClass}
+	}
+
+	return v
+}
+
+type dynamoDBCreateTableInput struct {
+	AttributeDefinitions      []any
+	BillingMode               string
+	DeletionProtectionEnabled bool
+	GlobalSecondaryIndexes    []map[string]any
+	KeySchema                 []any
+	LocalSecondaryIndexes     []map[string]any
+	ProvisionedThroughput     map[string]any
+	SSESpecification          *dynamoDBSSESpecification
+	StreamSpecification       *dynamoDBStreamSpecification
+	TableClass                string
+	TableName                 string
+	Tags                      []tag
+}
+
+type dynamoDBTableDescriptionOutput struct {
+	TableDescription map[string]any
+}
+
+func (s *dynamoDBService) createTable(c *call, in *dynamoDBCreateTableInput) (*dynamoDBTableDescriptionOutput, error) {
+	if in.TableName == "" || len(in.KeySchema) == 0 || len(in.AttributeDefinitions) == 0 {
+		return nil, validationError("TableName, KeySchema and AttributeDefinitions are required")
+	}
+
+	billingMode := in.BillingMode
+	if billingMode == "" {
+		billingMode = "PROVISIONED"
+	}
+	if billingMode == "PROVISIONED" && in.ProvisionedThroughput == nil {
+		return nil, validationError("No provisioned throughput specified for the table")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := c.region + "/" + in.TableName
+	if _, ok := s.tables[key]; ok {
+		return nil, newError(http.StatusBadRequest, "com.amazonaws.dynamodb.v20120810#ResourceInUseException", "Table already exists: %s", in.TableName)
+	}
+
+	t := &dynamoDBTable{
+		arn:                       c.arn("table/" + in.TableName),
+		attributeDefinitions:      in.AttributeDefinitions,
+		billingMode:               billingMode,
+		created:                   time.Now(),
+		deletionProtectionEnabled: in.DeletionProtectionEnabled,
+		globalSecondaryIndexes:    in.GlobalSecondaryIndexes,
+		keySchema:                 in.KeySchema,
+		localSecondaryIndexes:     in.LocalSecondaryIndexes,
+		name:                      in.TableName,
+		sse:                       in.SSESpecification,
+		tableClass:                in.TableClass,
+		tags:                      tagsFromList(in.Tags),
+	}
+	t.setProvisionedThroughput(in.ProvisionedThroughput)
+	t.setStream(in.StreamSpecification)
+
+	s.tables[key] = t
+
+	return &dynamoDBTableDescriptionOutput{
+		TableDescription: t.description("ACTIVE"),
+	}, nil
+}
+
+type dynamoDBTableNameInput struct {
+	TableName string
+}
+
+type dynamoDBDescribeTableOutput struct {
+	Table map[string]any
+}
+
+func (s *dynamoDBService) describeTable(c *call, in *dynamoDBTableNameInput) (*dynamoDBDescribeTableOutput, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t, err := s.table(c, in.TableName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dynamoDBDescribeTableOutput{
+		Table: t.description("ACTIVE"),
+	}, nil
+}
+
+type dynamoDBUpdateTableInput struct {
+	AttributeDefinitions        []any
+	BillingMode                 string
+	DeletionProtectionEnabled   *bool
+	GlobalSecondaryIndexUpdates []struct {
+		Create map[string]any
+		Delete map[string]any
+		Update map[string]any
+	}
+	ProvisionedThroughput map[string]any
+	SSESpecification      *dynamoDBSSESpecification
+	StreamSpecification   *dynamoDBStreamSpecification
+	TableClass            string
+	TableName             string
+}
+
+func (s *dynamoDBService) updateTable(c *call, in *dynamoDBUpdateTableInput) (*dynamoDBTableDescriptionOutput, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t, err := s.table(c, in.TableName)
+	if err != nil {
+		return nil, err
+	}
+
+	if in.AttributeDefinitions != nil {
+		t.attributeDefinitions = in.AttributeDefinitions
+	}
+	if in.BillingMode != "" {
+		t.billingMode = in.BillingMode
+	}
+	if in.BillingMode != "" || in.ProvisionedThroughput != nil {
+		throughput := in.ProvisionedThroughput
+		if throughput == nil {
+			throughput = t.provisionedThroughput
+		}
+		t.setProvisionedThroughput(throughput)
+	}
+	if in.DeletionProtectionEnabled != nil {
+		t.deletionProtectionEnabled = *in.DeletionProtectionEnabled
+	}
+	for _, update := range in.GlobalSecondaryIndexUpdates {
+		switch {
+		case update.Create != nil:
+			t.globalSecondaryIndexes = append(t.globalSecondaryIndexes, update.Create)
+		case update.Delete != nil:
+			t.globalSecondaryIndexes = slices.DeleteFunc(t.globalSecondaryIndexes, func(gsi map[string]any) bool {
+				return gsi["IndexName"] == update.Delete["IndexName"]
+			})
+		case update.Update != nil:
+			for _, gsi := range t.globalSecondaryIndexes {
+				if gsi["IndexName"] == update.Update["IndexName"] {
+					gsi["ProvisionedThroughput"] = update.Update["ProvisionedThroughput"]
+				}
+			}
+		}
+	}
+	if in.SSESpecification != nil {
+		t.sse = in.SSESpecification
+	}
+	if in.StreamSpecification != nil {
+		t.setStream(in.StreamSpecification)
+	}
+	if in.TableClass != "" {
+		t.tableClass = in.TableClass
+	}
+
+	return &dynamoDBTableDescriptionOutput{
+		TableDescription: t.description("ACTIVE"),
+	}, nil
+}
+
+func (s *dynamoDBService) deleteTable(c *call, in *dynamoDBTableNameInput) (*dynamoDBTableDescriptionOutput, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t, err := s.table(c, in.TableName)
+	if err != nil {
+		return nil, err
+	}
+
+	if t.deletionProtectionEnabled {
+		return nil, validationError("Resource cannot be deleted as it is currently protected against deletion. Disable deletion protection first.")
+	}
+
+	delete(s.tables, c.region+"/"+t.name)
+
+	return &dynamoDBTableDescriptionOutput{
+		TableDescription: t.description("DELETING"),
+	}, nil
+}
+
+type dynamoDBListTablesOutput struct {
+	TableNames []string
+}
+
+func (s *dynamoDBService) listTables(c *call, _ *struct{}) (*dynamoDBListTablesOutput, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	output := &dynamoDBListTablesOutput{
+		TableNames: []string{},
+	}
+	for k, t := range s.tables {
+		if strings.HasPrefix(k, c.region+"/") {
+			output.TableNames = append(output.TableNames, t.name)
+		}
+	}
+	slices.Sort(output.TableNames)
+
+	return output, nil
+}
+
+type dynamoDBContinuousBackupsOutput struct {
+	ContinuousBackupsDescription map[string]any
+}
+
+func (t *dynamoDBTable) continuousBackups() *dynamoDBContinuousBackupsOutput {
+	status := "DISABLED"
+	if t.pointInTimeRecovery {
+		status = "ENABLED"
+	}
+
+	return &dynamoDBContinuousBackupsOutput{
+		ContinuousBackupsDescription: map[string]any{
+			"ContinuousBackupsStatus": "ENABLED",
+			"PointInTimeRecoveryDescription": map[string]any{
+				"PointInTimeRecoveryStatus": status,
+			},
+		},
+	}
+}
+
+func (s *dynamoDBService) describeContinuousBackups(c *call, in *dynamoDBTableNameInput) (*dynamoDBContinuousBackupsOutput, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t, err := s.table(c, in.TableName)
+	if err != nil {
+		return nil, newError(http.StatusBadRequest, "com.amazonaws.dynamodb.v20120810#TableNotFoundException", "Table not found: %s", in.TableName)
+	}
+
+	return t.continuousBackups(), nil
+}
+
+type dynamoDBUpdateContinuousBackupsInput struct {
+	PointInTimeRecoverySpecification struct {
+		PointInTimeRecoveryEnabled bool
+	}
+	TableName string
+}
+
+func (s *dynamoDBService) updateContinuousBackups(c *call, in *dynamoDBUpdateContinuousBackupsInput) (*dynamoDBContinuousBackupsOutput, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t, err := s.table(c, in.TableName)
+	if err != nil {
+		return nil, newError(http.StatusBadRequest, "com.amazonaws.dynamodb.v20120810#TableNotFoundException", "Table not found: %s", in.TableName)
+	}
+
+	t.pointInTimeRecovery = in.PointInTimeRecoverySpecification.PointInTimeRecoveryEnabled
+
+	return t.continuousBackups(), nil
+}
+
+type dynamoDBDescribeTimeToLiveOutput struct {
+	TimeToLiveDescription map[string]any
+}
+
+func (s *dynamoDBService) describeTimeToLive(c *call, in *dynamoDBTableNameInput) (*dynamoDBDescribeTimeToLiveOutput, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t, err := s.table(c, in.TableName)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &dynamoDBDescribeTimeToLiveOutput{
+		TimeToLiveDescription: map[string]any{
+			"TimeToLiveStatus": "DISABLED",
+		},
+	}
+	if t.ttlAttributeName != "" {
+		output.TimeToLiveDescription["AttributeName"] = t.ttlAttributeName
+		output.TimeToLiveDescription["TimeToLiveStatus"] = "ENABLED"
+	}
+
+	return output, nil
+}
+
+type dynamoDBTimeToLiveSpecification struct {
+	AttributeName string
+	Enabled       bool
+}
+
+type dynamoDBUpdateTimeToLiveInput struct {
+	TableName               string
+	TimeToLiveSpecification dynamoDBTimeToLiveSpecification
+}
+
+type dynamoDBUpdateTimeToLiveOutput struct {
+	TimeToLiveSpecification dynamoDBTimeToLiveSpecification
+}
+
+func (s *dynamoDBService) updateTimeToLive(c *call, in *dynamoDBUpdateTimeToLiveInput) (*dynamoDBUpdateTimeToLiveOutput, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t, err := s.table(c, in.TableName)
+	if err != nil {
+		return nil, err
+	}
+
+	switch spec := in.TimeToLiveSpecification; {
+	case spec.Enabled && t.ttlAttributeName != "":
+		return nil, validationError("TimeToLive is already enabled")
+	case !spec.Enabled && t.ttlAttributeName == "":
+		return nil, validationError("TimeToLive is already disabled")
+	case spec.Enabled:
+		t.ttlAttributeName = spec.AttributeName
+	default:
+		t.ttlAttributeName = ""
+	}
+
+	return &dynamoDBUpdateTimeToLiveOutput{
+		TimeToLiveSpecification: in.TimeToLiveSpecification,
+	}, nil
+}
+
+type dynamoDBTagsInput struct {
+	ResourceArn string
+	TagKeys     []string
+	Tags        []tag
+}
+
+type dynamoDBTagResourceOutput struct{}
+
+func (s *dynamoDBService) tagResource(c *call, in *dynamoDBTagsInput) (*dynamoDBTagResourceOutput, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t, err := s.tableByARN(c, in.ResourceArn)
+	if err != nil {
+		return nil, err
+	}
+
+	t.tags.merge(tagsFromList(in.Tags))
+
+	return &dynamoDBTagResourceOutput{}, nil
+}
+
+type dynamoDBUntagResourceOutput struct{}
+
+func (s *dynamoDBService) untagResource(c *call, in *dynamoDBTagsInput) (*dynamoDBUntagResourceOutput, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t, err := s.tableByARN(c, in.ResourceArn)
+	if err != nil {
+		return nil, err
+	}
+
+	t.tags.remove(in.TagKeys)
+
+	return &dynamoDBUntagResourceOutput{}, nil
+}
+
+type dynamoDBListTagsOfResourceOutput struct {
+	Tags []tag
+}
+
+func (s *dynamoDBService) listTagsOfResource(c *call, in *dynamoDBTagsInput) (*dynamoDBListTagsOfResourceOutput, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t, err := s.tableByARN(c, in.ResourceArn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dynamoDBListTagsOfResourceOutput{
+		Tags: t.tags.list(),
+	}, nil
+}
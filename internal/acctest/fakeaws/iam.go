@@ -0,0 +1,536 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeaws
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// iamService implements AWS IAM roles and their inline and attached policies.
+// IAM is a global service, so its state is shared by all Regions.
+type iamService struct {
+	mu     sync.Mutex
+	nextID int
+	roles  map[string]*iamRole // Keyed by name.
+}
+
+type iamRole struct {
+	arn                      string
+	assumeRolePolicyDocument string
+	attachedPolicies         []string // ARNs.
+	createDate               time.Time
+	description              string
+	inlinePolicies           map[string]string
+	maxSessionDuration       int
+	name                     string
+	path                     string
+	permissionsBoundary      string
+	roleID                   string
+	tags                     tags
+}
+
+func newIAMService() *iamService {
+	return &iamService{
+		roles: make(map[string]*iamRole),
+	}
+}
+
+func (s *iamService) handlers() map[string]queryHandler {
+	return map[string]queryHandler{
+		"AttachRolePolicy":              s.attachRolePolicy,
+		"CreateRole":                    s.createRole,
+		"DeleteRole":                    s.deleteRole,
+		"DeleteRolePermissionsBoundary": s.deleteRolePermissionsBoundary,
+		"DeleteRolePolicy":              s.deleteRolePolicy,
+		"DetachRolePolicy":              s.detachRolePolicy,
+		"GetRole":                       s.getRole,
+		"GetRolePolicy":                 s.getRolePolicy,
+		"ListAttachedRolePolicies":      s.listAttachedRolePolicies,
+		"ListInstanceProfilesForRole":   s.listInstanceProfilesForRole,
+		"ListRolePolicies":              s.listRolePolicies,
+		"ListRoleTags":                  s.listRoleTags,
+		"PutRolePermissionsBoundary":    s.putRolePermissionsBoundary,
+		"PutRolePolicy":                 s.putRolePolicy,
+		"TagRole":                       s.tagRole,
+		"UntagRole":                     s.untagRole,
+		"UpdateAssumeRolePolicy":        s.updateAssumeRolePolicy,
+		"UpdateRole":                    s.updateRole,
+		"UpdateRoleDescription":         s.updateRoleDescription,
+	}
+}
+
+func iamNoSuchEntity(format string, a ...any) *apiError {
+	return newError(http.StatusNotFound, "NoSuchEntity", format, a...)
+}
+
+func (s *iamService) resources() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var arns []string
+	for _, r := range s.roles {
+		arns = append(arns, r.arn)
+	}
+
+	return arns
+}
+
+// role returns the named role. The caller must hold the lock.
+func (s *iamService) role(name string) (*iamRole, *apiError) {
+	r, ok := s.roles[name]
+	if !ok {
+		return nil, iamNoSuchEntity("The role with name %s cannot be found.", name)
+	}
+
+	return r, nil
+}
+
+func iamValidatePolicyDocument(document string) *apiError {
+	if !json.Valid([]byte(document)) {
+		return newError(http.StatusBadRequest, "MalformedPolicyDocument", "This policy contains invalid Json")
+	}
+
+	return nil
+}
+
+// iamEncodePolicyDocument URL-encodes a policy document, as returned by the IAM API.
+func iamEncodePolicyDocument(document string) string {
+	return url.QueryEscape(document)
+}
+
+type iamRoleOutput struct {
+	Arn                      string                  `xml:"Arn"`
+	AssumeRolePolicyDocument string                  `xml:"AssumeRolePolicyDocument"`
+	CreateDate               string                  `xml:"CreateDate"`
+	Description              string                  `xml:"Description,omitempty"`
+	MaxSessionDuration       int                     `xml:"MaxSessionDuration"`
+	Path                     string                  `xml:"Path"`
+	PermissionsBoundary      *iamPermissionsBoundary `xml:"PermissionsBoundary,omitempty"`
+	RoleID                   string                  `xml:"RoleId"`
+	RoleName                 string                  `xml:"RoleName"`
+	Tags                     []tag                   `xml:"Tags>member"`
+}
+
+type iamPermissionsBoundary struct {
+	PermissionsBoundaryArn  string `xml:"PermissionsBoundaryArn"`
+	PermissionsBoundaryType string `xml:"PermissionsBoundaryType"`
+}
+
+type iamRoleResult struct {
+	Role iamRoleOutput `xml:"Role"`
+}
+
+func (r *iamRole) output() iamRoleOutput {
+	v := iamRoleOutput{
+		Arn:                      r.arn,
+		AssumeRolePolicyDocument: iamEncodePolicyDocument(r.assumeRolePolicyDocument),
+		CreateDate:               iso8601(r.createDate),
+		Description:              r.description,
+		MaxSessionDuration:       r.maxSessionDuration,
+		Path:                     r.path,
+		RoleID:                   r.roleID,
+		RoleName:                 r.name,
+		Tags:                     r.tags.list(),
+	}
+	if r.permissionsBoundary != "" {
+		v.PermissionsBoundary = &iamPermissionsBoundary{
+			PermissionsBoundaryArn:  r.permissionsBoundary,
+			PermissionsBoundaryType: "Policy",
+		}
+	}
+
+	return v
+}
+
+func (s *iamService) createRole(c *call, form url.Values) (any, error) {
+	name := form.Get("RoleName")
+	if name == "" {
+		return nil, validationError("RoleName is required")
+	}
+
+	document := form.Get("AssumeRolePolicyDocument")
+	if err := iamValidatePolicyDocument(document); err != nil {
+		return nil, err
+	}
+
+	maxSessionDuration, err := queryInt(form, "MaxSessionDuration", 3600)
+	if err != nil {
+		return nil, err
+	}
+
+	rolePath := form.Get("Path")
+	if rolePath == "" {
+		rolePath = "/"
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.roles[name]; ok {
+		return nil, newError(http.StatusConflict, "EntityAlreadyExists", "Role with name %s already exists.", name)
+	}
+
+	s.nextID++
+	r := &iamRole{
+		arn:                      c.server.arn("iam", "", "role"+rolePath+name),
+		assumeRolePolicyDocument: document,
+		createDate:               time.Now(),
+		description:              form.Get("Description"),
+		inlinePolicies:           make(map[string]string),
+		maxSessionDuration:       maxSessionDuration,
+		name:                     name,
+		path:                     rolePath,
+		permissionsBoundary:      form.Get("PermissionsBoundary"),
+		roleID:                   fmt.Sprintf("AROA%017X", s.nextID),
+		tags:                     tagsFromQuery(form, "Tags"),
+	}
+	s.roles[name] = r
+
+	return &iamRoleResult{Role: r.output()}, nil
+}
+
+func (s *iamService) getRole(_ *call, form url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r, err := s.role(form.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	return &iamRoleResult{Role: r.output()}, nil
+}
+
+func (s *iamService) updateRole(_ *call, form url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r, err := s.role(form.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	if form.Has("Description") {
+		r.description = form.Get("Description")
+	}
+	if form.Has("MaxSessionDuration") {
+		v, err := queryInt(form, "MaxSessionDuration", r.maxSessionDuration)
+		if err != nil {
+			return nil, err
+		}
+		r.maxSessionDuration = v
+	}
+
+	return nil, nil
+}
+
+func (s *iamService) updateRoleDescription(_ *call, form url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r, err := s.role(form.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	r.description = form.Get("Description")
+
+	return &iamRoleResult{Role: r.output()}, nil
+}
+
+func (s *iamService) updateAssumeRolePolicy(_ *call, form url.Values) (any, error) {
+	document := form.Get("PolicyDocument")
+	if err := iamValidatePolicyDocument(document); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r, err := s.role(form.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	r.assumeRolePolicyDocument = document
+
+	return nil, nil
+}
+
+func (s *iamService) putRolePermissionsBoundary(_ *call, form url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r, err := s.role(form.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	r.permissionsBoundary = form.Get("PermissionsBoundary")
+
+	return nil, nil
+}
+
+func (s *iamService) deleteRolePermissionsBoundary(_ *call, form url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r, err := s.role(form.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	r.permissionsBoundary = ""
+
+	return nil, nil
+}
+
+func (s *iamService) deleteRole(_ *call, form url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r, err := s.role(form.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(r.inlinePolicies) > 0 || len(r.attachedPolicies) > 0 {
+		return nil, newError(http.StatusConflict, "DeleteConflict", "Cannot delete entity, must delete policies first.")
+	}
+
+	delete(s.roles, r.name)
+
+	return nil, nil
+}
+
+func (s *iamService) putRolePolicy(_ *call, form url.Values) (any, error) {
+	document := form.Get("PolicyDocument")
+	if err := iamValidatePolicyDocument(document); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r, err := s.role(form.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	r.inlinePolicies[form.Get("PolicyName")] = document
+
+	return nil, nil
+}
+
+type iamGetRolePolicyResult struct {
+	PolicyDocument string `xml:"PolicyDocument"`
+	PolicyName     string `xml:"PolicyName"`
+	RoleName       string `xml:"RoleName"`
+}
+
+func (s *iamService) getRolePolicy(_ *call, form url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r, err := s.role(form.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	name := form.Get("PolicyName")
+	document, ok := r.inlinePolicies[name]
+	if !ok {
+		return nil, iamNoSuchEntity("The role policy with name %s cannot be found.", name)
+	}
+
+	return &iamGetRolePolicyResult{
+		PolicyDocument: iamEncodePolicyDocument(document),
+		PolicyName:     name,
+		RoleName:       r.name,
+	}, nil
+}
+
+func (s *iamService) deleteRolePolicy(_ *call, form url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r, err := s.role(form.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	name := form.Get("PolicyName")
+	if _, ok := r.inlinePolicies[name]; !ok {
+		return nil, iamNoSuchEntity("The role policy with name %s cannot be found.", name)
+	}
+
+	delete(r.inlinePolicies, name)
+
+	return nil, nil
+}
+
+type iamListRolePoliciesResult struct {
+	IsTruncated bool     `xml:"IsTruncated"`
+	PolicyNames []string `xml:"PolicyNames>member"`
+}
+
+func (s *iamService) listRolePolicies(_ *call, form url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r, err := s.role(form.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	result := &iamListRolePoliciesResult{}
+	for name := range r.inlinePolicies {
+		result.PolicyNames = append(result.PolicyNames, name)
+	}
+	slices.Sort(result.PolicyNames)
+
+	return result, nil
+}
+
+func (s *iamService) attachRolePolicy(_ *call, form url.Values) (any, error) {
+	policyARN := form.Get("PolicyArn")
+	if !strings.HasPrefix(policyARN, "arn:") {
+		return nil, newError(http.StatusBadRequest, "InvalidInput", "ARN %s is not valid.", policyARN)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r, err := s.role(form.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	if !slices.Contains(r.attachedPolicies, policyARN) {
+		r.attachedPolicies = append(r.attachedPolicies, policyARN)
+	}
+
+	return nil, nil
+}
+
+func (s *iamService) detachRolePolicy(_ *call, form url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r, err := s.role(form.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	policyARN := form.Get("PolicyArn")
+	i := slices.Index(r.attachedPolicies, policyARN)
+	if i == -1 {
+		return nil, iamNoSuchEntity("Policy %s was not found.", policyARN)
+	}
+
+	r.attachedPolicies = slices.Delete(r.attachedPolicies, i, i+1)
+
+	return nil, nil
+}
+
+type iamListAttachedRolePoliciesResult struct {
+	AttachedPolicies []iamAttachedPolicy `xml:"AttachedPolicies>member"`
+	IsTruncated      bool                `xml:"IsTruncated"`
+}
+
+type iamAttachedPolicy struct {
+	PolicyArn  string `xml:"PolicyArn"`
+	PolicyName string `xml:"PolicyName"`
+}
+
+func (s *iamService) listAttachedRolePolicies(_ *call, form url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r, err := s.role(form.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	result := &iamListAttachedRolePoliciesResult{}
+	for _, v := range r.attachedPolicies {
+		result.AttachedPolicies = append(result.AttachedPolicies, iamAttachedPolicy{
+			PolicyArn:  v,
+			PolicyName: path.Base(v),
+		})
+	}
+
+	return result, nil
+}
+
+type iamListInstanceProfilesForRoleResult struct {
+	InstanceProfiles []struct{} `xml:"InstanceProfiles>member"`
+	IsTruncated      bool       `xml:"IsTruncated"`
+}
+
+func (s *iamService) listInstanceProfilesForRole(_ *call, form url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.role(form.Get("RoleName")); err != nil {
+		return nil, err
+	}
+
+	return &iamListInstanceProfilesForRoleResult{}, nil
+}
+
+func (s *iamService) tagRole(_ *call, form url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r, err := s.role(form.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	r.tags.merge(tagsFromQuery(form, "Tags"))
+
+	return nil, nil
+}
+
+func (s *iamService) untagRole(_ *call, form url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r, err := s.role(form.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	r.tags.remove(queryList(form, "TagKeys"))
+
+	return nil, nil
+}
+
+type iamListRoleTagsResult struct {
+	IsTruncated bool  `xml:"IsTruncated"`
+	Tags        []tag `xml:"Tags>member"`
+}
+
+func (s *iamService) listRoleTags(_ *call, form url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r, err := s.role(form.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	return &iamListRoleTagsResult{
+		Tags: r.tags.list(),
+	}, nil
+}
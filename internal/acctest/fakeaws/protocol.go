@@ -0,0 +1,331 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeaws
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// apiError is an AWS API error response.
+type apiError struct {
+	status  int
+	code    string
+	message string
+	// queryCode is the AWS Query protocol error code of an AWS Query-compatible JSON service, e.g. SQS.
+	queryCode string
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("%s: %s", e.code, e.message)
+}
+
+func newError(status int, code, format string, a ...any) *apiError {
+	return &apiError{
+		status:  status,
+		code:    code,
+		message: fmt.Sprintf(format, a...),
+	}
+}
+
+func validationError(format string, a ...any) *apiError {
+	return newError(http.StatusBadRequest, "ValidationException", format, a...)
+}
+
+// call is a single API call to the server.
+type call struct {
+	w         http.ResponseWriter
+	r         *http.Request
+	server    *Server
+	region    string
+	service   string
+	operation string
+	requestID string
+}
+
+// arn returns an ARN for a resource in the call's service and Region.
+func (c *call) arn(resource string) string {
+	return c.server.arn(c.service, c.region, resource)
+}
+
+func (c *call) writeHeader(status int, contentType string) {
+	c.w.Header().Set("Content-Type", contentType)
+	c.w.Header().Set("X-Amzn-Requestid", c.requestID)
+	c.w.Header().Set("X-Amz-Request-Id", c.requestID)
+	c.w.WriteHeader(status)
+}
+
+// jsonHandler handles an AWS JSON protocol operation.
+type jsonHandler func(c *call, body []byte) (any, error)
+
+// jsonOperation returns a handler for an AWS JSON protocol operation with typed input and output.
+func jsonOperation[I, O any](f func(*call, *I) (*O, error)) jsonHandler {
+	return func(c *call, body []byte) (any, error) {
+		in := new(I)
+
+		if len(body) > 0 {
+			if err := json.Unmarshal(body, in); err != nil {
+				return nil, newError(http.StatusBadRequest, "SerializationException", "%s", err)
+			}
+		}
+
+		return f(c, in)
+	}
+}
+
+// serveJSON serves an AWS JSON 1.0 or 1.1 protocol request.
+// The operation is specified by the X-Amz-Target header, e.g. "AmazonSSM.PutParameter".
+func (c *call) serveJSON(targetPrefix, contentType string, handlers map[string]jsonHandler) {
+	prefix, operation, _ := strings.Cut(c.r.Header.Get("X-Amz-Target"), ".")
+	c.operation = operation
+	c.server.recordCall(c.service, operation)
+
+	var output any
+	var err error
+
+	if h, ok := handlers[operation]; ok && prefix == targetPrefix {
+		var body []byte
+		body, err = io.ReadAll(c.r.Body)
+		if err == nil {
+			output, err = h(c, body)
+		}
+	} else {
+		err = newError(http.StatusBadRequest, "UnknownOperationException", "operation %q is not supported", c.r.Header.Get("X-Amz-Target"))
+	}
+
+	if err != nil {
+		apiErr := asAPIError(err)
+		if apiErr.queryCode != "" {
+			c.w.Header().Set("X-Amzn-Query-Error", apiErr.queryCode+";Sender")
+		}
+		c.writeHeader(apiErr.status, contentType)
+		json.NewEncoder(c.w).Encode(map[string]string{ //nolint:errcheck // Response already started.
+			"__type":  apiErr.code,
+			"message": apiErr.message,
+		})
+		return
+	}
+
+	if output == nil {
+		output = struct{}{}
+	}
+
+	c.writeHeader(http.StatusOK, contentType)
+	json.NewEncoder(c.w).Encode(output) //nolint:errcheck // Response already started.
+}
+
+// queryHandler handles an AWS Query protocol operation.
+type queryHandler func(c *call, form url.Values) (any, error)
+
+// serveQuery serves an AWS Query protocol request.
+// The operation is specified by the Action parameter.
+func (c *call) serveQuery(xmlns string, handlers map[string]queryHandler) {
+	var output any
+	var err error
+
+	if err = c.r.ParseForm(); err == nil {
+		c.operation = c.r.Form.Get("Action")
+		c.server.recordCall(c.service, c.operation)
+
+		if h, ok := handlers[c.operation]; ok {
+			output, err = h(c, c.r.Form)
+		} else {
+			err = newError(http.StatusBadRequest, "InvalidAction", "operation %q is not supported", c.operation)
+		}
+	}
+
+	if err != nil {
+		apiErr := asAPIError(err)
+		c.writeHeader(apiErr.status, "text/xml")
+		c.writeXML(queryErrorResponse{
+			Xmlns: xmlns,
+			Error: queryError{
+				Type:    "Sender",
+				Code:    apiErr.code,
+				Message: apiErr.message,
+			},
+			RequestID: c.requestID,
+		}, xml.StartElement{Name: xml.Name{Local: "ErrorResponse"}})
+		return
+	}
+
+	c.writeHeader(http.StatusOK, "text/xml")
+
+	enc := xml.NewEncoder(c.w)
+	response := xml.StartElement{
+		Name: xml.Name{Local: c.operation + "Response"},
+		Attr: []xml.Attr{{Name: xml.Name{Local: "xmlns"}, Value: xmlns}},
+	}
+	enc.EncodeToken(response) //nolint:errcheck // Response already started.
+	if output != nil {
+		enc.EncodeElement(output, xml.StartElement{Name: xml.Name{Local: c.operation + "Result"}}) //nolint:errcheck // Response already started.
+	}
+	enc.EncodeElement(queryResponseMetadata{RequestID: c.requestID}, xml.StartElement{Name: xml.Name{Local: "ResponseMetadata"}}) //nolint:errcheck // Response already started.
+	enc.EncodeToken(response.End())                                                                                               //nolint:errcheck // Response already started.
+	enc.Flush()                                                                                                                   //nolint:errcheck // Response already started.
+}
+
+type queryErrorResponse struct {
+	Xmlns     string     `xml:"xmlns,attr"`
+	Error     queryError `xml:"Error"`
+	RequestID string     `xml:"RequestId"`
+}
+
+type queryError struct {
+	Type    string `xml:"Type"`
+	Code    string `xml:"Code"`
+	Message string `xml:"Message"`
+}
+
+type queryResponseMetadata struct {
+	RequestID string `xml:"RequestId"`
+}
+
+// writeXML writes v as an XML document with the specified root element.
+func (c *call) writeXML(v any, start xml.StartElement) {
+	io.WriteString(c.w, xml.Header)             //nolint:errcheck // Response already started.
+	xml.NewEncoder(c.w).EncodeElement(v, start) //nolint:errcheck // Response already started.
+}
+
+func asAPIError(err error) *apiError {
+	if apiErr, ok := err.(*apiError); ok {
+		return apiErr
+	}
+
+	return newError(http.StatusInternalServerError, "InternalFailure", "%s", err)
+}
+
+// queryList returns the values of an AWS Query protocol list parameter, e.g. "PolicyArns.member.N".
+func queryList(form url.Values, name string) []string {
+	var list []string
+
+	for i := 1; ; i++ {
+		k := fmt.Sprintf("%s.member.%d", name, i)
+		if !form.Has(k) {
+			break
+		}
+		list = append(list, form.Get(k))
+	}
+
+	return list
+}
+
+// queryStructList returns the members of an AWS Query protocol list of structures, e.g. "Tags.member.N.Key".
+func queryStructList(form url.Values, name string) []map[string]string {
+	var list []map[string]string
+
+	for i := 1; ; i++ {
+		prefix := fmt.Sprintf("%s.member.%d.", name, i)
+		member := make(map[string]string)
+
+		for k := range form {
+			if field, ok := strings.CutPrefix(k, prefix); ok {
+				member[field] = form.Get(k)
+			}
+		}
+
+		if len(member) == 0 {
+			break
+		}
+		list = append(list, member)
+	}
+
+	return list
+}
+
+// queryMap returns an AWS Query protocol map parameter, e.g. "Attributes.entry.N.key" and "Attributes.entry.N.value".
+func queryMap(form url.Values, name string) map[string]string {
+	m := make(map[string]string)
+
+	for i := 1; ; i++ {
+		k := fmt.Sprintf("%s.entry.%d.key", name, i)
+		if !form.Has(k) {
+			break
+		}
+		m[form.Get(k)] = form.Get(fmt.Sprintf("%s.entry.%d.value", name, i))
+	}
+
+	return m
+}
+
+// queryInt returns an AWS Query protocol integer parameter, or the default value if not set.
+func queryInt(form url.Values, name string, defaultValue int) (int, error) {
+	if !form.Has(name) {
+		return defaultValue, nil
+	}
+
+	v, err := strconv.Atoi(form.Get(name))
+	if err != nil {
+		return 0, validationError("invalid value for %s: %s", name, err)
+	}
+
+	return v, nil
+}
+
+// tags are resource tags.
+type tags map[string]string
+
+type tag struct {
+	Key   string `json:"Key" xml:"Key"`
+	Value string `json:"Value" xml:"Value"`
+}
+
+func tagsFromList(list []tag) tags {
+	t := make(tags, len(list))
+	for _, v := range list {
+		t[v.Key] = v.Value
+	}
+	return t
+}
+
+func tagsFromQuery(form url.Values, name string) tags {
+	t := make(tags)
+	for _, v := range queryStructList(form, name) {
+		t[v["Key"]] = v["Value"]
+	}
+	return t
+}
+
+// list returns the tags as a list, sorted by key.
+func (t tags) list() []tag {
+	list := make([]tag, 0, len(t))
+	for k, v := range t {
+		list = append(list, tag{Key: k, Value: v})
+	}
+	slices.SortFunc(list, func(a, b tag) int {
+		return strings.Compare(a.Key, b.Key)
+	})
+	return list
+}
+
+// merge adds or overwrites tags.
+func (t tags) merge(other tags) {
+	maps.Copy(t, other)
+}
+
+// remove removes the tags with the specified keys.
+func (t tags) remove(keys []string) {
+	for _, k := range keys {
+		delete(t, k)
+	}
+}
+
+// epochSeconds returns a time as the number of seconds since the Unix epoch, as used by the AWS JSON protocols.
+func epochSeconds(t time.Time) float64 {
+	return float64(t.UnixMilli()) / 1000
+}
+
+// iso8601 returns a time in the format used by the AWS Query and REST-XML protocols.
+func iso8601(t time.Time) string {
+	return t.UTC().Format("2006-01-02T15:04:05.000Z")
+}
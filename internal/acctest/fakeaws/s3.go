@@ -0,0 +1,784 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeaws
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// s3Xmlns is the XML namespace of Amazon S3 responses.
+const s3Xmlns = "http://s3.amazonaws.com/doc/2006-03-01/"
+
+// s3Service implements Amazon S3 buckets and objects.
+// Requests must use path-style addressing.
+// Only the current version of each object is kept.
+type s3Service struct {
+	mu      sync.Mutex
+	buckets map[string]*s3Bucket // Keyed by name.
+}
+
+type s3Bucket struct {
+	created    time.Time
+	name       string
+	objects    map[string]*s3Object // Keyed by key.
+	policy     string
+	region     string
+	tags       tags
+	versioning string
+}
+
+type s3Object struct {
+	body         []byte
+	etag         string
+	headers      http.Header
+	key          string
+	lastModified time.Time
+	metadata     map[string]string
+	tags         tags
+	versionID    string
+}
+
+// s3ObjectHeaders are the request headers that are stored with an object and returned by GetObject and HeadObject.
+var s3ObjectHeaders = []string{
+	"Cache-Control",
+	"Content-Disposition",
+	"Content-Encoding",
+	"Content-Language",
+	"Content-Type",
+	"X-Amz-Storage-Class",
+	"X-Amz-Website-Redirect-Location",
+}
+
+// s3Parameters are the query parameters that do not identify a subresource.
+var s3Parameters = []string{
+	"continuation-token",
+	"delimiter",
+	"encoding-type",
+	"key-marker",
+	"list-type",
+	"max-keys",
+	"prefix",
+	"version-id-marker",
+	"versionId",
+	"x-id",
+}
+
+// s3NotFoundConfigurations are the bucket subresources that are always reported as not configured,
+// with the corresponding Get operation and error code.
+var s3NotFoundConfigurations = map[string][2]string{
+	"cors":              {"GetBucketCors", "NoSuchCORSConfiguration"},
+	"lifecycle":         {"GetBucketLifecycleConfiguration", "NoSuchLifecycleConfiguration"},
+	"object-lock":       {"GetObjectLockConfiguration", "ObjectLockConfigurationNotFoundError"},
+	"ownershipControls": {"GetBucketOwnershipControls", "OwnershipControlsNotFoundError"},
+	"publicAccessBlock": {"GetPublicAccessBlock", "NoSuchPublicAccessBlockConfiguration"},
+	"replication":       {"GetBucketReplication", "ReplicationConfigurationNotFoundError"},
+	"website":           {"GetBucketWebsite", "NoSuchWebsiteConfiguration"},
+}
+
+func newS3Service() *s3Service {
+	return &s3Service{
+		buckets: make(map[string]*s3Bucket),
+	}
+}
+
+func (s *s3Service) resources(partition string) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var arns []string
+	for _, b := range s.buckets {
+		arn := fmt.Sprintf("arn:%s:s3:::%s", partition, b.name)
+		arns = append(arns, arn)
+		for key := range b.objects {
+			arns = append(arns, arn+"/"+key)
+		}
+	}
+
+	return arns
+}
+
+type s3Handler func(c *call, b *s3Bucket, key string) error
+
+// serve serves an Amazon S3 REST-XML protocol request.
+// The operation is determined by the request's method, path and subresource query parameters.
+func (s *s3Service) serve(c *call) {
+	bucket, key, _ := strings.Cut(strings.TrimPrefix(c.r.URL.Path, "/"), "/")
+	query := c.r.URL.Query()
+
+	var subresource string
+	for k := range query {
+		if !slices.Contains(s3Parameters, k) {
+			subresource = k
+			break
+		}
+	}
+
+	var h s3Handler
+	switch {
+	case bucket == "":
+		c.operation = "ListBuckets"
+		if c.r.Method == http.MethodGet {
+			h = s.listBuckets
+		}
+	case key == "":
+		c.operation, h = s.bucketOperation(c.r.Method, subresource, query)
+	default:
+		c.operation, h = s.objectOperation(c.r.Method, subresource)
+	}
+	c.server.recordCall(c.service, c.operation)
+
+	var err error
+	if h == nil {
+		err = newError(http.StatusNotImplemented, "NotImplemented", "A header or query you provided requested a function that is not implemented.")
+	} else {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		var b *s3Bucket
+		if c.operation != "CreateBucket" && c.operation != "ListBuckets" {
+			var ok bool
+			if b, ok = s.buckets[bucket]; !ok {
+				err = newError(http.StatusNotFound, "NoSuchBucket", "The specified bucket does not exist")
+			}
+		}
+
+		if err == nil {
+			err = h(c, b, key)
+		}
+	}
+
+	if err != nil {
+		apiErr := asAPIError(err)
+		c.writeHeader(apiErr.status, "application/xml")
+		// HEAD responses have no body.
+		if c.r.Method == http.MethodHead {
+			return
+		}
+		c.writeXML(s3Error{
+			Code:      apiErr.code,
+			Message:   apiErr.message,
+			RequestID: c.requestID,
+		}, xml.StartElement{Name: xml.Name{Local: "Error"}})
+	}
+}
+
+type s3Error struct {
+	Code      string `xml:"Code"`
+	Message   string `xml:"Message"`
+	RequestID string `xml:"RequestId"`
+}
+
+// bucketOperation returns the name and handler of a bucket operation.
+// The handler is nil if the operation is not supported.
+func (s *s3Service) bucketOperation(method, subresource string, query url.Values) (string, s3Handler) {
+	switch subresource {
+	case "":
+		switch method {
+		case http.MethodDelete:
+			return "DeleteBucket", s.deleteBucket
+		case http.MethodGet:
+			if query.Get("list-type") == "2" {
+				return "ListObjectsV2", s.listObjectsV2
+			}
+			return "ListObjects", nil
+		case http.MethodHead:
+			return "HeadBucket", s.headBucket
+		case http.MethodPut:
+			return "CreateBucket", s.createBucket
+		}
+	case "delete":
+		if method == http.MethodPost {
+			return "DeleteObjects", s.deleteObjects
+		}
+	case "location":
+		if method == http.MethodGet {
+			return "GetBucketLocation", s.getBucketLocation
+		}
+	case "policy":
+		switch method {
+		case http.MethodDelete:
+			return "DeleteBucketPolicy", s.deleteBucketPolicy
+		case http.MethodGet:
+			return "GetBucketPolicy", s.getBucketPolicy
+		case http.MethodPut:
+			return "PutBucketPolicy", s.putBucketPolicy
+		}
+	case "tagging":
+		switch method {
+		case http.MethodDelete:
+			return "DeleteBucketTagging", s.deleteBucketTagging
+		case http.MethodGet:
+			return "GetBucketTagging", s.getBucketTagging
+		case http.MethodPut:
+			return "PutBucketTagging", s.putBucketTagging
+		}
+	case "versioning":
+		switch method {
+		case http.MethodGet:
+			return "GetBucketVersioning", s.getBucketVersioning
+		case http.MethodPut:
+			return "PutBucketVersioning", s.putBucketVersioning
+		}
+	case "versions":
+		if method == http.MethodGet {
+			return "ListObjectVersions", s.listObjectVersions
+		}
+	}
+
+	if v, ok := s3NotFoundConfigurations[subresource]; ok && method == http.MethodGet {
+		return v[0], func(*call, *s3Bucket, string) error {
+			return newError(http.StatusNotFound, v[1], "The %s configuration does not exist", subresource)
+		}
+	}
+
+	return fmt.Sprintf("%s ?%s", method, subresource), nil
+}
+
+// objectOperation returns the name and handler of an object operation.
+// The handler is nil if the operation is not supported.
+func (s *s3Service) objectOperation(method, subresource string) (string, s3Handler) {
+	switch subresource {
+	case "":
+		switch method {
+		case http.MethodDelete:
+			return "DeleteObject", s.deleteObject
+		case http.MethodGet:
+			return "GetObject", s.getObject
+		case http.MethodHead:
+			return "HeadObject", s.getObject
+		case http.MethodPut:
+			return "PutObject", s.putObject
+		}
+	case "tagging":
+		switch method {
+		case http.MethodDelete:
+			return "DeleteObjectTagging", s.deleteObjectTagging
+		case http.MethodGet:
+			return "GetObjectTagging", s.getObjectTagging
+		case http.MethodPut:
+			return "PutObjectTagging", s.putObjectTagging
+		}
+	}
+
+	return fmt.Sprintf("%s object ?%s", method, subresource), nil
+}
+
+// writeResult writes a successful response with an optional XML body.
+func (c *call) writeResult(status int, v any, root string) {
+	c.writeHeader(status, "application/xml")
+	if v == nil {
+		return
+	}
+
+	c.writeXML(v, xml.StartElement{
+		Name: xml.Name{Local: root},
+		Attr: []xml.Attr{{Name: xml.Name{Local: "xmlns"}, Value: s3Xmlns}},
+	})
+}
+
+// readXML decodes the request body into v.
+func (c *call) readXML(v any) error {
+	if err := xml.NewDecoder(c.r.Body).Decode(v); err != nil {
+		return newError(http.StatusBadRequest, "MalformedXML", "The XML you provided was not well-formed or did not validate against our published schema")
+	}
+
+	return nil
+}
+
+type s3Owner struct {
+	DisplayName string `xml:"DisplayName"`
+	ID          string `xml:"ID"`
+}
+
+func (c *call) s3Owner() s3Owner {
+	return s3Owner{
+		DisplayName: "fakeaws",
+		ID:          c.server.AccountID,
+	}
+}
+
+type s3ListAllMyBucketsResult struct {
+	Buckets []s3BucketSummary `xml:"Buckets>Bucket"`
+	Owner   s3Owner           `xml:"Owner"`
+}
+
+type s3BucketSummary struct {
+	CreationDate string `xml:"CreationDate"`
+	Name         string `xml:"Name"`
+}
+
+func (s *s3Service) listBuckets(c *call, _ *s3Bucket, _ string) error {
+	result := s3ListAllMyBucketsResult{
+		Owner: c.s3Owner(),
+	}
+	for _, b := range s.buckets {
+		result.Buckets = append(result.Buckets, s3BucketSummary{
+			CreationDate: iso8601(b.created),
+			Name:         b.name,
+		})
+	}
+	slices.SortFunc(result.Buckets, func(a, b s3BucketSummary) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	c.writeResult(http.StatusOK, result, "ListAllMyBucketsResult")
+
+	return nil
+}
+
+type s3CreateBucketConfiguration struct {
+	LocationConstraint string `xml:"LocationConstraint"`
+}
+
+func (s *s3Service) createBucket(c *call, _ *s3Bucket, _ string) error {
+	name := strings.TrimPrefix(c.r.URL.Path, "/")
+	if _, ok := s.buckets[name]; ok {
+		return newError(http.StatusConflict, "BucketAlreadyOwnedByYou", "Your previous request to create the named bucket succeeded and you already own it.")
+	}
+
+	region := "us-east-1"
+	if c.r.ContentLength != 0 {
+		var configuration s3CreateBucketConfiguration
+		if err := c.readXML(&configuration); err != nil {
+			return err
+		}
+		if configuration.LocationConstraint != "" {
+			region = configuration.LocationConstraint
+		}
+	}
+	if region != c.region {
+		return newError(http.StatusBadRequest, "IllegalLocationConstraintException", "The %s location constraint is incompatible for the region specific endpoint this request was sent to.", region)
+	}
+
+	s.buckets[name] = &s3Bucket{
+		created: time.Now(),
+		name:    name,
+		objects: make(map[string]*s3Object),
+		region:  region,
+		tags:    make(tags),
+	}
+
+	c.w.Header().Set("Location", "/"+name)
+	c.writeResult(http.StatusOK, nil, "")
+
+	return nil
+}
+
+func (s *s3Service) headBucket(c *call, b *s3Bucket, _ string) error {
+	c.w.Header().Set("X-Amz-Bucket-Region", b.region)
+	c.writeResult(http.StatusOK, nil, "")
+
+	return nil
+}
+
+func (s *s3Service) deleteBucket(c *call, b *s3Bucket, _ string) error {
+	if len(b.objects) > 0 {
+		return newError(http.StatusConflict, "BucketNotEmpty", "The bucket you tried to delete is not empty")
+	}
+
+	delete(s.buckets, b.name)
+	c.writeResult(http.StatusNoContent, nil, "")
+
+	return nil
+}
+
+func (s *s3Service) getBucketLocation(c *call, b *s3Bucket, _ string) error {
+	location := b.region
+	if location == "us-east-1" {
+		location = ""
+	}
+
+	c.writeResult(http.StatusOK, struct {
+		Value string `xml:",chardata"`
+	}{Value: location}, "LocationConstraint")
+
+	return nil
+}
+
+func (s *s3Service) getBucketPolicy(c *call, b *s3Bucket, _ string) error {
+	if b.policy == "" {
+		return newError(http.StatusNotFound, "NoSuchBucketPolicy", "The bucket policy does not exist")
+	}
+
+	c.writeHeader(http.StatusOK, "application/json")
+	io.WriteString(c.w, b.policy) //nolint:errcheck // Response already started.
+
+	return nil
+}
+
+func (s *s3Service) putBucketPolicy(c *call, b *s3Bucket, _ string) error {
+	body, err := io.ReadAll(c.r.Body)
+	if err != nil {
+		return err
+	}
+	if len(body) == 0 {
+		return newError(http.StatusBadRequest, "MalformedPolicy", "Policies must be valid JSON and the first byte must be '{'")
+	}
+
+	b.policy = string(body)
+	c.writeResult(http.StatusNoContent, nil, "")
+
+	return nil
+}
+
+func (s *s3Service) deleteBucketPolicy(c *call, b *s3Bucket, _ string) error {
+	b.policy = ""
+	c.writeResult(http.StatusNoContent, nil, "")
+
+	return nil
+}
+
+type s3Tagging struct {
+	TagSet []tag `xml:"TagSet>Tag"`
+}
+
+func (s *s3Service) getBucketTagging(c *call, b *s3Bucket, _ string) error {
+	if len(b.tags) == 0 {
+		return newError(http.StatusNotFound, "NoSuchTagSet", "The TagSet does not exist")
+	}
+
+	c.writeResult(http.StatusOK, s3Tagging{TagSet: b.tags.list()}, "Tagging")
+
+	return nil
+}
+
+func (s *s3Service) putBucketTagging(c *call, b *s3Bucket, _ string) error {
+	var tagging s3Tagging
+	if err := c.readXML(&tagging); err != nil {
+		return err
+	}
+
+	b.tags = tagsFromList(tagging.TagSet)
+	c.writeResult(http.StatusNoContent, nil, "")
+
+	return nil
+}
+
+func (s *s3Service) deleteBucketTagging(c *call, b *s3Bucket, _ string) error {
+	b.tags = make(tags)
+	c.writeResult(http.StatusNoContent, nil, "")
+
+	return nil
+}
+
+type s3VersioningConfiguration struct {
+	MFADelete string `xml:"MfaDelete,omitempty"`
+	Status    string `xml:"Status,omitempty"`
+}
+
+func (s *s3Service) getBucketVersioning(c *call, b *s3Bucket, _ string) error {
+	c.writeResult(http.StatusOK, s3VersioningConfiguration{Status: b.versioning}, "VersioningConfiguration")
+
+	return nil
+}
+
+func (s *s3Service) putBucketVersioning(c *call, b *s3Bucket, _ string) error {
+	var configuration s3VersioningConfiguration
+	if err := c.readXML(&configuration); err != nil {
+		return err
+	}
+
+	switch configuration.Status {
+	case "Enabled", "Suspended":
+		b.versioning = configuration.Status
+	default:
+		return newError(http.StatusBadRequest, "IllegalVersioningConfigurationException", "The Versioning element must be specified")
+	}
+
+	c.writeResult(http.StatusOK, nil, "")
+
+	return nil
+}
+
+type s3ObjectSummary struct {
+	ETag         string `xml:"ETag"`
+	IsLatest     *bool  `xml:"IsLatest,omitempty"`
+	Key          string `xml:"Key"`
+	LastModified string `xml:"LastModified"`
+	Size         int    `xml:"Size"`
+	StorageClass string `xml:"StorageClass"`
+	VersionID    string `xml:"VersionId,omitempty"`
+}
+
+func (o *s3Object) summary() s3ObjectSummary {
+	storageClass := o.headers.Get("X-Amz-Storage-Class")
+	if storageClass == "" {
+		storageClass = "STANDARD"
+	}
+
+	return s3ObjectSummary{
+		ETag:         o.etag,
+		Key:          o.key,
+		LastModified: iso8601(o.lastModified),
+		Size:         len(o.body),
+		StorageClass: storageClass,
+	}
+}
+
+// objectsWithPrefix returns the bucket's objects whose keys begin with prefix, sorted by key.
+func (b *s3Bucket) objectsWithPrefix(prefix string) []*s3Object {
+	var objects []*s3Object
+
+	for key, o := range b.objects {
+		if strings.HasPrefix(key, prefix) {
+			objects = append(objects, o)
+		}
+	}
+	slices.SortFunc(objects, func(a, b *s3Object) int {
+		return strings.Compare(a.key, b.key)
+	})
+
+	return objects
+}
+
+type s3ListBucketResult struct {
+	Contents    []s3ObjectSummary `xml:"Contents"`
+	IsTruncated bool              `xml:"IsTruncated"`
+	KeyCount    int               `xml:"KeyCount"`
+	MaxKeys     int               `xml:"MaxKeys"`
+	Name        string            `xml:"Name"`
+	Prefix      string            `xml:"Prefix"`
+}
+
+func (s *s3Service) listObjectsV2(c *call, b *s3Bucket, _ string) error {
+	prefix := c.r.URL.Query().Get("prefix")
+	result := s3ListBucketResult{
+		MaxKeys: 1000,
+		Name:    b.name,
+		Prefix:  prefix,
+	}
+	for _, o := range b.objectsWithPrefix(prefix) {
+		result.Contents = append(result.Contents, o.summary())
+	}
+	result.KeyCount = len(result.Contents)
+
+	c.writeResult(http.StatusOK, result, "ListBucketResult")
+
+	return nil
+}
+
+type s3ListVersionsResult struct {
+	IsTruncated bool              `xml:"IsTruncated"`
+	Name        string            `xml:"Name"`
+	Prefix      string            `xml:"Prefix"`
+	Versions    []s3ObjectSummary `xml:"Version"`
+}
+
+func (s *s3Service) listObjectVersions(c *call, b *s3Bucket, _ string) error {
+	prefix := c.r.URL.Query().Get("prefix")
+	result := s3ListVersionsResult{
+		Name:   b.name,
+		Prefix: prefix,
+	}
+	isLatest := true
+	for _, o := range b.objectsWithPrefix(prefix) {
+		v := o.summary()
+		v.IsLatest = &isLatest
+		v.VersionID = o.versionID
+		if v.VersionID == "" {
+			v.VersionID = "null"
+		}
+		result.Versions = append(result.Versions, v)
+	}
+
+	c.writeResult(http.StatusOK, result, "ListVersionsResult")
+
+	return nil
+}
+
+type s3Delete struct {
+	Objects []s3ObjectIdentifier `xml:"Object"`
+}
+
+type s3DeleteResult struct {
+	Deleted []s3ObjectIdentifier `xml:"Deleted"`
+}
+
+type s3ObjectIdentifier struct {
+	Key       string `xml:"Key"`
+	VersionID string `xml:"VersionId,omitempty"`
+}
+
+func (s *s3Service) deleteObjects(c *call, b *s3Bucket, _ string) error {
+	var request s3Delete
+	if err := c.readXML(&request); err != nil {
+		return err
+	}
+
+	var result s3DeleteResult
+	for _, v := range request.Objects {
+		b.deleteObject(v.Key, v.VersionID)
+		result.Deleted = append(result.Deleted, v)
+	}
+
+	c.writeResult(http.StatusOK, result, "DeleteResult")
+
+	return nil
+}
+
+// object returns the object with the specified key. The caller must hold the lock.
+func (b *s3Bucket) object(key string) (*s3Object, *apiError) {
+	o, ok := b.objects[key]
+	if !ok {
+		return nil, newError(http.StatusNotFound, "NoSuchKey", "The specified key does not exist.")
+	}
+
+	return o, nil
+}
+
+// deleteObject deletes the current version of an object, or the specified version if it is current.
+func (b *s3Bucket) deleteObject(key, versionID string) {
+	if o, ok := b.objects[key]; ok && (versionID == "" || versionID == "null" || versionID == o.versionID) {
+		delete(b.objects, key)
+	}
+}
+
+func (s *s3Service) putObject(c *call, b *s3Bucket, key string) error {
+	if c.r.Header.Get("X-Amz-Copy-Source") != "" {
+		return newError(http.StatusNotImplemented, "NotImplemented", "CopyObject is not implemented")
+	}
+
+	body, err := io.ReadAll(c.r.Body)
+	if err != nil {
+		return err
+	}
+
+	sum := md5.Sum(body)
+	o := &s3Object{
+		body:         body,
+		etag:         `"` + hex.EncodeToString(sum[:]) + `"`,
+		headers:      make(http.Header),
+		key:          key,
+		lastModified: time.Now(),
+		metadata:     make(map[string]string),
+		tags:         make(tags),
+	}
+	for _, k := range s3ObjectHeaders {
+		if v := c.r.Header.Get(k); v != "" {
+			o.headers.Set(k, v)
+		}
+	}
+	if o.headers.Get("Content-Type") == "" {
+		o.headers.Set("Content-Type", "binary/octet-stream")
+	}
+	for k := range c.r.Header {
+		if name, ok := strings.CutPrefix(strings.ToLower(k), "x-amz-meta-"); ok {
+			o.metadata[name] = c.r.Header.Get(k)
+		}
+	}
+	if v := c.r.Header.Get("X-Amz-Tagging"); v != "" {
+		query, err := url.ParseQuery(v)
+		if err != nil {
+			return newError(http.StatusBadRequest, "InvalidArgument", "The header 'x-amz-tagging' shall be encoded as UTF-8 then URLEncoded URL query parameters without tag name duplicates.")
+		}
+		for k := range query {
+			o.tags[k] = query.Get(k)
+		}
+	}
+	if b.versioning == "Enabled" {
+		o.versionID = strconv.FormatInt(o.lastModified.UnixNano(), 36)
+	}
+
+	b.objects[key] = o
+
+	c.w.Header().Set("ETag", o.etag)
+	c.w.Header().Set("X-Amz-Server-Side-Encryption", "AES256")
+	if o.versionID != "" {
+		c.w.Header().Set("X-Amz-Version-Id", o.versionID)
+	}
+	c.writeResult(http.StatusOK, nil, "")
+
+	return nil
+}
+
+// getObject implements GetObject and HeadObject.
+func (s *s3Service) getObject(c *call, b *s3Bucket, key string) error {
+	o, err := b.object(key)
+	if err != nil {
+		return err
+	}
+
+	h := c.w.Header()
+	maps.Copy(h, o.headers)
+	for k, v := range o.metadata {
+		h.Set("X-Amz-Meta-"+k, v)
+	}
+	h.Set("Content-Length", strconv.Itoa(len(o.body)))
+	h.Set("ETag", o.etag)
+	h.Set("Last-Modified", o.lastModified.UTC().Format(http.TimeFormat))
+	h.Set("X-Amz-Server-Side-Encryption", "AES256")
+	if o.versionID != "" {
+		h.Set("X-Amz-Version-Id", o.versionID)
+	}
+	if len(o.tags) > 0 {
+		h.Set("X-Amz-Tagging-Count", strconv.Itoa(len(o.tags)))
+	}
+
+	c.writeHeader(http.StatusOK, o.headers.Get("Content-Type"))
+	if c.r.Method != http.MethodHead {
+		c.w.Write(o.body) //nolint:errcheck // Response already started.
+	}
+
+	return nil
+}
+
+func (s *s3Service) deleteObject(c *call, b *s3Bucket, key string) error {
+	// DeleteObject is idempotent.
+	b.deleteObject(key, c.r.URL.Query().Get("versionId"))
+	c.writeResult(http.StatusNoContent, nil, "")
+
+	return nil
+}
+
+func (s *s3Service) getObjectTagging(c *call, b *s3Bucket, key string) error {
+	o, err := b.object(key)
+	if err != nil {
+		return err
+	}
+
+	c.writeResult(http.StatusOK, s3Tagging{TagSet: o.tags.list()}, "Tagging")
+
+	return nil
+}
+
+func (s *s3Service) putObjectTagging(c *call, b *s3Bucket, key string) error {
+	o, err := b.object(key)
+	if err != nil {
+		return err
+	}
+
+	var tagging s3Tagging
+	if err := c.readXML(&tagging); err != nil {
+		return err
+	}
+
+	o.tags = tagsFromList(tagging.TagSet)
+	c.writeResult(http.StatusOK, nil, "")
+
+	return nil
+}
+
+func (s *s3Service) deleteObjectTagging(c *call, b *s3Bucket, key string) error {
+	o, err := b.object(key)
+	if err != nil {
+		return err
+	}
+
+	o.tags = make(tags)
+	c.writeResult(http.StatusNoContent, nil, "")
+
+	return nil
+}
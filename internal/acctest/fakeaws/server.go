@@ -0,0 +1,183 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package fakeaws implements a local, stateful, in-memory stand-in for a subset of AWS service APIs.
+//
+// The server speaks the AWS wire protocols (JSON, Query and REST-XML), so the provider's unmodified
+// AWS SDK for Go v2 clients can be pointed at it via endpoint overrides.
+// Only the operations needed to create, read, update, import and delete a small set of resources are
+// implemented: S3 buckets and objects, SQS queues, SNS topics, IAM roles, DynamoDB tables and SSM parameters.
+// Unsupported operations fail with the protocol's "unknown operation" error.
+package fakeaws
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+const (
+	// DefaultAccountID is the AWS account ID of the caller.
+	DefaultAccountID = "123456789012"
+	// DefaultPartition is the AWS partition of the server's ARNs.
+	DefaultPartition = "aws"
+)
+
+// Services are the signing names of the services that the server implements.
+var Services = []string{
+	"dynamodb",
+	"iam",
+	"s3",
+	"sns",
+	"sqs",
+	"ssm",
+	"sts",
+}
+
+// Server is a local AWS API stand-in.
+// All state is held in memory and is discarded when the server is closed.
+type Server struct {
+	AccountID string
+	Partition string
+
+	httpServer *httptest.Server
+	requestID  atomic.Int64
+
+	mu    sync.Mutex
+	calls []string
+
+	dynamodb *dynamoDBService
+	iam      *iamService
+	s3       *s3Service
+	sns      *snsService
+	sqs      *sqsService
+	ssm      *ssmService
+}
+
+// NewServer starts and returns a new server.
+// The caller should call Close when finished, to shut it down.
+func NewServer() *Server {
+	s := &Server{
+		AccountID: DefaultAccountID,
+		Partition: DefaultPartition,
+		dynamodb:  newDynamoDBService(),
+		iam:       newIAMService(),
+		s3:        newS3Service(),
+		sns:       newSNSService(),
+		sqs:       newSQSService(),
+		ssm:       newSSMService(),
+	}
+	s.httpServer = httptest.NewServer(s)
+
+	return s
+}
+
+// New starts a new server that is shut down when the test and all its subtests complete.
+func New(t testing.TB) *Server {
+	t.Helper()
+
+	s := NewServer()
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+// URL returns the base URL of the server, of the form http://ipaddr:port with no trailing slash.
+func (s *Server) URL() string {
+	return s.httpServer.URL
+}
+
+// Close shuts down the server.
+func (s *Server) Close() {
+	s.httpServer.Close()
+}
+
+// Calls returns the API calls made to the server, in order, as "<service>:<Operation>".
+func (s *Server) Calls() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return slices.Clone(s.calls)
+}
+
+func (s *Server) recordCall(service, operation string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.calls = append(s.calls, service+":"+operation)
+}
+
+// Resources returns the ARNs of all the resources held by the server, sorted.
+// A test can verify that all its resources have been destroyed by checking that the result is empty.
+func (s *Server) Resources() []string {
+	var arns []string
+
+	arns = append(arns, s.dynamodb.resources()...)
+	arns = append(arns, s.iam.resources()...)
+	arns = append(arns, s.s3.resources(s.Partition)...)
+	arns = append(arns, s.sns.resources()...)
+	arns = append(arns, s.sqs.resources()...)
+	arns = append(arns, s.ssm.resources()...)
+	slices.Sort(arns)
+
+	return arns
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	region, service := credentialScope(r)
+
+	c := &call{
+		w:         w,
+		r:         r,
+		server:    s,
+		region:    region,
+		service:   service,
+		requestID: fmt.Sprintf("fakeaws-%08d", s.requestID.Add(1)),
+	}
+
+	switch service {
+	case "dynamodb":
+		c.serveJSON("DynamoDB_20120810", "application/x-amz-json-1.0", s.dynamodb.handlers())
+	case "iam":
+		c.serveQuery("https://iam.amazonaws.com/doc/2010-05-08/", s.iam.handlers())
+	case "s3":
+		s.s3.serve(c)
+	case "sns":
+		c.serveQuery("http://sns.amazonaws.com/doc/2010-03-31/", s.sns.handlers())
+	case "sqs":
+		c.serveJSON("AmazonSQS", "application/x-amz-json-1.0", s.sqs.handlers())
+	case "ssm":
+		c.serveJSON("AmazonSSM", "application/x-amz-json-1.1", s.ssm.handlers())
+	case "sts":
+		c.serveQuery("https://sts.amazonaws.com/doc/2011-06-15/", stsHandlers())
+	default:
+		http.Error(w, fmt.Sprintf("unsupported service %q", service), http.StatusNotImplemented)
+	}
+}
+
+// credentialScope returns the Region and signing name from a request's SigV4 Authorization header.
+func credentialScope(r *http.Request) (string, string) {
+	_, v, ok := strings.Cut(r.Header.Get("Authorization"), "Credential=")
+	if !ok {
+		return "", ""
+	}
+
+	v, _, _ = strings.Cut(v, ",")
+	// <access-key>/<date>/<region>/<service>/aws4_request.
+	parts := strings.Split(v, "/")
+	if len(parts) != 5 {
+		return "", ""
+	}
+
+	return parts[2], parts[3]
+}
+
+// arn returns an ARN for a resource in the server's partition and account.
+func (s *Server) arn(service, region, resource string) string {
+	return fmt.Sprintf("arn:%s:%s:%s:%s:%s", s.Partition, service, region, s.AccountID, resource)
+}
@@ -0,0 +1,486 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeaws_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	dynamodbtypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	sqstypes "github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/fakeaws"
+)
+
+func testConfig(server *fakeaws.Server) aws.Config {
+	return aws.Config{
+		BaseEndpoint: aws.String(server.URL()),
+		Credentials:  credentials.NewStaticCredentialsProvider("AKIAFAKEAWS", "secret", ""),
+		Region:       "us-west-2",
+	}
+}
+
+func TestSTS(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	server := fakeaws.New(t)
+	conn := sts.NewFromConfig(testConfig(server))
+
+	output, err := conn.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		t.Fatalf("GetCallerIdentity: %s", err)
+	}
+
+	if got, want := aws.ToString(output.Account), fakeaws.DefaultAccountID; got != want {
+		t.Errorf("Account = %q, want %q", got, want)
+	}
+	if got, want := aws.ToString(output.Arn), "arn:aws:iam::123456789012:user/fakeaws"; got != want {
+		t.Errorf("Arn = %q, want %q", got, want)
+	}
+}
+
+func TestS3(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	server := fakeaws.New(t)
+	conn := s3.NewFromConfig(testConfig(server), func(o *s3.Options) {
+		o.UsePathStyle = true
+	})
+	bucket := "tf-test-bucket"
+
+	if _, err := conn.CreateBucket(ctx, &s3.CreateBucketInput{
+		Bucket: aws.String(bucket),
+		CreateBucketConfiguration: &s3types.CreateBucketConfiguration{
+			LocationConstraint: s3types.BucketLocationConstraintUsWest2,
+		},
+	}); err != nil {
+		t.Fatalf("CreateBucket: %s", err)
+	}
+
+	region, err := manager.GetBucketRegion(ctx, conn, bucket, func(o *s3.Options) {
+		o.UsePathStyle = true
+	})
+	if err != nil {
+		t.Fatalf("GetBucketRegion: %s", err)
+	}
+	if got, want := region, "us-west-2"; got != want {
+		t.Errorf("region = %q, want %q", got, want)
+	}
+
+	_, err = conn.GetBucketTagging(ctx, &s3.GetBucketTaggingInput{Bucket: aws.String(bucket)})
+	if !tfawserr.ErrCodeEquals(err, "NoSuchTagSet") {
+		t.Errorf("GetBucketTagging: got %v, want NoSuchTagSet", err)
+	}
+
+	_, err = conn.GetBucketPolicy(ctx, &s3.GetBucketPolicyInput{Bucket: aws.String(bucket)})
+	if !tfawserr.ErrCodeEquals(err, "NoSuchBucketPolicy") {
+		t.Errorf("GetBucketPolicy: got %v, want NoSuchBucketPolicy", err)
+	}
+
+	_, err = conn.GetBucketAccelerateConfiguration(ctx, &s3.GetBucketAccelerateConfigurationInput{Bucket: aws.String(bucket)})
+	if !tfawserr.ErrCodeEquals(err, "NotImplemented") {
+		t.Errorf("GetBucketAccelerateConfiguration: got %v, want NotImplemented", err)
+	}
+
+	if _, err := conn.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(bucket),
+		Key:         aws.String("dir/key.txt"),
+		Body:        strings.NewReader("hello"),
+		ContentType: aws.String("text/plain"),
+		Metadata:    map[string]string{"owner": "tf"},
+		Tagging:     aws.String("Name=test"),
+	}); err != nil {
+		t.Fatalf("PutObject: %s", err)
+	}
+
+	head, err := conn.HeadObject(ctx, &s3.HeadObjectInput{Bucket: aws.String(bucket), Key: aws.String("dir/key.txt")})
+	if err != nil {
+		t.Fatalf("HeadObject: %s", err)
+	}
+	if got, want := aws.ToString(head.ETag), `"5d41402abc4b2a76b9719d911017c592"`; got != want {
+		t.Errorf("ETag = %q, want %q", got, want)
+	}
+	if got, want := aws.ToString(head.ContentType), "text/plain"; got != want {
+		t.Errorf("ContentType = %q, want %q", got, want)
+	}
+	if got, want := head.Metadata["owner"], "tf"; got != want {
+		t.Errorf("Metadata[owner] = %q, want %q", got, want)
+	}
+
+	object, err := conn.GetObject(ctx, &s3.GetObjectInput{Bucket: aws.String(bucket), Key: aws.String("dir/key.txt")})
+	if err != nil {
+		t.Fatalf("GetObject: %s", err)
+	}
+	body, err := io.ReadAll(object.Body)
+	object.Body.Close()
+	if err != nil {
+		t.Fatalf("reading object body: %s", err)
+	}
+	if got, want := string(body), "hello"; got != want {
+		t.Errorf("body = %q, want %q", got, want)
+	}
+
+	tagging, err := conn.GetObjectTagging(ctx, &s3.GetObjectTaggingInput{Bucket: aws.String(bucket), Key: aws.String("dir/key.txt")})
+	if err != nil {
+		t.Fatalf("GetObjectTagging: %s", err)
+	}
+	if len(tagging.TagSet) != 1 || aws.ToString(tagging.TagSet[0].Key) != "Name" || aws.ToString(tagging.TagSet[0].Value) != "test" {
+		t.Errorf("TagSet = %v, want Name=test", tagging.TagSet)
+	}
+
+	list, err := conn.ListObjectsV2(ctx, &s3.ListObjectsV2Input{Bucket: aws.String(bucket), Prefix: aws.String("dir/")})
+	if err != nil {
+		t.Fatalf("ListObjectsV2: %s", err)
+	}
+	if len(list.Contents) != 1 || aws.ToString(list.Contents[0].Key) != "dir/key.txt" {
+		t.Errorf("Contents = %v, want dir/key.txt", list.Contents)
+	}
+
+	_, err = conn.DeleteBucket(ctx, &s3.DeleteBucketInput{Bucket: aws.String(bucket)})
+	if !tfawserr.ErrCodeEquals(err, "BucketNotEmpty") {
+		t.Errorf("DeleteBucket: got %v, want BucketNotEmpty", err)
+	}
+
+	if _, err := conn.DeleteObject(ctx, &s3.DeleteObjectInput{Bucket: aws.String(bucket), Key: aws.String("dir/key.txt")}); err != nil {
+		t.Fatalf("DeleteObject: %s", err)
+	}
+
+	_, err = conn.HeadObject(ctx, &s3.HeadObjectInput{Bucket: aws.String(bucket), Key: aws.String("dir/key.txt")})
+	if !tfawserr.ErrHTTPStatusCodeEquals(err, http.StatusNotFound) {
+		t.Errorf("HeadObject: got %v, want 404", err)
+	}
+
+	if _, err := conn.DeleteBucket(ctx, &s3.DeleteBucketInput{Bucket: aws.String(bucket)}); err != nil {
+		t.Fatalf("DeleteBucket: %s", err)
+	}
+
+	_, err = conn.HeadBucket(ctx, &s3.HeadBucketInput{Bucket: aws.String(bucket)})
+	if !tfawserr.ErrHTTPStatusCodeEquals(err, http.StatusNotFound) {
+		t.Errorf("HeadBucket: got %v, want 404", err)
+	}
+}
+
+func TestSQS(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	server := fakeaws.New(t)
+	conn := sqs.NewFromConfig(testConfig(server))
+
+	create, err := conn.CreateQueue(ctx, &sqs.CreateQueueInput{
+		QueueName:  aws.String("tf-test-queue"),
+		Attributes: map[string]string{"VisibilityTimeout": "60"},
+		Tags:       map[string]string{"Name": "test"},
+	})
+	if err != nil {
+		t.Fatalf("CreateQueue: %s", err)
+	}
+
+	attributes, err := conn.GetQueueAttributes(ctx, &sqs.GetQueueAttributesInput{
+		QueueUrl:       create.QueueUrl,
+		AttributeNames: []sqstypes.QueueAttributeName{sqstypes.QueueAttributeNameAll},
+	})
+	if err != nil {
+		t.Fatalf("GetQueueAttributes: %s", err)
+	}
+	if got, want := attributes.Attributes["VisibilityTimeout"], "60"; got != want {
+		t.Errorf("VisibilityTimeout = %q, want %q", got, want)
+	}
+	if got, want := attributes.Attributes["QueueArn"], "arn:aws:sqs:us-west-2:123456789012:tf-test-queue"; got != want {
+		t.Errorf("QueueArn = %q, want %q", got, want)
+	}
+
+	tags, err := conn.ListQueueTags(ctx, &sqs.ListQueueTagsInput{QueueUrl: create.QueueUrl})
+	if err != nil {
+		t.Fatalf("ListQueueTags: %s", err)
+	}
+	if got, want := tags.Tags["Name"], "test"; got != want {
+		t.Errorf("Tags[Name] = %q, want %q", got, want)
+	}
+
+	if _, err := conn.DeleteQueue(ctx, &sqs.DeleteQueueInput{QueueUrl: create.QueueUrl}); err != nil {
+		t.Fatalf("DeleteQueue: %s", err)
+	}
+
+	// The provider checks for the AWS Query-compatible error code.
+	_, err = conn.GetQueueAttributes(ctx, &sqs.GetQueueAttributesInput{QueueUrl: create.QueueUrl})
+	if !tfawserr.ErrCodeEquals(err, "AWS.SimpleQueueService.NonExistentQueue") {
+		t.Errorf("GetQueueAttributes: got %v, want AWS.SimpleQueueService.NonExistentQueue", err)
+	}
+}
+
+func TestSNS(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	server := fakeaws.New(t)
+	conn := sns.NewFromConfig(testConfig(server))
+
+	create, err := conn.CreateTopic(ctx, &sns.CreateTopicInput{
+		Name:       aws.String("tf-test-topic"),
+		Attributes: map[string]string{"DisplayName": "Test"},
+	})
+	if err != nil {
+		t.Fatalf("CreateTopic: %s", err)
+	}
+	if got, want := aws.ToString(create.TopicArn), "arn:aws:sns:us-west-2:123456789012:tf-test-topic"; got != want {
+		t.Errorf("TopicArn = %q, want %q", got, want)
+	}
+
+	attributes, err := conn.GetTopicAttributes(ctx, &sns.GetTopicAttributesInput{TopicArn: create.TopicArn})
+	if err != nil {
+		t.Fatalf("GetTopicAttributes: %s", err)
+	}
+	if got, want := attributes.Attributes["DisplayName"], "Test"; got != want {
+		t.Errorf("DisplayName = %q, want %q", got, want)
+	}
+	if attributes.Attributes["Policy"] == "" {
+		t.Error("Policy is empty")
+	}
+
+	if _, err := conn.DeleteTopic(ctx, &sns.DeleteTopicInput{TopicArn: create.TopicArn}); err != nil {
+		t.Fatalf("DeleteTopic: %s", err)
+	}
+
+	_, err = conn.GetTopicAttributes(ctx, &sns.GetTopicAttributesInput{TopicArn: create.TopicArn})
+	if !tfawserr.ErrCodeEquals(err, "NotFound") {
+		t.Errorf("GetTopicAttributes: got %v, want NotFound", err)
+	}
+}
+
+func TestIAM(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	server := fakeaws.New(t)
+	cfg := testConfig(server)
+	cfg.Region = "us-east-1"
+	conn := iam.NewFromConfig(cfg)
+	policy := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"ec2.amazonaws.com"},"Action":"sts:AssumeRole"}]}`
+
+	if _, err := conn.CreateRole(ctx, &iam.CreateRoleInput{
+		RoleName:                 aws.String("tf-test-role"),
+		AssumeRolePolicyDocument: aws.String(policy),
+	}); err != nil {
+		t.Fatalf("CreateRole: %s", err)
+	}
+
+	role, err := conn.GetRole(ctx, &iam.GetRoleInput{RoleName: aws.String("tf-test-role")})
+	if err != nil {
+		t.Fatalf("GetRole: %s", err)
+	}
+	if got, want := aws.ToString(role.Role.Arn), "arn:aws:iam::123456789012:role/tf-test-role"; got != want {
+		t.Errorf("Arn = %q, want %q", got, want)
+	}
+
+	if _, err := conn.AttachRolePolicy(ctx, &iam.AttachRolePolicyInput{
+		RoleName:  aws.String("tf-test-role"),
+		PolicyArn: aws.String("arn:aws:iam::aws:policy/ReadOnlyAccess"),
+	}); err != nil {
+		t.Fatalf("AttachRolePolicy: %s", err)
+	}
+
+	_, err = conn.DeleteRole(ctx, &iam.DeleteRoleInput{RoleName: aws.String("tf-test-role")})
+	if !tfawserr.ErrCodeEquals(err, "DeleteConflict") {
+		t.Errorf("DeleteRole: got %v, want DeleteConflict", err)
+	}
+
+	if _, err := conn.DetachRolePolicy(ctx, &iam.DetachRolePolicyInput{
+		RoleName:  aws.String("tf-test-role"),
+		PolicyArn: aws.String("arn:aws:iam::aws:policy/ReadOnlyAccess"),
+	}); err != nil {
+		t.Fatalf("DetachRolePolicy: %s", err)
+	}
+
+	if _, err := conn.DeleteRole(ctx, &iam.DeleteRoleInput{RoleName: aws.String("tf-test-role")}); err != nil {
+		t.Fatalf("DeleteRole: %s", err)
+	}
+
+	_, err = conn.GetRole(ctx, &iam.GetRoleInput{RoleName: aws.String("tf-test-role")})
+	if !tfawserr.ErrCodeEquals(err, "NoSuchEntity") {
+		t.Errorf("GetRole: got %v, want NoSuchEntity", err)
+	}
+}
+
+func TestDynamoDB(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	server := fakeaws.New(t)
+	conn := dynamodb.NewFromConfig(testConfig(server))
+
+	create, err := conn.CreateTable(ctx, &dynamodb.CreateTableInput{
+		TableName:   aws.String("tf-test-table"),
+		BillingMode: dynamodbtypes.BillingModePayPerRequest,
+		AttributeDefinitions: []dynamodbtypes.AttributeDefinition{
+			{AttributeName: aws.String("pk"), AttributeType: dynamodbtypes.ScalarAttributeTypeS},
+		},
+		KeySchema: []dynamodbtypes.KeySchemaElement{
+			{AttributeName: aws.String("pk"), KeyType: dynamodbtypes.KeyTypeHash},
+		},
+		Tags: []dynamodbtypes.Tag{
+			{Key: aws.String("Name"), Value: aws.String("test")},
+		},
+	})
+	if err != nil {
+		t.Fatalf("CreateTable: %s", err)
+	}
+
+	table, err := conn.DescribeTable(ctx, &dynamodb.DescribeTableInput{TableName: aws.String("tf-test-table")})
+	if err != nil {
+		t.Fatalf("DescribeTable: %s", err)
+	}
+	if got, want := table.Table.TableStatus, dynamodbtypes.TableStatusActive; got != want {
+		t.Errorf("TableStatus = %q, want %q", got, want)
+	}
+	if got, want := table.Table.BillingModeSummary.BillingMode, dynamodbtypes.BillingModePayPerRequest; got != want {
+		t.Errorf("BillingMode = %q, want %q", got, want)
+	}
+
+	if _, err := conn.UpdateTimeToLive(ctx, &dynamodb.UpdateTimeToLiveInput{
+		TableName: aws.String("tf-test-table"),
+		TimeToLiveSpecification: &dynamodbtypes.TimeToLiveSpecification{
+			AttributeName: aws.String("expires"),
+			Enabled:       aws.Bool(true),
+		},
+	}); err != nil {
+		t.Fatalf("UpdateTimeToLive: %s", err)
+	}
+
+	ttl, err := conn.DescribeTimeToLive(ctx, &dynamodb.DescribeTimeToLiveInput{TableName: aws.String("tf-test-table")})
+	if err != nil {
+		t.Fatalf("DescribeTimeToLive: %s", err)
+	}
+	if got, want := aws.ToString(ttl.TimeToLiveDescription.AttributeName), "expires"; got != want {
+		t.Errorf("AttributeName = %q, want %q", got, want)
+	}
+
+	tags, err := conn.ListTagsOfResource(ctx, &dynamodb.ListTagsOfResourceInput{ResourceArn: create.TableDescription.TableArn})
+	if err != nil {
+		t.Fatalf("ListTagsOfResource: %s", err)
+	}
+	if len(tags.Tags) != 1 || aws.ToString(tags.Tags[0].Value) != "test" {
+		t.Errorf("Tags = %v, want Name=test", tags.Tags)
+	}
+
+	if _, err := conn.DeleteTable(ctx, &dynamodb.DeleteTableInput{TableName: aws.String("tf-test-table")}); err != nil {
+		t.Fatalf("DeleteTable: %s", err)
+	}
+
+	_, err = conn.DescribeTable(ctx, &dynamodb.DescribeTableInput{TableName: aws.String("tf-test-table")})
+	if !tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
+		t.Errorf("DescribeTable: got %v, want ResourceNotFoundException", err)
+	}
+}
+
+func TestSSM(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	server := fakeaws.New(t)
+	conn := ssm.NewFromConfig(testConfig(server))
+
+	if _, err := conn.PutParameter(ctx, &ssm.PutParameterInput{
+		Name:  aws.String("/tf/test"),
+		Type:  ssmtypes.ParameterTypeString,
+		Value: aws.String("v1"),
+	}); err != nil {
+		t.Fatalf("PutParameter: %s", err)
+	}
+
+	_, err := conn.PutParameter(ctx, &ssm.PutParameterInput{
+		Name:  aws.String("/tf/test"),
+		Type:  ssmtypes.ParameterTypeString,
+		Value: aws.String("v2"),
+	})
+	if !tfawserr.ErrCodeEquals(err, "ParameterAlreadyExists") {
+		t.Errorf("PutParameter: got %v, want ParameterAlreadyExists", err)
+	}
+
+	if _, err := conn.PutParameter(ctx, &ssm.PutParameterInput{
+		Name:      aws.String("/tf/test"),
+		Overwrite: aws.Bool(true),
+		Value:     aws.String("v2"),
+	}); err != nil {
+		t.Fatalf("PutParameter: %s", err)
+	}
+
+	parameter, err := conn.GetParameter(ctx, &ssm.GetParameterInput{Name: aws.String("/tf/test")})
+	if err != nil {
+		t.Fatalf("GetParameter: %s", err)
+	}
+	if got, want := aws.ToString(parameter.Parameter.Value), "v2"; got != want {
+		t.Errorf("Value = %q, want %q", got, want)
+	}
+	if got, want := parameter.Parameter.Version, int64(2); got != want {
+		t.Errorf("Version = %d, want %d", got, want)
+	}
+
+	if _, err := conn.DeleteParameter(ctx, &ssm.DeleteParameterInput{Name: aws.String("/tf/test")}); err != nil {
+		t.Fatalf("DeleteParameter: %s", err)
+	}
+
+	_, err = conn.GetParameter(ctx, &ssm.GetParameterInput{Name: aws.String("/tf/test")})
+	if !tfawserr.ErrCodeEquals(err, "ParameterNotFound") {
+		t.Errorf("GetParameter: got %v, want ParameterNotFound", err)
+	}
+}
+
+func TestUnsupportedOperation(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	server := fakeaws.New(t)
+	conn := sqs.NewFromConfig(testConfig(server))
+
+	_, err := conn.PurgeQueue(ctx, &sqs.PurgeQueueInput{QueueUrl: aws.String(server.URL() + "/123456789012/q")})
+	if !tfawserr.ErrCodeEquals(err, "UnknownOperationException") {
+		t.Errorf("PurgeQueue: got %v, want UnknownOperationException", err)
+	}
+
+	if got, want := server.Calls(), []string{"sqs:PurgeQueue"}; !slices.Equal(got, want) {
+		t.Errorf("Calls = %v, want %v", got, want)
+	}
+}
+
+func TestResources(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	server := fakeaws.New(t)
+	cfg := testConfig(server)
+
+	if _, err := sns.NewFromConfig(cfg).CreateTopic(ctx, &sns.CreateTopicInput{Name: aws.String("b")}); err != nil {
+		t.Fatalf("CreateTopic: %s", err)
+	}
+	if _, err := sqs.NewFromConfig(cfg).CreateQueue(ctx, &sqs.CreateQueueInput{QueueName: aws.String("a")}); err != nil {
+		t.Fatalf("CreateQueue: %s", err)
+	}
+
+	want := []string{
+		"arn:aws:sns:us-west-2:123456789012:b",
+		"arn:aws:sqs:us-west-2:123456789012:a",
+	}
+	if got := server.Resources(); !slices.Equal(got, want) {
+		t.Errorf("Resources = %v, want %v", got, want)
+	}
+}
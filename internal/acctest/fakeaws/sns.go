@@ -0,0 +1,281 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeaws
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// snsService implements Amazon SNS topics.
+type snsService struct {
+	mu     sync.Mutex
+	topics map[string]*snsTopic // Keyed by ARN.
+}
+
+type snsTopic struct {
+	arn        string
+	attributes map[string]string
+	tags       tags
+}
+
+func newSNSService() *snsService {
+	return &snsService{
+		topics: make(map[string]*snsTopic),
+	}
+}
+
+func (s *snsService) handlers() map[string]queryHandler {
+	return map[string]queryHandler{
+		"CreateTopic":         s.createTopic,
+		"DeleteTopic":         s.deleteTopic,
+		"GetTopicAttributes":  s.getTopicAttributes,
+		"ListTagsForResource": s.listTagsForResource,
+		"ListTopics":          s.listTopics,
+		"SetTopicAttributes":  s.setTopicAttributes,
+		"TagResource":         s.tagResource,
+		"UntagResource":       s.untagResource,
+	}
+}
+
+func snsTopicNotFound() *apiError {
+	return newError(http.StatusNotFound, "NotFound", "Topic does not exist")
+}
+
+func (s *snsService) resources() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var arns []string
+	for arn := range s.topics {
+		arns = append(arns, arn)
+	}
+
+	return arns
+}
+
+// topic returns the topic with the specified ARN. The caller must hold the lock.
+func (s *snsService) topic(arn string) (*snsTopic, *apiError) {
+	t, ok := s.topics[arn]
+	if !ok {
+		return nil, snsTopicNotFound()
+	}
+
+	return t, nil
+}
+
+// snsDefaultTopicPolicy is the access policy of a new topic.
+const snsDefaultTopicPolicy = `{"Version":"2008-10-17","Id":"__default_policy_ID","Statement":[{"Sid":"__default_statement_ID","Effect":"Allow","Principal":{"AWS":"*"},"Action":["SNS:GetTopicAttributes","SNS:SetTopicAttributes","SNS:AddPermission","SNS:RemovePermission","SNS:DeleteTopic","SNS:Subscribe","SNS:ListSubscriptionsByTopic","SNS:Publish"],"Resource":"%[1]s","Condition":{"StringEquals":{"AWS:SourceOwner":"%[2]s"}}}]}`
+
+// snsDefaultEffectiveDeliveryPolicy is the effective delivery policy of a topic without a delivery policy.
+const snsDefaultEffectiveDeliveryPolicy = `{"http":{"defaultHealthyRetryPolicy":{"minDelayTarget":20,"maxDelayTarget":20,"numRetries":3,"numMaxDelayRetries":0,"numNoDelayRetries":0,"numMinDelayRetries":0,"backoffFunction":"linear"},"disableSubscriptionOverrides":false,"defaultRequestPolicy":{"headerContentType":"text/plain; charset=UTF-8"}}}`
+
+type snsTopicARNResult struct {
+	TopicArn string `xml:"TopicArn"`
+}
+
+func (s *snsService) createTopic(c *call, form url.Values) (any, error) {
+	name := form.Get("Name")
+	if name == "" {
+		return nil, newError(http.StatusBadRequest, "InvalidParameter", "Invalid parameter: Topic Name")
+	}
+
+	attributes := queryMap(form, "Attributes")
+	fifo := strings.HasSuffix(name, ".fifo")
+	if (attributes["FifoTopic"] == "true") != fifo {
+		return nil, newError(http.StatusBadRequest, "InvalidParameter", "Invalid parameter: Fifo Topic names must end with .fifo and must be made up of only uppercase and lowercase ASCII letters, numbers, underscores, and hyphens, and must be between 1 and 256 characters long.")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	arn := c.arn(name)
+	if _, ok := s.topics[arn]; ok {
+		// CreateTopic is idempotent.
+		return &snsTopicARNResult{TopicArn: arn}, nil
+	}
+
+	t := &snsTopic{
+		arn: arn,
+		attributes: map[string]string{
+			"DisplayName":             "",
+			"EffectiveDeliveryPolicy": snsDefaultEffectiveDeliveryPolicy,
+			"Owner":                   c.server.AccountID,
+			"Policy":                  fmt.Sprintf(snsDefaultTopicPolicy, arn, c.server.AccountID),
+			"SubscriptionsConfirmed":  "0",
+			"SubscriptionsDeleted":    "0",
+			"SubscriptionsPending":    "0",
+			"TopicArn":                arn,
+		},
+		tags: tagsFromQuery(form, "Tags"),
+	}
+	if fifo {
+		t.attributes["ContentBasedDeduplication"] = "false"
+	}
+	for k, v := range attributes {
+		t.attributes[k] = v
+	}
+
+	s.topics[arn] = t
+
+	return &snsTopicARNResult{TopicArn: arn}, nil
+}
+
+type snsGetTopicAttributesResult struct {
+	Attributes []snsMapEntry `xml:"Attributes>entry"`
+}
+
+type snsMapEntry struct {
+	Key   string `xml:"key"`
+	Value string `xml:"value"`
+}
+
+func (s *snsService) getTopicAttributes(_ *call, form url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t, err := s.topic(form.Get("TopicArn"))
+	if err != nil {
+		return nil, err
+	}
+
+	result := &snsGetTopicAttributesResult{}
+	for k, v := range t.attributes {
+		result.Attributes = append(result.Attributes, snsMapEntry{Key: k, Value: v})
+	}
+	slices.SortFunc(result.Attributes, func(a, b snsMapEntry) int {
+		return strings.Compare(a.Key, b.Key)
+	})
+
+	return result, nil
+}
+
+func (s *snsService) setTopicAttributes(_ *call, form url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t, err := s.topic(form.Get("TopicArn"))
+	if err != nil {
+		return nil, err
+	}
+
+	name, value := form.Get("AttributeName"), form.Get("AttributeValue")
+	switch name {
+	case "":
+		return nil, newError(http.StatusBadRequest, "InvalidParameter", "Invalid parameter: AttributeName")
+	case "FifoTopic":
+		return nil, newError(http.StatusBadRequest, "InvalidParameter", "Invalid parameter: AttributeName")
+	case "DeliveryPolicy":
+		if value == "" {
+			delete(t.attributes, name)
+			t.attributes["EffectiveDeliveryPolicy"] = snsDefaultEffectiveDeliveryPolicy
+		} else {
+			t.attributes[name] = value
+			t.attributes["EffectiveDeliveryPolicy"] = value
+		}
+	case "Policy":
+		if value == "" {
+			value = fmt.Sprintf(snsDefaultTopicPolicy, t.arn, t.attributes["Owner"])
+		}
+		t.attributes[name] = value
+	default:
+		if value == "" && name != "DisplayName" {
+			delete(t.attributes, name)
+		} else {
+			t.attributes[name] = value
+		}
+	}
+
+	return nil, nil
+}
+
+func (s *snsService) deleteTopic(_ *call, form url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// DeleteTopic is idempotent.
+	delete(s.topics, form.Get("TopicArn"))
+
+	return nil, nil
+}
+
+type snsListTopicsResult struct {
+	Topics []snsTopicARNResult `xml:"Topics>member"`
+}
+
+func (s *snsService) listTopics(c *call, _ url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result := &snsListTopicsResult{}
+	for arn := range s.topics {
+		if strings.HasPrefix(arn, c.arn("")) {
+			result.Topics = append(result.Topics, snsTopicARNResult{TopicArn: arn})
+		}
+	}
+	slices.SortFunc(result.Topics, func(a, b snsTopicARNResult) int {
+		return strings.Compare(a.TopicArn, b.TopicArn)
+	})
+
+	return result, nil
+}
+
+func (s *snsService) taggedTopic(form url.Values) (*snsTopic, *apiError) {
+	t, err := s.topic(form.Get("ResourceArn"))
+	if err != nil {
+		return nil, newError(http.StatusNotFound, "ResourceNotFound", "Resource does not exist")
+	}
+
+	return t, nil
+}
+
+func (s *snsService) tagResource(_ *call, form url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t, err := s.taggedTopic(form)
+	if err != nil {
+		return nil, err
+	}
+
+	t.tags.merge(tagsFromQuery(form, "Tags"))
+
+	return nil, nil
+}
+
+func (s *snsService) untagResource(_ *call, form url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t, err := s.taggedTopic(form)
+	if err != nil {
+		return nil, err
+	}
+
+	t.tags.remove(queryList(form, "TagKeys"))
+
+	return nil, nil
+}
+
+type snsListTagsForResourceResult struct {
+	Tags []tag `xml:"Tags>member"`
+}
+
+func (s *snsService) listTagsForResource(_ *call, form url.Values) (any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t, err := s.taggedTopic(form)
+	if err != nil {
+		return nil, err
+	}
+
+	return &snsListTagsForResourceResult{
+		Tags: t.tags.list(),
+	}, nil
+}
@@ -0,0 +1,346 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeaws
+
+import (
+	"maps"
+	"net/http"
+	"net/url"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// sqsService implements Amazon SQS queues.
+type sqsService struct {
+	mu     sync.Mutex
+	queues map[string]*sqsQueue // Keyed by Region and name.
+}
+
+type sqsQueue struct {
+	attributes map[string]string
+	name       string
+	tags       tags
+	url        string
+}
+
+func newSQSService() *sqsService {
+	return &sqsService{
+		queues: make(map[string]*sqsQueue),
+	}
+}
+
+func (s *sqsService) handlers() map[string]jsonHandler {
+	return map[string]jsonHandler{
+		"CreateQueue":        jsonOperation(s.createQueue),
+		"DeleteQueue":        jsonOperation(s.deleteQueue),
+		"GetQueueAttributes": jsonOperation(s.getQueueAttributes),
+		"GetQueueUrl":        jsonOperation(s.getQueueURL),
+		"ListQueueTags":      jsonOperation(s.listQueueTags),
+		"ListQueues":         jsonOperation(s.listQueues),
+		"SetQueueAttributes": jsonOperation(s.setQueueAttributes),
+		"TagQueue":           jsonOperation(s.tagQueue),
+		"UntagQueue":         jsonOperation(s.untagQueue),
+	}
+}
+
+func sqsQueueNotFound() *apiError {
+	err := newError(http.StatusBadRequest, "com.amazonaws.sqs#QueueDoesNotExist", "The specified queue does not exist.")
+	err.queryCode = "AWS.SimpleQueueService.NonExistentQueue"
+
+	return err
+}
+
+func (s *sqsService) resources() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var arns []string
+	for _, q := range s.queues {
+		arns = append(arns, q.attributes["QueueArn"])
+	}
+
+	return arns
+}
+
+// sqsDeletableAttributes are attributes that are removed when set to an empty value.
+var sqsDeletableAttributes = []string{
+	"KmsMasterKeyId",
+	"Policy",
+	"RedriveAllowPolicy",
+	"RedrivePolicy",
+}
+
+// queue returns the queue with the specified URL. The caller must hold the lock.
+func (s *sqsService) queue(c *call, queueURL string) (*sqsQueue, *apiError) {
+	u, err := url.Parse(queueURL)
+	if err != nil {
+		return nil, sqsQueueNotFound()
+	}
+
+	q, ok := s.queues[c.region+"/"+path.Base(u.Path)]
+	if !ok {
+		return nil, sqsQueueNotFound()
+	}
+
+	return q, nil
+}
+
+func (q *sqsQueue) setAttributes(attributes map[string]string) {
+	for k, v := range attributes {
+		if v == "" && slices.Contains(sqsDeletableAttributes, k) {
+			delete(q.attributes, k)
+			continue
+		}
+		q.attributes[k] = v
+	}
+
+	q.attributes["LastModifiedTimestamp"] = strconv.FormatInt(time.Now().Unix(), 10)
+}
+
+type sqsCreateQueueInput struct {
+	Attributes map[string]string
+	QueueName  string
+	Tags       map[string]string
+}
+
+type sqsQueueURLOutput struct {
+	QueueUrl string
+}
+
+func (s *sqsService) createQueue(c *call, in *sqsCreateQueueInput) (*sqsQueueURLOutput, error) {
+	if in.QueueName == "" {
+		return nil, validationError("QueueName is required")
+	}
+
+	fifo := strings.HasSuffix(in.QueueName, ".fifo")
+	if v, ok := in.Attributes["FifoQueue"]; ok && (v == "true") != fifo {
+		return nil, newError(http.StatusBadRequest, "InvalidParameterValue", "The name of a FIFO queue can only include alphanumeric characters, hyphens, or underscores, must end with .fifo suffix.")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := c.region + "/" + in.QueueName
+	if q, ok := s.queues[key]; ok {
+		for k, v := range in.Attributes {
+			if q.attributes[k] != v {
+				err := newError(http.StatusBadRequest, "com.amazonaws.sqs#QueueNameExists", "A queue already exists with the same name and a different value for attribute %s", k)
+				err.queryCode = "QueueAlreadyExists"
+				return nil, err
+			}
+		}
+
+		return &sqsQueueURLOutput{QueueUrl: q.url}, nil
+	}
+
+	now := strconv.FormatInt(time.Now().Unix(), 10)
+	q := &sqsQueue{
+		attributes: map[string]string{
+			"ApproximateNumberOfMessages":           "0",
+			"ApproximateNumberOfMessagesDelayed":    "0",
+			"ApproximateNumberOfMessagesNotVisible": "0",
+			"CreatedTimestamp":                      now,
+			"DelaySeconds":                          "0",
+			"MaximumMessageSize":                    "262144",
+			"MessageRetentionPeriod":                "345600",
+			"QueueArn":                              c.arn(in.QueueName),
+			"ReceiveMessageWaitTimeSeconds":         "0",
+			"VisibilityTimeout":                     "30",
+		},
+		name: in.QueueName,
+		tags: tags(in.Tags),
+		url:  c.server.URL() + "/" + c.server.AccountID + "/" + in.QueueName,
+	}
+	if q.tags == nil {
+		q.tags = make(tags)
+	}
+	if _, ok := in.Attributes["KmsMasterKeyId"]; !ok {
+		q.attributes["SqsManagedSseEnabled"] = "true"
+	}
+	if fifo {
+		q.attributes["ContentBasedDeduplication"] = "false"
+		q.attributes["DeduplicationScope"] = "queue"
+		q.attributes["FifoQueue"] = "true"
+		q.attributes["FifoThroughputLimit"] = "perQueue"
+	}
+	q.setAttributes(in.Attributes)
+
+	s.queues[key] = q
+
+	return &sqsQueueURLOutput{QueueUrl: q.url}, nil
+}
+
+type sqsGetQueueURLInput struct {
+	QueueName string
+}
+
+func (s *sqsService) getQueueURL(c *call, in *sqsGetQueueURLInput) (*sqsQueueURLOutput, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	q, ok := s.queues[c.region+"/"+in.QueueName]
+	if !ok {
+		return nil, sqsQueueNotFound()
+	}
+
+	return &sqsQueueURLOutput{QueueUrl: q.url}, nil
+}
+
+type sqsListQueuesInput struct {
+	QueueNamePrefix string
+}
+
+type sqsListQueuesOutput struct {
+	QueueUrls []string
+}
+
+func (s *sqsService) listQueues(c *call, in *sqsListQueuesInput) (*sqsListQueuesOutput, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	output := &sqsListQueuesOutput{
+		QueueUrls: []string{},
+	}
+
+	for k, q := range s.queues {
+		if strings.HasPrefix(k, c.region+"/") && strings.HasPrefix(q.name, in.QueueNamePrefix) {
+			output.QueueUrls = append(output.QueueUrls, q.url)
+		}
+	}
+	slices.Sort(output.QueueUrls)
+
+	return output, nil
+}
+
+type sqsQueueAttributesInput struct {
+	AttributeNames []string
+	Attributes     map[string]string
+	QueueUrl       string
+}
+
+type sqsGetQueueAttributesOutput struct {
+	Attributes map[string]string
+}
+
+func (s *sqsService) getQueueAttributes(c *call, in *sqsQueueAttributesInput) (*sqsGetQueueAttributesOutput, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	q, err := s.queue(c, in.QueueUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &sqsGetQueueAttributesOutput{
+		Attributes: make(map[string]string),
+	}
+
+	if slices.Contains(in.AttributeNames, "All") {
+		output.Attributes = maps.Clone(q.attributes)
+	} else {
+		for _, k := range in.AttributeNames {
+			if v, ok := q.attributes[k]; ok {
+				output.Attributes[k] = v
+			}
+		}
+	}
+
+	return output, nil
+}
+
+type sqsSetQueueAttributesOutput struct{}
+
+func (s *sqsService) setQueueAttributes(c *call, in *sqsQueueAttributesInput) (*sqsSetQueueAttributesOutput, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	q, err := s.queue(c, in.QueueUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	q.setAttributes(in.Attributes)
+
+	return &sqsSetQueueAttributesOutput{}, nil
+}
+
+type sqsDeleteQueueInput struct {
+	QueueUrl string
+}
+
+type sqsDeleteQueueOutput struct{}
+
+func (s *sqsService) deleteQueue(c *call, in *sqsDeleteQueueInput) (*sqsDeleteQueueOutput, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	q, err := s.queue(c, in.QueueUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	delete(s.queues, c.region+"/"+q.name)
+
+	return &sqsDeleteQueueOutput{}, nil
+}
+
+type sqsTagsInput struct {
+	QueueUrl string
+	TagKeys  []string
+	Tags     map[string]string
+}
+
+type sqsTagQueueOutput struct{}
+
+func (s *sqsService) tagQueue(c *call, in *sqsTagsInput) (*sqsTagQueueOutput, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	q, err := s.queue(c, in.QueueUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	q.tags.merge(in.Tags)
+
+	return &sqsTagQueueOutput{}, nil
+}
+
+type sqsUntagQueueOutput struct{}
+
+func (s *sqsService) untagQueue(c *call, in *sqsTagsInput) (*sqsUntagQueueOutput, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	q, err := s.queue(c, in.QueueUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	q.tags.remove(in.TagKeys)
+
+	return &sqsUntagQueueOutput{}, nil
+}
+
+type sqsListQueueTagsOutput struct {
+	Tags map[string]string
+}
+
+func (s *sqsService) listQueueTags(c *call, in *sqsTagsInput) (*sqsListQueueTagsOutput, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	q, err := s.queue(c, in.QueueUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sqsListQueueTagsOutput{
+		Tags: maps.Clone(q.tags),
+	}, nil
+}
@@ -0,0 +1,377 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeaws
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ssmService implements SSM Parameter Store.
+type ssmService struct {
+	mu         sync.Mutex
+	parameters map[string]*ssmParameter // Keyed by Region and name.
+}
+
+type ssmParameter struct {
+	allowedPattern string
+	arn            string
+	dataType       string
+	description    string
+	keyID          string
+	lastModified   time.Time
+	name           string
+	tags           tags
+	tier           string
+	typ            string
+	value          string
+	version        int64
+}
+
+func newSSMService() *ssmService {
+	return &ssmService{
+		parameters: make(map[string]*ssmParameter),
+	}
+}
+
+func (s *ssmService) handlers() map[string]jsonHandler {
+	return map[string]jsonHandler{
+		"AddTagsToResource":      jsonOperation(s.addTagsToResource),
+		"DeleteParameter":        jsonOperation(s.deleteParameter),
+		"DescribeParameters":     jsonOperation(s.describeParameters),
+		"GetParameter":           jsonOperation(s.getParameter),
+		"ListTagsForResource":    jsonOperation(s.listTagsForResource),
+		"PutParameter":           jsonOperation(s.putParameter),
+		"RemoveTagsFromResource": jsonOperation(s.removeTagsFromResource),
+	}
+}
+
+func ssmParameterNotFound(name string) *apiError {
+	return newError(http.StatusBadRequest, "ParameterNotFound", "parameter %s not found", name)
+}
+
+func (s *ssmService) resources() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var arns []string
+	for _, p := range s.parameters {
+		arns = append(arns, p.arn)
+	}
+
+	return arns
+}
+
+// parameter returns the named parameter. The caller must hold the lock.
+func (s *ssmService) parameter(c *call, name string) (*ssmParameter, *apiError) {
+	p, ok := s.parameters[c.region+"/"+name]
+	if !ok {
+		return nil, ssmParameterNotFound(name)
+	}
+
+	return p, nil
+}
+
+type ssmPutParameterInput struct {
+	AllowedPattern string
+	DataType       string
+	Description    string
+	KeyId          string
+	Name           string
+	Overwrite      bool
+	Tags           []tag
+	Tier           string
+	Type           string
+	Value          string
+}
+
+type ssmPutParameterOutput struct {
+	Tier    string
+	Version int64
+}
+
+func (s *ssmService) putParameter(c *call, in *ssmPutParameterInput) (*ssmPutParameterOutput, error) {
+	if in.Name == "" || in.Value == "" {
+		return nil, validationError("Name and Value are required")
+	}
+	if in.Overwrite && len(in.Tags) > 0 {
+		return nil, validationError("Invalid request: tags and overwrite can't be used together. To create a parameter with tags, please remove overwrite flag. To update tags for an existing parameter, please use AddTagsToResource or RemoveTagsFromResource.")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, err := s.parameter(c, in.Name)
+	if err == nil && !in.Overwrite {
+		return nil, newError(http.StatusBadRequest, "ParameterAlreadyExists", "The parameter already exists. To overwrite this value, set the overwrite option in the request to true.")
+	}
+
+	if p == nil {
+		if in.Type == "" {
+			return nil, validationError("A parameter type is required when you create a parameter.")
+		}
+
+		p = &ssmParameter{
+			arn:      c.arn("parameter/" + strings.TrimPrefix(in.Name, "/")),
+			dataType: "text",
+			name:     in.Name,
+			tags:     tagsFromList(in.Tags),
+			tier:     "Standard",
+		}
+		s.parameters[c.region+"/"+in.Name] = p
+	}
+
+	if in.Type != "" {
+		p.typ = in.Type
+	}
+	p.value = in.Value
+	p.allowedPattern = in.AllowedPattern
+	p.description = in.Description
+	if in.DataType != "" {
+		p.dataType = in.DataType
+	}
+	if in.Tier != "" && in.Tier != "Intelligent-Tiering" {
+		p.tier = in.Tier
+	}
+	p.keyID = ""
+	if p.typ == "SecureString" {
+		p.keyID = in.KeyId
+		if p.keyID == "" {
+			p.keyID = "alias/aws/ssm"
+		}
+	}
+	p.lastModified = time.Now()
+	p.version++
+
+	return &ssmPutParameterOutput{
+		Tier:    p.tier,
+		Version: p.version,
+	}, nil
+}
+
+type ssmGetParameterInput struct {
+	Name           string
+	WithDecryption bool
+}
+
+type ssmGetParameterOutput struct {
+	Parameter ssmParameterOutput
+}
+
+type ssmParameterOutput struct {
+	ARN              string
+	DataType         string
+	LastModifiedDate float64
+	Name             string
+	Type             string
+	Value            string
+	Version          int64
+}
+
+func (s *ssmService) getParameter(c *call, in *ssmGetParameterInput) (*ssmGetParameterOutput, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, err := s.parameter(c, in.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	value := p.value
+	if p.typ == "SecureString" && !in.WithDecryption {
+		value = "ENCRYPTED"
+	}
+
+	return &ssmGetParameterOutput{
+		Parameter: ssmParameterOutput{
+			ARN:              p.arn,
+			DataType:         p.dataType,
+			LastModifiedDate: epochSeconds(p.lastModified),
+			Name:             p.name,
+			Type:             p.typ,
+			Value:            value,
+			Version:          p.version,
+		},
+	}, nil
+}
+
+type ssmDescribeParametersInput struct {
+	ParameterFilters []struct {
+		Key    string
+		Option string
+		Values []string
+	}
+}
+
+type ssmDescribeParametersOutput struct {
+	Parameters []ssmParameterMetadata
+}
+
+type ssmParameterMetadata struct {
+	ARN              string
+	AllowedPattern   string `json:",omitempty"`
+	DataType         string
+	Description      string `json:",omitempty"`
+	KeyId            string `json:",omitempty"`
+	LastModifiedDate float64
+	LastModifiedUser string
+	Name             string
+	Policies         []any
+	Tier             string
+	Type             string
+	Version          int64
+}
+
+func (s *ssmService) describeParameters(c *call, in *ssmDescribeParametersInput) (*ssmDescribeParametersOutput, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	output := &ssmDescribeParametersOutput{
+		Parameters: []ssmParameterMetadata{},
+	}
+
+	for k, p := range s.parameters {
+		if !strings.HasPrefix(k, c.region+"/") {
+			continue
+		}
+
+		match := true
+		for _, filter := range in.ParameterFilters {
+			switch filter.Key {
+			case "Name":
+				switch filter.Option {
+				case "", "Equals":
+					match = match && slices.Contains(filter.Values, p.name)
+				case "BeginsWith":
+					match = match && slices.ContainsFunc(filter.Values, func(v string) bool {
+						return strings.HasPrefix(p.name, v)
+					})
+				default:
+					return nil, validationError("unsupported parameter filter option: %s", filter.Option)
+				}
+			case "Type":
+				match = match && slices.Contains(filter.Values, p.typ)
+			default:
+				return nil, validationError("unsupported parameter filter key: %s", filter.Key)
+			}
+		}
+		if !match {
+			continue
+		}
+
+		output.Parameters = append(output.Parameters, ssmParameterMetadata{
+			ARN:              p.arn,
+			AllowedPattern:   p.allowedPattern,
+			DataType:         p.dataType,
+			Description:      p.description,
+			KeyId:            p.keyID,
+			LastModifiedDate: epochSeconds(p.lastModified),
+			LastModifiedUser: c.server.arn("iam", "", "user/fakeaws"),
+			Name:             p.name,
+			Policies:         []any{},
+			Tier:             p.tier,
+			Type:             p.typ,
+			Version:          p.version,
+		})
+	}
+
+	slices.SortFunc(output.Parameters, func(a, b ssmParameterMetadata) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return output, nil
+}
+
+type ssmDeleteParameterInput struct {
+	Name string
+}
+
+type ssmDeleteParameterOutput struct{}
+
+func (s *ssmService) deleteParameter(c *call, in *ssmDeleteParameterInput) (*ssmDeleteParameterOutput, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.parameter(c, in.Name); err != nil {
+		return nil, err
+	}
+
+	delete(s.parameters, c.region+"/"+in.Name)
+
+	return &ssmDeleteParameterOutput{}, nil
+}
+
+type ssmTagsInput struct {
+	ResourceId   string
+	ResourceType string
+	Tags         []tag
+	TagKeys      []string
+}
+
+// taggedParameter returns the parameter identified by a tagging operation. The caller must hold the lock.
+func (s *ssmService) taggedParameter(c *call, in *ssmTagsInput) (*ssmParameter, error) {
+	if in.ResourceType != "Parameter" {
+		return nil, validationError("unsupported resource type: %s", in.ResourceType)
+	}
+
+	p, err := s.parameter(c, in.ResourceId)
+	if err != nil {
+		return nil, newError(http.StatusBadRequest, "InvalidResourceId", "resource %s not found", in.ResourceId)
+	}
+
+	return p, nil
+}
+
+type ssmAddTagsToResourceOutput struct{}
+
+func (s *ssmService) addTagsToResource(c *call, in *ssmTagsInput) (*ssmAddTagsToResourceOutput, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, err := s.taggedParameter(c, in)
+	if err != nil {
+		return nil, err
+	}
+
+	p.tags.merge(tagsFromList(in.Tags))
+
+	return &ssmAddTagsToResourceOutput{}, nil
+}
+
+type ssmRemoveTagsFromResourceOutput struct{}
+
+func (s *ssmService) removeTagsFromResource(c *call, in *ssmTagsInput) (*ssmRemoveTagsFromResourceOutput, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, err := s.taggedParameter(c, in)
+	if err != nil {
+		return nil, err
+	}
+
+	p.tags.remove(in.TagKeys)
+
+	return &ssmRemoveTagsFromResourceOutput{}, nil
+}
+
+type ssmListTagsForResourceOutput struct {
+	TagList []tag
+}
+
+func (s *ssmService) listTagsForResource(c *call, in *ssmTagsInput) (*ssmListTagsForResourceOutput, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, err := s.taggedParameter(c, in)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ssmListTagsForResourceOutput{
+		TagList: p.tags.list(),
+	}, nil
+}
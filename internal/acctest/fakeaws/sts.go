@@ -0,0 +1,28 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeaws
+
+import (
+	"net/url"
+)
+
+func stsHandlers() map[string]queryHandler {
+	return map[string]queryHandler{
+		"GetCallerIdentity": stsGetCallerIdentity,
+	}
+}
+
+type stsGetCallerIdentityResult struct {
+	Account string `xml:"Account"`
+	Arn     string `xml:"Arn"`
+	UserID  string `xml:"UserId"`
+}
+
+func stsGetCallerIdentity(c *call, _ url.Values) (any, error) {
+	return &stsGetCallerIdentityResult{
+		Account: c.server.AccountID,
+		Arn:     c.server.arn("iam", "", "user/fakeaws"),
+		UserID:  "AIDAFAKEAWS000000000",
+	}, nil
+}
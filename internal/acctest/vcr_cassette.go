@@ -0,0 +1,382 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"reflect"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+	"gopkg.in/dnaeon/go-vcr.v3/recorder"
+)
+
+const (
+	vcrRedacted = "REDACTED"
+)
+
+var (
+	// vcrVolatileParameters are request parameters whose values differ between runs.
+	// They are ignored when matching requests to recorded interactions.
+	vcrVolatileParameters = []string{
+		// Idempotency tokens.
+		"CallerReference",
+		"ClientRequestToken",
+		"ClientToken",
+		"IdempotencyToken",
+		// Query string (pre-signed) request signatures.
+		"X-Amz-Algorithm",
+		"X-Amz-Credential",
+		"X-Amz-Date",
+		"X-Amz-Expires",
+		"X-Amz-Security-Token",
+		"X-Amz-Signature",
+		"X-Amz-SignedHeaders",
+	}
+
+	// vcrSensitiveParameters are request and response parameters whose values are redacted before a cassette is saved.
+	// They are also ignored when matching requests to recorded interactions.
+	vcrSensitiveParameters = []string{
+		"MasterUserPassword",
+		"NewPassword",
+		"OldPassword",
+		"Password",
+		"SecretAccessKey",
+		"SessionToken",
+	}
+
+	// vcrSensitiveHeaders are HTTP request headers that are removed before an interaction is recorded.
+	vcrSensitiveHeaders = []string{
+		"Authorization",
+		"X-Amz-Security-Token",
+	}
+)
+
+var (
+	vcrRedactJSONRegexp  = regexache.MustCompile(`(?i)("(?:` + strings.Join(vcrSensitiveParameters, "|") + `)"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+	vcrRedactXMLRegexp   = regexache.MustCompile(`(?i)(<(?:` + strings.Join(vcrSensitiveParameters, "|") + `)>)[^<]*(</)`)
+	vcrRedactFormRegexp  = regexache.MustCompile(`(?i)((?:^|&)(?:[^=&]*\.)?(?:` + strings.Join(vcrSensitiveParameters, "|") + `)=)[^&]*`)
+	vcrRedactQueryRegexp = regexache.MustCompile(`(?i)((?:^|&)(?:X-Amz-Credential|X-Amz-Security-Token|X-Amz-Signature)=)[^&]*`)
+)
+
+// vcrInteractionNotFoundError is returned when no recorded interaction matches a request.
+// It is not temporary, so that the AWS SDKs don't retry the request.
+type vcrInteractionNotFoundError struct {
+	method string
+	url    string
+}
+
+func (e *vcrInteractionNotFoundError) Error() string {
+	return fmt.Sprintf("%s: %s %s", cassette.ErrInteractionNotFound, e.method, e.url)
+}
+
+func (e *vcrInteractionNotFoundError) Temporary() bool {
+	return false
+}
+
+func (e *vcrInteractionNotFoundError) Unwrap() error {
+	return cassette.ErrInteractionNotFound
+}
+
+// vcrTransport wraps a VCR recorder, reporting requests that don't match any recorded interaction.
+type vcrTransport struct {
+	recorder *recorder.Recorder
+}
+
+func (t *vcrTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	resp, err := t.recorder.RoundTrip(r)
+
+	if errors.Is(err, cassette.ErrInteractionNotFound) {
+		return nil, &vcrInteractionNotFoundError{
+			method: r.Method,
+			url:    r.URL.String(),
+		}
+	}
+
+	return resp, err
+}
+
+// vcrParameterIn returns whether the named parameter is in the specified list, ignoring case.
+// Parameter names may be qualified, for example "Tags.member.1.Key" in the AWS Query protocol.
+func vcrParameterIn(name string, names []string) bool {
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		name = name[i+1:]
+	}
+
+	return slices.ContainsFunc(names, func(s string) bool {
+		return strings.EqualFold(s, name)
+	})
+}
+
+// vcrIsIgnoredParameter returns whether the named request parameter is ignored when matching requests.
+func vcrIsIgnoredParameter(name string) bool {
+	return vcrParameterIn(name, vcrVolatileParameters) || vcrParameterIn(name, vcrSensitiveParameters)
+}
+
+// vcrMatcher returns a function that matches requests to recorded interactions.
+// Requests match if their method, URL and body match, ignoring request signatures, idempotency tokens,
+// redacted values, parameter order and formatting.
+// Headers, including the signing timestamp, are not compared.
+func vcrMatcher(ctx context.Context) cassette.MatcherFunc {
+	return func(r *http.Request, i cassette.Request) bool {
+		if r.Method != i.Method {
+			return false
+		}
+
+		if !vcrURLsMatch(r.URL, i.URL) {
+			return false
+		}
+
+		if r.Body == nil || r.Body == http.NoBody {
+			return i.Body == ""
+		}
+
+		var b bytes.Buffer
+		if _, err := b.ReadFrom(r.Body); err != nil {
+			tflog.Debug(ctx, "Failed to read request body", map[string]interface{}{
+				"error": err,
+			})
+			return false
+		}
+
+		r.Body = io.NopCloser(&b)
+		body := b.String()
+		// If body matches identically, we are done.
+		if body == i.Body {
+			return true
+		}
+
+		mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+
+		// https://smithy.io/2.0/aws/protocols/index.html.
+		switch mediaType {
+		case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
+			return vcrJSONBodiesMatch(ctx, body, i.Body)
+
+		case "application/x-www-form-urlencoded":
+			return vcrFormBodiesMatch(ctx, body, i.Body)
+
+		case "application/xml", "text/xml":
+			return vcrXMLBodiesMatch(ctx, body, i.Body)
+		}
+
+		return false
+	}
+}
+
+func vcrURLsMatch(u *url.URL, recorded string) bool {
+	v, err := url.Parse(recorded)
+	if err != nil {
+		return false
+	}
+
+	if u.Scheme != v.Scheme || u.Host != v.Host || u.EscapedPath() != v.EscapedPath() {
+		return false
+	}
+
+	return reflect.DeepEqual(vcrNormalizeValues(u.Query()), vcrNormalizeValues(v.Query()))
+}
+
+func vcrFormBodiesMatch(ctx context.Context, body, recorded string) bool {
+	requestForm, err := url.ParseQuery(body)
+	if err != nil {
+		tflog.Debug(ctx, "Failed to parse request form", map[string]interface{}{
+			"error": err,
+		})
+		return false
+	}
+
+	cassetteForm, err := url.ParseQuery(recorded)
+	if err != nil {
+		tflog.Debug(ctx, "Failed to parse cassette form", map[string]interface{}{
+			"error": err,
+		})
+		return false
+	}
+
+	return reflect.DeepEqual(vcrNormalizeValues(requestForm), vcrNormalizeValues(cassetteForm))
+}
+
+func vcrNormalizeValues(values url.Values) url.Values {
+	for k := range values {
+		if vcrIsIgnoredParameter(k) {
+			delete(values, k)
+		}
+	}
+
+	return values
+}
+
+func vcrJSONBodiesMatch(ctx context.Context, body, recorded string) bool {
+	// JSON might be the same, but reordered. Try parsing and comparing.
+	var requestJson, cassetteJson interface{}
+
+	if err := json.Unmarshal([]byte(body), &requestJson); err != nil {
+		tflog.Debug(ctx, "Failed to unmarshal request JSON", map[string]interface{}{
+			"error": err,
+		})
+		return false
+	}
+
+	if err := json.Unmarshal([]byte(recorded), &cassetteJson); err != nil {
+		tflog.Debug(ctx, "Failed to unmarshal cassette JSON", map[string]interface{}{
+			"error": err,
+		})
+		return false
+	}
+
+	return reflect.DeepEqual(vcrNormalizeJSON(requestJson), vcrNormalizeJSON(cassetteJson))
+}
+
+func vcrNormalizeJSON(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			if vcrIsIgnoredParameter(k) {
+				delete(v, k)
+			} else {
+				v[k] = vcrNormalizeJSON(e)
+			}
+		}
+	case []interface{}:
+		for i, e := range v {
+			v[i] = vcrNormalizeJSON(e)
+		}
+	}
+
+	return v
+}
+
+func vcrXMLBodiesMatch(ctx context.Context, body, recorded string) bool {
+	requestXml, err := vcrNormalizeXML(body)
+	if err != nil {
+		tflog.Debug(ctx, "Failed to parse request XML", map[string]interface{}{
+			"error": err,
+		})
+		return false
+	}
+
+	cassetteXml, err := vcrNormalizeXML(recorded)
+	if err != nil {
+		tflog.Debug(ctx, "Failed to parse cassette XML", map[string]interface{}{
+			"error": err,
+		})
+		return false
+	}
+
+	return slices.Equal(requestXml, cassetteXml)
+}
+
+// vcrNormalizeXML returns the significant tokens of an XML document as strings.
+// Whitespace between elements, comments, processing instructions and ignored elements are dropped.
+func vcrNormalizeXML(s string) ([]string, error) {
+	var tokens []string
+	var skip int
+
+	decoder := xml.NewDecoder(strings.NewReader(s))
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch token := token.(type) {
+		case xml.StartElement:
+			if skip > 0 || vcrIsIgnoredParameter(token.Name.Local) {
+				skip++
+				continue
+			}
+
+			attrs := make([]string, 0, len(token.Attr))
+			for _, v := range token.Attr {
+				attrs = append(attrs, fmt.Sprintf("%s:%s=%q", v.Name.Space, v.Name.Local, v.Value))
+			}
+			sort.Strings(attrs)
+
+			tokens = append(tokens, fmt.Sprintf("<%s:%s %s>", token.Name.Space, token.Name.Local, strings.Join(attrs, " ")))
+		case xml.EndElement:
+			if skip > 0 {
+				skip--
+				continue
+			}
+
+			tokens = append(tokens, fmt.Sprintf("</%s:%s>", token.Name.Space, token.Name.Local))
+		case xml.CharData:
+			if skip > 0 {
+				continue
+			}
+
+			if v := strings.TrimSpace(string(token)); v != "" {
+				tokens = append(tokens, strconv.Quote(v))
+			}
+		}
+	}
+
+	return tokens, nil
+}
+
+// vcrRedactRequestHeaders removes sensitive headers from a captured interaction.
+func vcrRedactRequestHeaders(i *cassette.Interaction) error {
+	for _, v := range vcrSensitiveHeaders {
+		i.Request.Headers.Del(v)
+	}
+
+	return nil
+}
+
+// vcrRedactInteraction redacts sensitive values from an interaction before it is saved.
+// Response credentials are redacted only on save so that they can be used while recording.
+func vcrRedactInteraction(i *cassette.Interaction) error {
+	if u, err := url.Parse(i.Request.URL); err == nil && u.RawQuery != "" {
+		u.RawQuery = vcrRedactQueryRegexp.ReplaceAllString(u.RawQuery, "${1}"+vcrRedacted)
+		i.Request.URL = u.String()
+	}
+
+	i.Request.Body = vcrRedactBody(i.Request.Body)
+	for k := range i.Request.Form {
+		if vcrParameterIn(k, vcrSensitiveParameters) {
+			i.Request.Form[k] = []string{vcrRedacted}
+		}
+	}
+
+	if body := vcrRedactBody(i.Response.Body); body != i.Response.Body {
+		i.Response.Body = body
+		i.Response.ContentLength = int64(len(body))
+		if i.Response.Headers.Get("Content-Length") != "" {
+			i.Response.Headers.Set("Content-Length", strconv.Itoa(len(body)))
+		}
+	}
+
+	return nil
+}
+
+// vcrRedactBody redacts sensitive values from a JSON, XML or form-encoded body.
+func vcrRedactBody(body string) string {
+	if body == "" {
+		return body
+	}
+
+	body = vcrRedactJSONRegexp.ReplaceAllString(body, `${1}"`+vcrRedacted+`"`)
+	body = vcrRedactXMLRegexp.ReplaceAllString(body, "${1}"+vcrRedacted+"${2}")
+	body = vcrRedactFormRegexp.ReplaceAllString(body, "${1}"+vcrRedacted)
+
+	return body
+}
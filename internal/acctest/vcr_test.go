@@ -4,9 +4,18 @@
 package acctest_test
 
 import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+	"gopkg.in/dnaeon/go-vcr.v3/recorder"
 )
 
 func TestRandInt(t *testing.T) {
@@ -52,3 +61,253 @@ func TestRandomWithPrefix(t *testing.T) {
 		t.Errorf("REPLAYING: %s, RECORDING: %s", rep2, rec2)
 	}
 }
+
+func TestVCRMatcher(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		method      string
+		url         string
+		contentType string
+		body        string
+		recorded    cassette.Request
+		expected    bool
+	}{
+		"method differs": {
+			method:   http.MethodGet,
+			url:      "https://s3.us-west-2.amazonaws.com/bucket",
+			recorded: cassette.Request{Method: http.MethodHead, URL: "https://s3.us-west-2.amazonaws.com/bucket"},
+			expected: false,
+		},
+		"path differs": {
+			method:   http.MethodGet,
+			url:      "https://s3.us-west-2.amazonaws.com/bucket1",
+			recorded: cassette.Request{Method: http.MethodGet, URL: "https://s3.us-west-2.amazonaws.com/bucket2"},
+			expected: false,
+		},
+		"query signature differs": {
+			method:   http.MethodGet,
+			url:      "https://s3.us-west-2.amazonaws.com/bucket/key?X-Amz-Date=20240102T030405Z&X-Amz-Signature=abc&versionId=1",
+			recorded: cassette.Request{Method: http.MethodGet, URL: "https://s3.us-west-2.amazonaws.com/bucket/key?versionId=1&X-Amz-Date=20230102T030405Z&X-Amz-Signature=REDACTED"},
+			expected: true,
+		},
+		"query parameter differs": {
+			method:   http.MethodGet,
+			url:      "https://s3.us-west-2.amazonaws.com/bucket/key?versionId=1",
+			recorded: cassette.Request{Method: http.MethodGet, URL: "https://s3.us-west-2.amazonaws.com/bucket/key?versionId=2"},
+			expected: false,
+		},
+		"JSON reordered with volatile token": {
+			method:      http.MethodPost,
+			url:         "https://logs.us-west-2.amazonaws.com/",
+			contentType: "application/x-amz-json-1.1",
+			body:        `{"logGroupName":"test","clientToken":"abc","tags":{"a":"b"}}`,
+			recorded: cassette.Request{
+				Method: http.MethodPost,
+				URL:    "https://logs.us-west-2.amazonaws.com/",
+				Body:   `{"tags":{"a":"b"},"clientToken":"def","logGroupName":"test"}`,
+			},
+			expected: true,
+		},
+		"JSON value differs": {
+			method:      http.MethodPost,
+			url:         "https://logs.us-west-2.amazonaws.com/",
+			contentType: "application/x-amz-json-1.1",
+			body:        `{"logGroupName":"test1"}`,
+			recorded: cassette.Request{
+				Method: http.MethodPost,
+				URL:    "https://logs.us-west-2.amazonaws.com/",
+				Body:   `{"logGroupName":"test2"}`,
+			},
+			expected: false,
+		},
+		"form redacted password": {
+			method:      http.MethodPost,
+			url:         "https://iam.amazonaws.com/",
+			contentType: "application/x-www-form-urlencoded; charset=utf-8",
+			body:        "Action=CreateLoginProfile&Password=secret&UserName=test&Version=2010-05-08",
+			recorded: cassette.Request{
+				Method: http.MethodPost,
+				URL:    "https://iam.amazonaws.com/",
+				Body:   "Action=CreateLoginProfile&UserName=test&Version=2010-05-08&Password=REDACTED",
+			},
+			expected: true,
+		},
+		"form value differs": {
+			method:      http.MethodPost,
+			url:         "https://ec2.us-west-2.amazonaws.com/",
+			contentType: "application/x-www-form-urlencoded; charset=utf-8",
+			body:        "Action=CreateVpc&CidrBlock=10.0.0.0%2F16&ClientToken=abc&Version=2016-11-15",
+			recorded: cassette.Request{
+				Method: http.MethodPost,
+				URL:    "https://ec2.us-west-2.amazonaws.com/",
+				Body:   "Action=CreateVpc&CidrBlock=10.1.0.0%2F16&ClientToken=def&Version=2016-11-15",
+			},
+			expected: false,
+		},
+		"XML formatting and volatile token": {
+			method:      http.MethodPost,
+			url:         "https://route53.amazonaws.com/2013-04-01/hostedzone",
+			contentType: "application/xml",
+			body:        `<CreateHostedZoneRequest xmlns="https://route53.amazonaws.com/doc/2013-04-01/"><Name>example.com</Name><CallerReference>abc</CallerReference></CreateHostedZoneRequest>`,
+			recorded: cassette.Request{
+				Method: http.MethodPost,
+				URL:    "https://route53.amazonaws.com/2013-04-01/hostedzone",
+				Body: `<?xml version="1.0" encoding="UTF-8"?>
+<CreateHostedZoneRequest xmlns="https://route53.amazonaws.com/doc/2013-04-01/">
+  <Name>example.com</Name>
+  <CallerReference>def</CallerReference>
+</CreateHostedZoneRequest>`,
+			},
+			expected: true,
+		},
+		"XML value differs": {
+			method:      http.MethodPost,
+			url:         "https://route53.amazonaws.com/2013-04-01/hostedzone",
+			contentType: "application/xml",
+			body:        `<CreateHostedZoneRequest><Name>example.com</Name></CreateHostedZoneRequest>`,
+			recorded: cassette.Request{
+				Method: http.MethodPost,
+				URL:    "https://route53.amazonaws.com/2013-04-01/hostedzone",
+				Body:   `<CreateHostedZoneRequest><Name>example.org</Name></CreateHostedZoneRequest>`,
+			},
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := acctest.Context(t)
+
+			var body io.Reader = http.NoBody
+			if testCase.body != "" {
+				body = strings.NewReader(testCase.body)
+			}
+			r, err := http.NewRequestWithContext(ctx, testCase.method, testCase.url, body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if testCase.contentType != "" {
+				r.Header.Set("Content-Type", testCase.contentType)
+			}
+
+			if got, want := acctest.VCRMatcher(ctx)(r, testCase.recorded), testCase.expected; got != want {
+				t.Errorf("got %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestVCRRedactInteraction(t *testing.T) {
+	t.Parallel()
+
+	responseBody := `<AssumeRoleResponse><AssumeRoleResult><Credentials><AccessKeyId>ASIAEXAMPLE</AccessKeyId><SecretAccessKey>secret</SecretAccessKey><SessionToken>token</SessionToken></Credentials></AssumeRoleResult></AssumeRoleResponse>`
+	i := &cassette.Interaction{
+		Request: cassette.Request{
+			Body: "Action=CreateLoginProfile&Password=secret&UserName=test",
+			Form: url.Values{"Password": []string{"secret"}, "UserName": []string{"test"}},
+			URL:  "https://s3.us-west-2.amazonaws.com/bucket/key?X-Amz-Credential=AKIAEXAMPLE&X-Amz-Signature=abc",
+		},
+		Response: cassette.Response{
+			Body:          responseBody,
+			ContentLength: int64(len(responseBody)),
+			Headers:       http.Header{"Content-Length": []string{strconv.Itoa(len(responseBody))}},
+		},
+	}
+
+	if err := acctest.VCRRedactInteraction(i); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := i.Request.Body, "Action=CreateLoginProfile&Password=REDACTED&UserName=test"; got != want {
+		t.Errorf("request body: got %q, want %q", got, want)
+	}
+	if got, want := i.Request.Form.Get("Password"), "REDACTED"; got != want {
+		t.Errorf("request form: got %q, want %q", got, want)
+	}
+	if got, want := i.Request.URL, "https://s3.us-west-2.amazonaws.com/bucket/key?X-Amz-Credential=REDACTED&X-Amz-Signature=REDACTED"; got != want {
+		t.Errorf("request URL: got %q, want %q", got, want)
+	}
+	want := `<AssumeRoleResponse><AssumeRoleResult><Credentials><AccessKeyId>ASIAEXAMPLE</AccessKeyId><SecretAccessKey>REDACTED</SecretAccessKey><SessionToken>REDACTED</SessionToken></Credentials></AssumeRoleResult></AssumeRoleResponse>`
+	if got := i.Response.Body; got != want {
+		t.Errorf("response body: got %q, want %q", got, want)
+	}
+	if got, want := i.Response.ContentLength, int64(len(want)); got != want {
+		t.Errorf("response content length: got %d, want %d", got, want)
+	}
+	if got, want := i.Response.Headers.Get("Content-Length"), strconv.Itoa(len(want)); got != want {
+		t.Errorf("response Content-Length header: got %q, want %q", got, want)
+	}
+}
+
+func TestVCRHTTPClient(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	t.Setenv("VCR_PATH", t.TempDir())
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+		_, _ = w.Write([]byte(`{"credentials":{"accessKeyId":"ASIAEXAMPLE","secretAccessKey":"secret"}}`))
+	}))
+
+	do := func(client *http.Client, signature string) (string, error) {
+		r, err := http.NewRequestWithContext(ctx, http.MethodPost, server.URL, strings.NewReader(`{"roleArn":"arn","clientToken":"`+signature+`"}`))
+		if err != nil {
+			return "", err
+		}
+		r.Header.Set("Authorization", signature)
+		r.Header.Set("Content-Type", "application/x-amz-json-1.1")
+
+		resp, err := client.Do(r)
+		if err != nil {
+			return "", err
+		}
+		defer resp.Body.Close()
+
+		b, err := io.ReadAll(resp.Body)
+
+		return string(b), err
+	}
+
+	client, err := acctest.VCRHTTPClient(ctx, t.Name(), recorder.ModeRecordOnce)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := do(client, "signature1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Credentials are usable while recording.
+	if want := `{"credentials":{"accessKeyId":"ASIAEXAMPLE","secretAccessKey":"secret"}}`; got != want {
+		t.Errorf("recording: got %q, want %q", got, want)
+	}
+
+	if err := acctest.StopVCRHTTPClient(client); err != nil {
+		t.Fatal(err)
+	}
+
+	server.Close()
+
+	client, err = acctest.VCRHTTPClient(ctx, t.Name(), recorder.ModeReplayOnly)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err = do(client, "signature2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"credentials":{"accessKeyId":"ASIAEXAMPLE","secretAccessKey":"REDACTED"}}`; got != want {
+		t.Errorf("replaying: got %q, want %q", got, want)
+	}
+
+	// Each recorded interaction is replayed once.
+	if _, err := do(client, "signature3"); !errors.Is(err, cassette.ErrInteractionNotFound) {
+		t.Errorf("replaying: got error %v, want %v", err, cassette.ErrInteractionNotFound)
+	}
+}
@@ -0,0 +1,173 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"sync"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
+	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
+	smithy "github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// apiAuditRecord is a single line of the API audit log.
+// Terraform does not pass resource addresses to providers, so only the resource type is known.
+type apiAuditRecord struct {
+	Time         time.Time `json:"time"`
+	Service      string    `json:"service"`
+	Operation    string    `json:"operation"`
+	Region       string    `json:"region"`
+	AccountID    string    `json:"account_id"`
+	ResourceType string    `json:"resource_type,omitempty"`
+	DataSource   bool      `json:"data_source,omitempty"`
+	LatencyMS    int64     `json:"latency_ms"`
+	RetryCount   int       `json:"retry_count"`
+	RequestID    string    `json:"request_id,omitempty"`
+	ErrorCode    string    `json:"error_code,omitempty"`
+}
+
+// apiAuditLogMu serializes writes to the API audit logs of all provider instances.
+var apiAuditLogMu sync.Mutex
+
+// apiAuditLog writes a JSON Lines record of every AWS API call.
+// The file is opened for each record, as providers are not notified when they stop.
+type apiAuditLog struct {
+	accountID string
+	path      string
+	now       func() time.Time
+}
+
+func newAPIAuditLog(path, accountID string) (*apiAuditLog, error) {
+	// Check that the file can be written before any API calls are made.
+	if err := appendFile(path, nil); err != nil {
+		return nil, fmt.Errorf("opening API audit log (%s): %w", path, err)
+	}
+
+	return &apiAuditLog{
+		accountID: accountID,
+		path:      path,
+		now:       time.Now,
+	}, nil
+}
+
+// appendFile appends to a file, creating it if necessary, so that the records of all provider instances in a run are kept.
+func appendFile(path string, b []byte) error {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+
+	_, err = file.Write(b)
+
+	return errors.Join(err, file.Close())
+}
+
+// write writes a record to the audit log.
+// Failures are logged rather than returned so that they do not fail API calls that have already been made.
+func (l *apiAuditLog) write(ctx context.Context, record apiAuditRecord) {
+	record.AccountID = l.accountID
+	if v, ok := FromContext(ctx); ok {
+		record.ResourceType = v.TypeName
+		record.DataSource = v.IsDataSource
+	}
+
+	b, err := json.Marshal(record)
+	if err == nil {
+		apiAuditLogMu.Lock()
+		err = appendFile(l.path, append(b, '\n'))
+		apiAuditLogMu.Unlock()
+	}
+
+	if err != nil {
+		tflog.Warn(ctx, "writing API audit log", map[string]any{
+			"error": err.Error(),
+		})
+	}
+}
+
+// addToStack adds the audit log to an AWS SDK for Go v2 API client's middleware stack.
+// The record spans all attempts, after the operation's metadata has been registered.
+func (l *apiAuditLog) addToStack(servicePackageName string) func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("TFAPIAuditLog", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+			start := l.now()
+
+			out, metadata, err := next.HandleInitialize(ctx, in)
+
+			record := apiAuditRecord{
+				Time:      start.UTC(),
+				Service:   servicePackageName,
+				Operation: awsmiddleware.GetOperationName(ctx),
+				Region:    awsmiddleware.GetRegion(ctx),
+				LatencyMS: l.now().Sub(start).Milliseconds(),
+			}
+			if v, ok := retry.GetAttemptResults(metadata); ok && len(v.Results) > 0 {
+				record.RetryCount = len(v.Results) - 1
+			}
+			if v, ok := awsmiddleware.GetRequestIDMetadata(metadata); ok {
+				record.RequestID = v
+			}
+			if apiErr := smithy.APIError(nil); errors.As(err, &apiErr) {
+				record.ErrorCode = apiErr.ErrorCode()
+			} else if err != nil {
+				record.ErrorCode = fmt.Sprintf("%T", err)
+			}
+
+			l.write(ctx, record)
+
+			return out, metadata, err
+		}), middleware.After)
+	}
+}
+
+// configureSession returns a copy of an AWS SDK for Go v1 session with the audit log added.
+func (l *apiAuditLog) configureSession(servicePackageName string, sess *session_sdkv1.Session) *session_sdkv1.Session {
+	sess = sess.Copy()
+
+	sess.Handlers.Complete.PushBackNamed(request_sdkv1.NamedHandler{
+		Name: "TFAPIAuditLog",
+		Fn: func(r *request_sdkv1.Request) {
+			record := apiAuditRecord{
+				Time:       r.Time.UTC(),
+				Service:    servicePackageName,
+				Operation:  r.Operation.Name,
+				Region:     aws_sdkv1.StringValue(r.Config.Region),
+				LatencyMS:  l.now().Sub(r.Time).Milliseconds(),
+				RetryCount: r.RetryCount,
+				RequestID:  r.RequestID,
+			}
+			if awsErr, ok := r.Error.(awserr.Error); ok {
+				record.ErrorCode = awsErr.Code()
+			} else if r.Error != nil {
+				record.ErrorCode = fmt.Sprintf("%T", r.Error)
+			}
+
+			l.write(r.Context(), record)
+		},
+	})
+
+	return sess
+}
+
+// configureAWSConfig returns a copy of an AWS SDK for Go v2 configuration with the audit log added.
+func (l *apiAuditLog) configureAWSConfig(servicePackageName string, cfg *aws_sdkv2.Config) *aws_sdkv2.Config {
+	v := cfg.Copy()
+	v.APIOptions = append(slices.Clone(v.APIOptions), l.addToStack(servicePackageName))
+
+	return &v
+}
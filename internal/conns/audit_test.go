@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
+	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
+	smithy "github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestAPIAuditLog(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	l, err := newAPIAuditLog(path, "123456789012")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	l.now = func() time.Time {
+		now = now.Add(250 * time.Millisecond)
+		return now
+	}
+
+	// AWS SDK for Go v2.
+	stack := middleware.NewStack("test", func() interface{} { return nil })
+	if err := stack.Initialize.Add(&awsmiddleware.RegisterServiceMetadata{
+		Region:        "us-west-2", //lintignore:AWSAT003
+		OperationName: "CreateBucket",
+	}, middleware.Before); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.addToStack("s3")(stack); err != nil {
+		t.Fatal(err)
+	}
+	handler := middleware.DecorateHandler(middleware.HandlerFunc(func(context.Context, interface{}) (interface{}, middleware.Metadata, error) {
+		return nil, middleware.Metadata{}, &smithy.GenericAPIError{Code: "BucketAlreadyExists"}
+	}), stack)
+
+	ctx := NewResourceContext(context.Background(), "s3", "Bucket", "aws_s3_bucket")
+	if _, _, err := handler.Handle(ctx, nil); err == nil {
+		t.Fatal("expected error, got none")
+	}
+
+	// AWS SDK for Go v1.
+	sess := l.configureSession("ec2", session_sdkv1.Must(session_sdkv1.NewSession()))
+	r := request_sdkv1.New(*aws_sdkv1.NewConfig().WithRegion("us-east-1"), metadata.ClientInfo{ServiceName: "ec2"}, sess.Handlers, nil, &request_sdkv1.Operation{Name: "DescribeVpcs"}, nil, nil) //lintignore:AWSAT003
+	r.Time = now
+	r.RetryCount = 2
+	r.RequestID = "request-1"
+	r.Error = awserr.New("RequestLimitExceeded", "Request limit exceeded.", nil)
+	r.SetContext(NewDataSourceContext(context.Background(), "ec2", "VPC", "aws_vpc"))
+	r.Handlers.Complete.Run(r)
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	var got []apiAuditRecord
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var record apiAuditRecord
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			t.Fatalf("invalid JSON line %q: %s", scanner.Text(), err)
+		}
+		got = append(got, record)
+	}
+
+	want := []apiAuditRecord{
+		{
+			Service:      "s3",
+			Operation:    "CreateBucket",
+			Region:       "us-west-2", //lintignore:AWSAT003
+			AccountID:    "123456789012",
+			ResourceType: "aws_s3_bucket",
+			LatencyMS:    250,
+			ErrorCode:    "BucketAlreadyExists",
+		},
+		{
+			Service:      "ec2",
+			Operation:    "DescribeVpcs",
+			Region:       "us-east-1", //lintignore:AWSAT003
+			AccountID:    "123456789012",
+			ResourceType: "aws_vpc",
+			DataSource:   true,
+			LatencyMS:    250,
+			RetryCount:   2,
+			RequestID:    "request-1",
+			ErrorCode:    "RequestLimitExceeded",
+		},
+	}
+	if diff := cmp.Diff(got, want, cmpopts.IgnoreFields(apiAuditRecord{}, "Time")); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
@@ -25,18 +25,23 @@ import (
 	baselogging "github.com/hashicorp/aws-sdk-go-base/v2/logging"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type AWSClient struct {
 	AccountID         string
 	DefaultTagsConfig *tftags.DefaultConfig
+	DefaultTimeouts   map[string]ResourceTimeouts // Keyed by resource type name.
 	IgnoreTagsConfig  *tftags.IgnoreConfig
 	Partition         string
 	Region            string
 	ServicePackages   map[string]ServicePackage
+	WaiterConfig      tfresource.WaiterConfig
 
+	apiAuditLog               *apiAuditLog // From provider configuration.
 	awsConfig                 *aws_sdkv2.Config
+	circuitBreakers           *circuitBreakers // From provider configuration.
 	clients                   map[string]any
 	conns                     map[string]any
 	dnsSuffix                 string
@@ -44,6 +49,7 @@ type AWSClient struct {
 	httpClient                *http.Client
 	lock                      sync.Mutex
 	logger                    baselogging.Logger
+	rateLimiters              map[string]*serviceRateLimiter // From provider configuration.
 	session                   *session_sdkv1.Session
 	s3ExpressClient           *s3_sdkv2.Client
 	s3UsePathStyle            bool   // From provider configuration.
@@ -70,7 +76,7 @@ func (c *AWSClient) DSConnForRegion(ctx context.Context, region string) *directo
 	if region == c.Region {
 		return c.DSConn(ctx)
 	}
-	return directoryservice_sdkv1.New(c.session, aws_sdkv1.NewConfig().WithRegion(region))
+	return directoryservice_sdkv1.New(c.apiClientSession(names.DS), aws_sdkv1.NewConfig().WithRegion(region))
 }
 
 // EFSConnForRegion returns an AWS SDK For Go v1 EFS API client for the specified AWS Region.
@@ -80,7 +86,7 @@ func (c *AWSClient) EFSConnForRegion(ctx context.Context, region string) *efs_sd
 	if region == c.Region {
 		return c.EFSConn(ctx)
 	}
-	return efs_sdkv1.New(c.session, aws_sdkv1.NewConfig().WithRegion(region))
+	return efs_sdkv1.New(c.apiClientSession(names.EFS), aws_sdkv1.NewConfig().WithRegion(region))
 }
 
 // OpsWorksConnForRegion returns an AWS SDK For Go v1 OpsWorks API client for the specified AWS Region.
@@ -90,7 +96,7 @@ func (c *AWSClient) OpsWorksConnForRegion(ctx context.Context, region string) *o
 	if region == c.Region {
 		return c.OpsWorksConn(ctx)
 	}
-	return opsworks_sdkv1.New(c.session, aws_sdkv1.NewConfig().WithRegion(region))
+	return opsworks_sdkv1.New(c.apiClientSession(names.OpsWorks), aws_sdkv1.NewConfig().WithRegion(region))
 }
 
 // PartitionHostname returns a hostname with the provider domain suffix for the partition
@@ -114,7 +120,7 @@ func (c *AWSClient) RDSConnForRegion(ctx context.Context, region string) *rds_sd
 	if region == c.Region {
 		return c.RDSConn(ctx)
 	}
-	return rds_sdkv1.New(c.session, aws_sdkv1.NewConfig().WithRegion(region))
+	return rds_sdkv1.New(c.apiClientSession(names.RDS), aws_sdkv1.NewConfig().WithRegion(region))
 }
 
 // S3ExpressClient returns an AWS SDK for Go v2 S3 API client suitable for use with S3 Express (directory buckets).
@@ -269,7 +275,7 @@ func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName stri
 		"aws_sdkv2_config": c.awsConfig,
 		"endpoint":         c.resolveEndpoint(ctx, servicePackageName),
 		"partition":        c.Partition,
-		"session":          c.session,
+		"session":          c.apiClientSession(servicePackageName),
 	}
 	switch servicePackageName {
 	case names.S3:
@@ -284,9 +290,36 @@ func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName stri
 		m["sts_region"] = c.stsRegion
 	}
 
+	if v := c.circuitBreakers; v != nil {
+		m["aws_sdkv2_config"] = v.forService(servicePackageName).configureAWSConfig(m["aws_sdkv2_config"].(*aws_sdkv2.Config))
+	}
+	if l, ok := c.rateLimiters[servicePackageName]; ok {
+		m["aws_sdkv2_config"] = l.configureAWSConfig(m["aws_sdkv2_config"].(*aws_sdkv2.Config))
+	}
+	if l := c.apiAuditLog; l != nil {
+		m["aws_sdkv2_config"] = l.configureAWSConfig(servicePackageName, m["aws_sdkv2_config"].(*aws_sdkv2.Config))
+	}
+
 	return m
 }
 
+// apiClientSession returns the AWS SDK for Go v1 session for the specified service.
+func (c *AWSClient) apiClientSession(servicePackageName string) *session_sdkv1.Session {
+	session := c.session
+
+	if v := c.circuitBreakers; v != nil {
+		session = v.forService(servicePackageName).configureSession(session)
+	}
+	if l, ok := c.rateLimiters[servicePackageName]; ok {
+		session = l.configureSession(session)
+	}
+	if l := c.apiAuditLog; l != nil {
+		session = l.configureSession(servicePackageName, session)
+	}
+
+	return session
+}
+
 func (c *AWSClient) resolveEndpoint(ctx context.Context, servicePackageName string) string {
 	endpoint := c.endpoints[servicePackageName]
 	if endpoint != "" {
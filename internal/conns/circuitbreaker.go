@@ -0,0 +1,355 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
+	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	defaultCircuitBreakerCooldown        = 30 * time.Second
+	defaultCircuitBreakerErrorThreshold  = 50
+	defaultCircuitBreakerMinimumRequests = 20
+	defaultCircuitBreakerWindow          = 1 * time.Minute
+)
+
+// CircuitBreakerConfig configures the per-service circuit breakers for AWS API calls.
+// A service's circuit breaker opens when too many of its recent API call attempts fail with
+// throttling or server (5xx) errors, and API calls then fail fast instead of being retried.
+// Zero values use the defaults.
+type CircuitBreakerConfig struct {
+	Enabled bool
+	// Cooldown is how long the circuit stays open before a single trial call is let through.
+	Cooldown time.Duration
+	// ErrorThreshold is the percentage of failed attempts within Window that opens the circuit.
+	ErrorThreshold int
+	// MinimumRequests is the number of attempts within Window needed before the circuit can open.
+	MinimumRequests int
+	// Window is the period over which the error rate is tracked.
+	Window time.Duration
+}
+
+func (c CircuitBreakerConfig) withDefaults() CircuitBreakerConfig {
+	if c.Cooldown <= 0 {
+		c.Cooldown = defaultCircuitBreakerCooldown
+	}
+	if c.ErrorThreshold <= 0 {
+		c.ErrorThreshold = defaultCircuitBreakerErrorThreshold
+	}
+	if c.MinimumRequests <= 0 {
+		c.MinimumRequests = defaultCircuitBreakerMinimumRequests
+	}
+	if c.Window <= 0 {
+		c.Window = defaultCircuitBreakerWindow
+	}
+
+	return c
+}
+
+// CircuitBreakerOpenError is returned for AWS API calls made while a service's circuit breaker is open.
+type CircuitBreakerOpenError struct {
+	Service  string
+	Region   string
+	Failures int // Failed attempts when the circuit opened.
+	Requests int // Total attempts when the circuit opened.
+	Until    time.Time
+}
+
+func (e *CircuitBreakerOpenError) Error() string {
+	return fmt.Sprintf("AWS API circuit breaker open for service %q in region %q: %d of the last %d API calls failed with throttling or server errors; failing fast until %s",
+		e.Service, e.Region, e.Failures, e.Requests, e.Until.UTC().Format(time.RFC3339))
+}
+
+type circuitState int
+
+const (
+	circuitClosed circuitState = iota
+	circuitOpen
+	circuitHalfOpen
+)
+
+// circuitAttempt is the outcome of a single API call attempt.
+type circuitAttempt struct {
+	time   time.Time
+	failed bool
+}
+
+// circuitBreaker tracks the error rate of a single service's API calls.
+type circuitBreaker struct {
+	config  CircuitBreakerConfig
+	service string
+	region  string
+	mu      sync.Mutex
+	now     func() time.Time
+
+	attempts   []circuitAttempt // Within the window, oldest first.
+	lastErr    *CircuitBreakerOpenError
+	probeStart time.Time // When the trial call of a half-open circuit was let through.
+	state      circuitState
+}
+
+func newCircuitBreaker(config CircuitBreakerConfig, service, region string) *circuitBreaker {
+	return &circuitBreaker{
+		config:  config.withDefaults(),
+		service: service,
+		region:  region,
+		now:     time.Now,
+	}
+}
+
+// allow returns an error if an API call attempt must fail fast.
+func (b *circuitBreaker) allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.now()
+
+	switch b.state {
+	case circuitOpen:
+		if now.Before(b.lastErr.Until) {
+			return b.lastErr
+		}
+		b.state = circuitHalfOpen
+		b.probeStart = now
+		return nil
+	case circuitHalfOpen:
+		// A trial call whose outcome is never recorded must not keep the circuit half-open forever.
+		if now.Sub(b.probeStart) < b.config.Cooldown {
+			return b.lastErr
+		}
+		b.probeStart = now
+		return nil
+	}
+
+	return nil
+}
+
+// record records the outcome of an API call attempt and reports whether the circuit's state changed.
+func (b *circuitBreaker) record(failed bool) (circuitState, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.now()
+
+	switch b.state {
+	case circuitOpen:
+		// An attempt let through before the circuit opened.
+		return b.state, false
+	case circuitHalfOpen:
+		if failed {
+			b.lastErr = &CircuitBreakerOpenError{
+				Service:  b.service,
+				Region:   b.region,
+				Failures: b.lastErr.Failures,
+				Requests: b.lastErr.Requests,
+				Until:    now.Add(b.config.Cooldown),
+			}
+			b.state = circuitOpen
+		} else {
+			b.attempts = nil
+			b.state = circuitClosed
+		}
+		return b.state, true
+	}
+
+	b.attempts = append(b.attempts, circuitAttempt{time: now, failed: failed})
+	cutoff := now.Add(-b.config.Window)
+	if i := slices.IndexFunc(b.attempts, func(v circuitAttempt) bool { return v.time.After(cutoff) }); i > 0 {
+		b.attempts = slices.Delete(b.attempts, 0, i)
+	}
+
+	requests := len(b.attempts)
+	if requests < b.config.MinimumRequests {
+		return b.state, false
+	}
+
+	var failures int
+	for _, v := range b.attempts {
+		if v.failed {
+			failures++
+		}
+	}
+	if failures*100 < b.config.ErrorThreshold*requests {
+		return b.state, false
+	}
+
+	b.lastErr = &CircuitBreakerOpenError{
+		Service:  b.service,
+		Region:   b.region,
+		Failures: failures,
+		Requests: requests,
+		Until:    now.Add(b.config.Cooldown),
+	}
+	b.attempts = nil
+	b.state = circuitOpen
+
+	return b.state, true
+}
+
+// recordAndLog records the outcome of an API call attempt, logging any change in the circuit's state.
+func (b *circuitBreaker) recordAndLog(ctx context.Context, operation string, failed bool) {
+	state, changed := b.record(failed)
+	if !changed {
+		return
+	}
+
+	fields := map[string]any{
+		"tf_aws.circuit_breaker.service":   b.service,
+		"tf_aws.circuit_breaker.region":    b.region,
+		"tf_aws.circuit_breaker.operation": operation,
+	}
+
+	switch state {
+	case circuitOpen:
+		tflog.Warn(ctx, "AWS API circuit breaker opened", fields)
+	case circuitClosed:
+		tflog.Info(ctx, "AWS API circuit breaker closed", fields)
+	}
+}
+
+// isCircuitBreakerFailureV2 returns whether an AWS SDK for Go v2 API call attempt failed with a throttling or server error.
+func isCircuitBreakerFailureV2(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if retry.IsErrorThrottles(retry.DefaultThrottles).IsErrorThrottle(err).Bool() {
+		return true
+	}
+
+	var respErr *awshttp.ResponseError
+	return errors.As(err, &respErr) && respErr.HTTPStatusCode() >= http.StatusInternalServerError
+}
+
+// serviceCircuitBreakers selects a service's circuit breaker for the Region of each API call attempt,
+// which may differ from the provider's Region, for example for an S3 bucket in another Region.
+type serviceCircuitBreakers struct {
+	breakers *circuitBreakers
+	service  string
+}
+
+// addToStack adds the circuit breakers to an AWS SDK for Go v2 API client's middleware stack.
+// The circuit breaker applies to each attempt, before the request is signed.
+// The error returned while the circuit is open is not retryable, so the operation fails fast.
+func (c *serviceCircuitBreakers) addToStack(stack *middleware.Stack) error {
+	mw := middleware.FinalizeMiddlewareFunc("TFCircuitBreaker", func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+		b := c.breakers.get(c.service, awsmiddleware.GetRegion(ctx))
+
+		if err := b.allow(); err != nil {
+			return middleware.FinalizeOutput{}, middleware.Metadata{}, err
+		}
+
+		out, metadata, err := next.HandleFinalize(ctx, in)
+
+		b.recordAndLog(ctx, awsmiddleware.GetOperationName(ctx), isCircuitBreakerFailureV2(err))
+
+		return out, metadata, err
+	})
+
+	if _, ok := stack.Finalize.Get("Signing"); ok {
+		return stack.Finalize.Insert(mw, "Signing", middleware.Before)
+	}
+
+	return stack.Finalize.Add(mw, middleware.After)
+}
+
+// configureSession returns a copy of an AWS SDK for Go v1 session with the circuit breakers added.
+func (c *serviceCircuitBreakers) configureSession(sess *session_sdkv1.Session) *session_sdkv1.Session {
+	sess = sess.Copy()
+
+	sess.Handlers.Sign.PushFrontNamed(request_sdkv1.NamedHandler{
+		Name: "TFCircuitBreaker",
+		Fn: func(r *request_sdkv1.Request) {
+			if err := c.breakers.get(c.service, aws_sdkv1.StringValue(r.Config.Region)).allow(); err != nil {
+				r.Error = err
+				r.Retryable = aws_sdkv1.Bool(false)
+			}
+		},
+	})
+	sess.Handlers.CompleteAttempt.PushBackNamed(request_sdkv1.NamedHandler{
+		Name: "TFCircuitBreaker",
+		Fn: func(r *request_sdkv1.Request) {
+			failed := r.IsErrorThrottle() || (r.HTTPResponse != nil && r.HTTPResponse.StatusCode >= http.StatusInternalServerError)
+
+			c.breakers.get(c.service, aws_sdkv1.StringValue(r.Config.Region)).recordAndLog(r.Context(), r.Operation.Name, failed)
+		},
+	})
+
+	return sess
+}
+
+// configureAWSConfig returns a copy of an AWS SDK for Go v2 configuration with the circuit breakers added.
+func (c *serviceCircuitBreakers) configureAWSConfig(cfg *aws_sdkv2.Config) *aws_sdkv2.Config {
+	v := cfg.Copy()
+	v.APIOptions = append(slices.Clone(v.APIOptions), c.addToStack)
+
+	return &v
+}
+
+type circuitBreakerKey struct {
+	service string
+	region  string
+}
+
+// circuitBreakers holds the circuit breakers of each service and Region, created on first use.
+type circuitBreakers struct {
+	config   CircuitBreakerConfig
+	region   string // Used if an API call's Region is unknown.
+	mu       sync.Mutex
+	breakers map[circuitBreakerKey]*circuitBreaker
+}
+
+func newCircuitBreakers(config CircuitBreakerConfig, region string) *circuitBreakers {
+	if !config.Enabled {
+		return nil
+	}
+
+	return &circuitBreakers{
+		config:   config,
+		region:   region,
+		breakers: make(map[circuitBreakerKey]*circuitBreaker),
+	}
+}
+
+// forService returns the circuit breakers of a service.
+func (c *circuitBreakers) forService(servicePackageName string) *serviceCircuitBreakers {
+	return &serviceCircuitBreakers{
+		breakers: c,
+		service:  servicePackageName,
+	}
+}
+
+func (c *circuitBreakers) get(servicePackageName, region string) *circuitBreaker {
+	if region == "" {
+		region = c.region
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := circuitBreakerKey{service: servicePackageName, region: region}
+	b, ok := c.breakers[key]
+	if !ok {
+		b = newCircuitBreaker(c.config, servicePackageName, region)
+		c.breakers[key] = b
+	}
+
+	return b
+}
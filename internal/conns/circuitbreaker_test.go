@@ -0,0 +1,199 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	smithy "github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	b := newCircuitBreaker(CircuitBreakerConfig{
+		Cooldown:        30 * time.Second,
+		ErrorThreshold:  50,
+		MinimumRequests: 4,
+		Window:          time.Minute,
+	}, "ec2", "us-west-2") //lintignore:AWSAT003
+	b.now = func() time.Time { return now }
+
+	// Too few attempts to open the circuit.
+	for i := 0; i < 3; i++ {
+		if err := b.allow(); err != nil {
+			t.Fatalf("attempt %d: unexpected error: %s", i, err)
+		}
+		if _, changed := b.record(true); changed {
+			t.Fatalf("attempt %d: circuit state changed", i)
+		}
+	}
+
+	// Old attempts leave the window.
+	now = now.Add(2 * time.Minute)
+	for i := 0; i < 3; i++ {
+		if _, changed := b.record(i == 0); changed {
+			t.Fatalf("attempt %d: circuit state changed", i)
+		}
+	}
+
+	// The error threshold is reached.
+	if state, changed := b.record(true); !changed || state != circuitOpen {
+		t.Fatalf("state = (%d, %t), want (%d, true)", state, changed, circuitOpen)
+	}
+
+	err := b.allow()
+	var cbErr *CircuitBreakerOpenError
+	if !errors.As(err, &cbErr) {
+		t.Fatalf("expected CircuitBreakerOpenError, got %v", err)
+	}
+	if got, want := cbErr.Error(), `AWS API circuit breaker open for service "ec2" in region "us-west-2": 2 of the last 4 API calls failed with throttling or server errors; failing fast until 2024-01-01T00:02:30Z`; got != want { //lintignore:AWSAT003
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+
+	// After the cooldown a single trial call is let through.
+	now = now.Add(30 * time.Second)
+	if err := b.allow(); err != nil {
+		t.Fatalf("trial call: unexpected error: %s", err)
+	}
+	if err := b.allow(); err == nil {
+		t.Fatal("second call while half-open: expected error")
+	}
+
+	// A failed trial call reopens the circuit.
+	if state, changed := b.record(true); !changed || state != circuitOpen {
+		t.Fatalf("state = (%d, %t), want (%d, true)", state, changed, circuitOpen)
+	}
+	if err := b.allow(); err == nil {
+		t.Fatal("expected error")
+	}
+
+	// A successful trial call closes the circuit.
+	now = now.Add(30 * time.Second)
+	if err := b.allow(); err != nil {
+		t.Fatalf("trial call: unexpected error: %s", err)
+	}
+	if state, changed := b.record(false); !changed || state != circuitClosed {
+		t.Fatalf("state = (%d, %t), want (%d, true)", state, changed, circuitClosed)
+	}
+	if err := b.allow(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestIsCircuitBreakerFailureV2(t *testing.T) {
+	t.Parallel()
+
+	responseError := func(statusCode int, err error) error {
+		return &smithy.OperationError{
+			ServiceID:     "EC2",
+			OperationName: "DescribeInstances",
+			Err: &awshttp.ResponseError{
+				ResponseError: &smithyhttp.ResponseError{
+					Response: &smithyhttp.Response{
+						Response: &http.Response{
+							StatusCode: statusCode,
+						},
+					},
+					Err: err,
+				},
+			},
+		}
+	}
+
+	testCases := map[string]struct {
+		err      error
+		expected bool
+	}{
+		"no error": {},
+		"client error": {
+			err: responseError(http.StatusBadRequest, &smithy.GenericAPIError{Code: "InvalidParameterValue"}),
+		},
+		"throttling": {
+			err:      responseError(http.StatusBadRequest, &smithy.GenericAPIError{Code: "Throttling"}),
+			expected: true,
+		},
+		"server error": {
+			err:      responseError(http.StatusServiceUnavailable, &smithy.GenericAPIError{Code: "ServiceUnavailable"}),
+			expected: true,
+		},
+		"circuit open": {
+			err: &CircuitBreakerOpenError{Service: "ec2"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := isCircuitBreakerFailureV2(testCase.err), testCase.expected; got != want {
+				t.Errorf("isCircuitBreakerFailureV2() = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestCircuitBreakersByRegion(t *testing.T) {
+	t.Parallel()
+
+	breakers := newCircuitBreakers(CircuitBreakerConfig{
+		Enabled:         true,
+		ErrorThreshold:  100,
+		MinimumRequests: 1,
+	}, "us-west-2") //lintignore:AWSAT003
+
+	var calls int
+	call := func(region string) error {
+		stack := middleware.NewStack("test", func() interface{} { return nil })
+		if err := stack.Initialize.Add(&awsmiddleware.RegisterServiceMetadata{
+			Region:        region,
+			OperationName: "GetBucketLocation",
+		}, middleware.Before); err != nil {
+			t.Fatal(err)
+		}
+		if err := breakers.forService("s3").addToStack(stack); err != nil {
+			t.Fatal(err)
+		}
+		handler := middleware.DecorateHandler(middleware.HandlerFunc(func(context.Context, interface{}) (interface{}, middleware.Metadata, error) {
+			calls++
+			return nil, middleware.Metadata{}, &smithy.GenericAPIError{Code: "Throttling"}
+		}), stack)
+
+		_, _, err := handler.Handle(context.Background(), nil)
+
+		return err
+	}
+
+	var cbErr *CircuitBreakerOpenError
+
+	// Opens the circuit in us-west-2.
+	if err := call("us-west-2"); errors.As(err, &cbErr) { //lintignore:AWSAT003
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := call("us-west-2"); !errors.As(err, &cbErr) { //lintignore:AWSAT003
+		t.Fatalf("error = %v, want circuit breaker open error", err)
+	}
+	if got, want := cbErr.Region, "us-west-2"; got != want { //lintignore:AWSAT003
+		t.Errorf("Region = %q, want %q", got, want)
+	}
+
+	// The circuit in us-east-1 is still closed.
+	if err := call("us-east-1"); errors.As(err, &cbErr) { //lintignore:AWSAT003
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got, want := calls, 2; got != want {
+		t.Errorf("calls = %d, want %d", got, want)
+	}
+}
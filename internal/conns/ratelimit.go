@@ -0,0 +1,229 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
+	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// ServiceRateLimit configures a client-side rate limit for the API calls made by a service package.
+type ServiceRateLimit struct {
+	// Service is the provider service package name, e.g. "route53".
+	Service string
+	// Operation optionally restricts the rate limit to a single API operation, e.g. "ChangeResourceRecordSets".
+	Operation         string
+	RequestsPerSecond float64
+	Burst             int
+}
+
+// tokenBucket is a token bucket rate limiter.
+// Tokens are reserved ahead of time so that waiting callers are served in order.
+type tokenBucket struct {
+	burst  float64
+	mu     sync.Mutex
+	now    func() time.Time
+	rate   float64 // Tokens per second.
+	tokens float64
+	last   time.Time
+
+	throttledCount int64
+	throttledTotal time.Duration
+}
+
+func newTokenBucket(rate float64, burst int) *tokenBucket {
+	return &tokenBucket{
+		burst:  float64(burst),
+		now:    time.Now,
+		rate:   rate,
+		tokens: float64(burst),
+	}
+}
+
+// reserve takes a token and returns how long the caller must wait before using it.
+func (b *tokenBucket) reserve() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.now()
+	if !b.last.IsZero() {
+		b.tokens = min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	}
+	b.last = now
+
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+
+	delay := time.Duration(-b.tokens / b.rate * float64(time.Second))
+	b.throttledCount++
+	b.throttledTotal += delay
+
+	return delay
+}
+
+// cancel returns a reserved token to the bucket.
+func (b *tokenBucket) cancel() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.tokens = min(b.burst, b.tokens+1)
+}
+
+func (b *tokenBucket) stats() (int64, time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.throttledCount, b.throttledTotal
+}
+
+// serviceRateLimiter applies the rate limits configured for a single service package.
+type serviceRateLimiter struct {
+	service    string
+	all        *tokenBucket            // Applies to all operations.
+	operations map[string]*tokenBucket // Keyed by operation name.
+}
+
+func newServiceRateLimiters(limits []ServiceRateLimit) (map[string]*serviceRateLimiter, error) {
+	rateLimiters := make(map[string]*serviceRateLimiter)
+
+	for _, v := range limits {
+		if v.RequestsPerSecond <= 0 {
+			return nil, fmt.Errorf("service rate limit (%s): requests per second must be greater than 0, got %g", v.Service, v.RequestsPerSecond)
+		}
+		burst := v.Burst
+		if burst == 0 {
+			burst = 1
+		}
+		if burst < 0 {
+			return nil, fmt.Errorf("service rate limit (%s): burst must be at least 1, got %d", v.Service, v.Burst)
+		}
+
+		l, ok := rateLimiters[v.Service]
+		if !ok {
+			l = &serviceRateLimiter{
+				service:    v.Service,
+				operations: make(map[string]*tokenBucket),
+			}
+			rateLimiters[v.Service] = l
+		}
+
+		bucket := newTokenBucket(v.RequestsPerSecond, burst)
+		if v.Operation == "" {
+			if l.all != nil {
+				return nil, fmt.Errorf("duplicate service rate limit (%s)", v.Service)
+			}
+			l.all = bucket
+		} else {
+			if _, ok := l.operations[v.Operation]; ok {
+				return nil, fmt.Errorf("duplicate service rate limit (%s, %s)", v.Service, v.Operation)
+			}
+			l.operations[v.Operation] = bucket
+		}
+	}
+
+	return rateLimiters, nil
+}
+
+// wait blocks until the specified operation may be called.
+// Operation-level and service-level rate limits both apply.
+// Tokens are reserved from both before waiting, so that a cancelled wait returns them all.
+func (l *serviceRateLimiter) wait(ctx context.Context, operation string) error {
+	var reserved []*tokenBucket
+	var delay time.Duration
+
+	for _, bucket := range []*tokenBucket{l.operations[operation], l.all} {
+		if bucket == nil {
+			continue
+		}
+
+		reserved = append(reserved, bucket)
+
+		d := bucket.reserve()
+		if d == 0 {
+			continue
+		}
+		delay = max(delay, d)
+
+		count, total := bucket.stats()
+		tflog.Info(ctx, "Rate limiting AWS API call", map[string]any{
+			"tf_aws.rate_limit.service":         l.service,
+			"tf_aws.rate_limit.operation":       operation,
+			"tf_aws.rate_limit.delay":           d.String(),
+			"tf_aws.rate_limit.throttled_count": count,
+			"tf_aws.rate_limit.throttled_total": total.String(),
+		})
+	}
+
+	if delay == 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	select {
+	case <-ctx.Done():
+		timer.Stop()
+		for _, bucket := range reserved {
+			bucket.cancel()
+		}
+		return ctx.Err()
+	case <-timer.C:
+	}
+
+	return nil
+}
+
+// addToStack adds the rate limiter to an AWS SDK for Go v2 API client's middleware stack.
+// The rate limit applies to each attempt, before the request is signed.
+func (l *serviceRateLimiter) addToStack(stack *middleware.Stack) error {
+	mw := middleware.FinalizeMiddlewareFunc("TFRateLimit", func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+		if err := l.wait(ctx, awsmiddleware.GetOperationName(ctx)); err != nil {
+			return middleware.FinalizeOutput{}, middleware.Metadata{}, err
+		}
+
+		return next.HandleFinalize(ctx, in)
+	})
+
+	if _, ok := stack.Finalize.Get("Signing"); ok {
+		return stack.Finalize.Insert(mw, "Signing", middleware.Before)
+	}
+
+	return stack.Finalize.Add(mw, middleware.After)
+}
+
+// configureSession returns a copy of an AWS SDK for Go v1 session with the rate limiter added.
+// The rate limit applies to each attempt, before the request is signed.
+func (l *serviceRateLimiter) configureSession(sess *session_sdkv1.Session) *session_sdkv1.Session {
+	sess = sess.Copy()
+
+	sess.Handlers.Sign.PushFrontNamed(request_sdkv1.NamedHandler{
+		Name: "TFRateLimit",
+		Fn: func(r *request_sdkv1.Request) {
+			if err := l.wait(r.Context(), r.Operation.Name); err != nil {
+				r.Error = err
+			}
+		},
+	})
+
+	return sess
+}
+
+// configureAWSConfig returns a copy of an AWS SDK for Go v2 configuration with the rate limiter added.
+func (l *serviceRateLimiter) configureAWSConfig(cfg *aws_sdkv2.Config) *aws_sdkv2.Config {
+	v := cfg.Copy()
+	v.APIOptions = append(slices.Clone(v.APIOptions), l.addToStack)
+
+	return &v
+}
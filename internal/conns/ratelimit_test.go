@@ -0,0 +1,169 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestTokenBucket(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	b := newTokenBucket(2, 3)
+	b.now = func() time.Time { return now }
+
+	// The burst is available immediately.
+	for i := 0; i < 3; i++ {
+		if got := b.reserve(); got != 0 {
+			t.Fatalf("reservation %d: delay = %s, want 0", i, got)
+		}
+	}
+
+	// Further reservations are queued at the sustained rate.
+	if got, want := b.reserve(), 500*time.Millisecond; got != want {
+		t.Errorf("delay = %s, want %s", got, want)
+	}
+	if got, want := b.reserve(), time.Second; got != want {
+		t.Errorf("delay = %s, want %s", got, want)
+	}
+
+	// Tokens are replenished over time.
+	now = now.Add(2 * time.Second)
+	if got := b.reserve(); got != 0 {
+		t.Errorf("delay = %s, want 0", got)
+	}
+
+	if count, total := b.stats(); count != 2 || total != 1500*time.Millisecond {
+		t.Errorf("stats = (%d, %s), want (2, 1.5s)", count, total)
+	}
+}
+
+func TestNewServiceRateLimiters(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		limits      []ServiceRateLimit
+		expectError bool
+	}{
+		"empty": {},
+		"service and operations": {
+			limits: []ServiceRateLimit{
+				{Service: "route53", RequestsPerSecond: 5},
+				{Service: "route53", Operation: "ChangeResourceRecordSets", RequestsPerSecond: 1, Burst: 2},
+				{Service: "iam", RequestsPerSecond: 10, Burst: 10},
+			},
+		},
+		"zero rate": {
+			limits:      []ServiceRateLimit{{Service: "route53"}},
+			expectError: true,
+		},
+		"negative burst": {
+			limits:      []ServiceRateLimit{{Service: "route53", RequestsPerSecond: 1, Burst: -1}},
+			expectError: true,
+		},
+		"duplicate service": {
+			limits: []ServiceRateLimit{
+				{Service: "route53", RequestsPerSecond: 5},
+				{Service: "route53", RequestsPerSecond: 1},
+			},
+			expectError: true,
+		},
+		"duplicate operation": {
+			limits: []ServiceRateLimit{
+				{Service: "route53", Operation: "GetChange", RequestsPerSecond: 5},
+				{Service: "route53", Operation: "GetChange", RequestsPerSecond: 1},
+			},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := newServiceRateLimiters(testCase.limits)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("error = %v, expectError = %t", err, want)
+			}
+		})
+	}
+}
+
+func TestServiceRateLimiterWait(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	rateLimiters, err := newServiceRateLimiters([]ServiceRateLimit{
+		{Service: "route53", Operation: "ChangeResourceRecordSets", RequestsPerSecond: 0.001},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	l := rateLimiters["route53"]
+
+	// Other operations are not rate limited.
+	for i := 0; i < 3; i++ {
+		if err := l.wait(ctx, "GetChange"); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	if err := l.wait(ctx, "ChangeResourceRecordSets"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// The next call must wait, so it is cancelled.
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+
+	if err := l.wait(ctx, "ChangeResourceRecordSets"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("error = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestServiceRateLimiterWaitCancelled(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	rateLimiters, err := newServiceRateLimiters([]ServiceRateLimit{
+		{Service: "route53", RequestsPerSecond: 0.001},
+		{Service: "route53", Operation: "ChangeResourceRecordSets", RequestsPerSecond: 0.001, Burst: 2},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	l := rateLimiters["route53"]
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, bucket := range []*tokenBucket{l.all, l.operations["ChangeResourceRecordSets"]} {
+		bucket.now = func() time.Time { return now }
+	}
+
+	if err := l.wait(ctx, "ChangeResourceRecordSets"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// The service-level token is used up, so the next call must wait and is cancelled.
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+
+	if err := l.wait(ctx, "ChangeResourceRecordSets"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("error = %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	// The cancelled call's tokens are returned to both buckets.
+	if got, want := l.operations["ChangeResourceRecordSets"].tokens, 1.0; got != want {
+		t.Errorf("operation tokens = %g, want %g", got, want)
+	}
+	if got, want := l.all.tokens, 0.0; got != want {
+		t.Errorf("service tokens = %g, want %g", got, want)
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"time"
+)
+
+// ResourceTimeouts holds provider-level overrides of a resource type's default operation timeouts.
+// A zero value leaves the resource's own default in place.
+// Timeouts configured in a resource's `timeouts` block take precedence.
+type ResourceTimeouts struct {
+	Create time.Duration
+	Update time.Duration
+	Delete time.Duration
+}
+
+// NewResourceTimeoutsContext returns a Context enhanced with a resource type's provider-level default timeouts.
+func NewResourceTimeoutsContext(ctx context.Context, timeouts ResourceTimeouts) context.Context {
+	return context.WithValue(ctx, timeoutsKey, &timeouts)
+}
+
+// ResourceTimeoutsFromContext returns the provider-level default timeouts kept in Context.
+func ResourceTimeoutsFromContext(ctx context.Context) (*ResourceTimeouts, bool) {
+	v, ok := ctx.Value(timeoutsKey).(*ResourceTimeouts)
+	return v, ok
+}
+
+type timeoutsKeyType int
+
+var timeoutsKey timeoutsKeyType
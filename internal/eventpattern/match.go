@@ -0,0 +1,307 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eventpattern
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+)
+
+// Match reports whether the event, in JSON format, matches the event pattern, in JSON format.
+func Match(pattern, event string) (bool, error) {
+	p, err := Parse(pattern)
+	if err != nil {
+		return false, fmt.Errorf("event pattern: %w", err)
+	}
+
+	return p.Match(event)
+}
+
+// Match reports whether the event, in JSON format, matches the pattern.
+func (p *Pattern) Match(event string) (bool, error) {
+	v, err := decode(event)
+	if err != nil {
+		return false, fmt.Errorf("event: invalid JSON: %w", err)
+	}
+
+	if _, ok := v.(map[string]any); !ok {
+		return false, fmt.Errorf("event: must be a JSON object")
+	}
+
+	return matchObject(p.root, v), nil
+}
+
+// matchObject reports whether an event value matches an object in a pattern.
+// All fields of the object must match.
+func matchObject(pattern map[string]any, event any) bool {
+	m, _ := event.(map[string]any)
+
+	for key, value := range pattern {
+		if key == KeyOr {
+			if !slices.ContainsFunc(value.([]any), func(alternative any) bool {
+				return matchObject(alternative.(map[string]any), event)
+			}) {
+				return false
+			}
+			continue
+		}
+
+		field, present := m[key]
+
+		switch value := value.(type) {
+		case map[string]any:
+			// Objects in an array of objects are matched individually.
+			if elements, ok := field.([]any); ok {
+				if !slices.ContainsFunc(elements, func(element any) bool {
+					return matchObject(value, element)
+				}) {
+					return false
+				}
+				continue
+			}
+
+			if !matchObject(value, field) {
+				return false
+			}
+
+		case []any:
+			if !matchField(value, field, present) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// matchField reports whether an event field matches any of the specified matchers.
+// If the field is an array, any of its elements can match.
+func matchField(matchers []any, field any, present bool) bool {
+	_, isObject := field.(map[string]any)
+	leaf := present && !isObject
+
+	values := []any{field}
+	if elements, ok := field.([]any); ok {
+		values = elements
+	}
+
+	for _, matcher := range matchers {
+		if m, ok := matcher.(map[string]any); ok {
+			if exists, ok := m[MatcherExists]; ok {
+				if exists == leaf {
+					return true
+				}
+				continue
+			}
+		}
+
+		if !leaf {
+			continue
+		}
+
+		if slices.ContainsFunc(values, func(value any) bool {
+			return matchValue(matcher, value)
+		}) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchValue reports whether a scalar event value matches a matcher.
+func matchValue(matcher, value any) bool {
+	m, ok := matcher.(map[string]any)
+	if !ok {
+		return equal(matcher, value)
+	}
+
+	for operator, operand := range m {
+		s, isString := value.(string)
+
+		switch operator {
+		case MatcherAnythingBut:
+			return matchAnythingBut(operand, value)
+
+		case MatcherCIDR:
+			_, network, _ := net.ParseCIDR(operand.(string))
+			ip := net.ParseIP(s)
+			return isString && ip != nil && network.Contains(ip)
+
+		case MatcherEqualsIgnoreCase:
+			return isString && strings.EqualFold(s, operand.(string))
+
+		case MatcherNumeric:
+			n, ok := value.(json.Number)
+			if !ok {
+				return false
+			}
+			f, err := n.Float64()
+			if err != nil {
+				return false
+			}
+			conditions, _ := parseNumericConditions(operand)
+			return matchNumeric(conditions, f)
+
+		case MatcherPrefix, MatcherSuffix:
+			if !isString {
+				return false
+			}
+			affix, caseSensitive := operand.(string)
+			if !caseSensitive {
+				affix = operand.(map[string]any)[MatcherEqualsIgnoreCase].(string)
+				s, affix = strings.ToLower(s), strings.ToLower(affix)
+			}
+			if operator == MatcherPrefix {
+				return strings.HasPrefix(s, affix)
+			}
+			return strings.HasSuffix(s, affix)
+
+		case MatcherWildcard:
+			return isString && matchWildcard(operand.(string), s)
+		}
+	}
+
+	return false
+}
+
+func matchAnythingBut(operand, value any) bool {
+	switch value.(type) {
+	case nil, map[string]any, []any:
+		return false
+	}
+
+	switch operand := operand.(type) {
+	case []any:
+		return !slices.ContainsFunc(operand, func(excluded any) bool {
+			return equal(excluded, value)
+		})
+
+	case map[string]any:
+		s, ok := value.(string)
+		if !ok {
+			return false
+		}
+		for operator, v := range operand {
+			switch operator {
+			case MatcherPrefix:
+				return !strings.HasPrefix(s, v.(string))
+			case MatcherSuffix:
+				return !strings.HasSuffix(s, v.(string))
+			case MatcherEqualsIgnoreCase:
+				excluded, _ := stringOrStrings(v)
+				return !slices.ContainsFunc(excluded, func(excluded string) bool {
+					return strings.EqualFold(s, excluded)
+				})
+			case MatcherWildcard:
+				excluded, _ := stringOrStrings(v)
+				return !slices.ContainsFunc(excluded, func(excluded string) bool {
+					return matchWildcard(excluded, s)
+				})
+			}
+		}
+		return false
+	}
+
+	return !equal(operand, value)
+}
+
+func matchNumeric(conditions []numericCondition, n float64) bool {
+	for _, condition := range conditions {
+		var ok bool
+		switch condition.operator {
+		case NumericOperatorEquals:
+			ok = n == condition.value
+		case NumericOperatorGreaterThan:
+			ok = n > condition.value
+		case NumericOperatorGreaterThanOrEqual:
+			ok = n >= condition.value
+		case NumericOperatorLessThan:
+			ok = n < condition.value
+		case NumericOperatorLessThanOrEqual:
+			ok = n <= condition.value
+		}
+		if !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// matchWildcard reports whether a string matches a wildcard pattern.
+// `*` matches any sequence of characters and `\*` and `\\` match a literal `*` and `\`.
+func matchWildcard(pattern, s string) bool {
+	type token struct {
+		literal rune
+		star    bool
+	}
+
+	var tokens []token
+	for runes, i := []rune(pattern), 0; i < len(runes); i++ {
+		switch r := runes[i]; {
+		case r == '\\' && i+1 < len(runes):
+			i++
+			tokens = append(tokens, token{literal: runes[i]})
+		case r == '*':
+			tokens = append(tokens, token{star: true})
+		default:
+			tokens = append(tokens, token{literal: r})
+		}
+	}
+
+	// Iterative matching with single-star backtracking.
+	runes := []rune(s)
+	var t, i int
+	star, mark := -1, 0
+	for i < len(runes) {
+		switch {
+		case t < len(tokens) && !tokens[t].star && tokens[t].literal == runes[i]:
+			t++
+			i++
+		case t < len(tokens) && tokens[t].star:
+			star, mark = t, i
+			t++
+		case star >= 0:
+			t = star + 1
+			mark++
+			i = mark
+		default:
+			return false
+		}
+	}
+	for t < len(tokens) && tokens[t].star {
+		t++
+	}
+
+	return t == len(tokens)
+}
+
+// equal reports whether two scalar JSON values are equal. Numbers are compared numerically.
+func equal(a, b any) bool {
+	switch b.(type) {
+	case map[string]any, []any:
+		return false
+	}
+
+	if a, ok := numberValue(a); ok {
+		b, ok := numberValue(b)
+		return ok && a == b
+	}
+
+	return a == b
+}
+
+func numberValue(v any) (float64, bool) {
+	n, ok := v.(json.Number)
+	if !ok {
+		return 0, false
+	}
+
+	f, err := n.Float64()
+	return f, err == nil
+}
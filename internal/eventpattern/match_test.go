@@ -0,0 +1,237 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eventpattern
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		pattern       string
+		expectedError string
+	}{
+		"valid": {
+			pattern: `{"source": ["aws.ec2"], "detail": {"state": [{"anything-but": ["running", "pending"]}], "size": [{"numeric": [">", 0, "<=", 5]}]}}`,
+		},
+		"invalid JSON": {
+			pattern:       `{"source": }`,
+			expectedError: "invalid JSON",
+		},
+		"not an object": {
+			pattern:       `["aws.ec2"]`,
+			expectedError: "event pattern must be a JSON object",
+		},
+		"empty": {
+			pattern:       `{}`,
+			expectedError: "event pattern must not be empty",
+		},
+		"scalar field": {
+			pattern:       `{"source": "aws.ec2"}`,
+			expectedError: "source: must be an object or an array",
+		},
+		"empty array": {
+			pattern:       `{"source": []}`,
+			expectedError: "source: must not be empty",
+		},
+		"unsupported content filter": {
+			pattern:       `{"source": [{"contains": "ec2"}]}`,
+			expectedError: `source[0]: unsupported content filter "contains"`,
+		},
+		"numeric equals with range": {
+			pattern:       `{"detail": {"size": [{"numeric": ["=", 1, "<", 5]}]}}`,
+			expectedError: `detail.size[0]: numeric "=" cannot be combined with other operators`,
+		},
+		"numeric two lower bounds": {
+			pattern:       `{"detail": {"size": [{"numeric": [">", 1, ">=", 5]}]}}`,
+			expectedError: "detail.size[0]: numeric range must have at most one lower and one upper bound",
+		},
+		"invalid cidr": {
+			pattern:       `{"detail": {"ip": [{"cidr": "10.0.0.0/33"}]}}`,
+			expectedError: `detail.ip[0]: cidr value "10.0.0.0/33" is not a valid CIDR block`,
+		},
+		"or alternative not an object": {
+			pattern:       `{"$or": [{"source": ["a"]}, "b"]}`,
+			expectedError: "$or[1]: must be an object",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := Parse(testCase.pattern)
+
+			if testCase.expectedError == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error")
+			}
+			if got, want := err.Error(), testCase.expectedError; !strings.HasPrefix(got, want) {
+				t.Errorf("err = %q, want prefix %q", got, want)
+			}
+		})
+	}
+}
+
+func TestMatch(t *testing.T) {
+	t.Parallel()
+
+	const event = `{
+  "source": "aws.ec2",
+  "detail-type": "EC2 Instance State-change Notification",
+  "resources": ["arn:aws:ec2:us-east-1:123456789012:instance/i-1234567890abcdef0"],
+  "detail": {
+    "instance-id": "i-1234567890abcdef0",
+    "state": "running",
+    "size": 3,
+    "ip": "10.0.1.7",
+    "tags": [{"key": "env", "value": "prod"}, {"key": "team", "value": "Platform"}],
+    "nothing": null
+  }
+}` //lintignore:AWSAT003,AWSAT005
+
+	testCases := map[string]struct {
+		pattern  string
+		expected bool
+	}{
+		"exact": {
+			pattern:  `{"source": ["aws.ec2"], "detail": {"state": ["running"]}}`,
+			expected: true,
+		},
+		"exact no match": {
+			pattern: `{"source": ["aws.s3"]}`,
+		},
+		"array field": {
+			pattern:  `{"resources": [{"prefix": "arn:aws:ec2:"}]}`,
+			expected: true,
+		},
+		"number": {
+			pattern:  `{"detail": {"size": [3.0]}}`,
+			expected: true,
+		},
+		"null": {
+			pattern:  `{"detail": {"nothing": [null]}}`,
+			expected: true,
+		},
+		"prefix": {
+			pattern:  `{"detail": {"instance-id": [{"prefix": "i-123"}]}}`,
+			expected: true,
+		},
+		"prefix ignore case": {
+			pattern:  `{"detail": {"instance-id": [{"prefix": {"equals-ignore-case": "I-123"}}]}}`,
+			expected: true,
+		},
+		"suffix": {
+			pattern: `{"detail": {"instance-id": [{"suffix": "ff"}]}}`,
+		},
+		"equals ignore case": {
+			pattern:  `{"detail": {"state": [{"equals-ignore-case": "RUNNING"}]}}`,
+			expected: true,
+		},
+		"anything-but": {
+			pattern: `{"detail": {"state": [{"anything-but": ["running", "pending"]}]}}`,
+		},
+		"anything-but prefix": {
+			pattern:  `{"detail": {"state": [{"anything-but": {"prefix": "stop"}}]}}`,
+			expected: true,
+		},
+		"anything-but missing field": {
+			pattern: `{"detail": {"missing": [{"anything-but": "x"}]}}`,
+		},
+		"numeric range": {
+			pattern:  `{"detail": {"size": [{"numeric": [">", 0, "<=", 3]}]}}`,
+			expected: true,
+		},
+		"numeric out of range": {
+			pattern: `{"detail": {"size": [{"numeric": [">", 3]}]}}`,
+		},
+		"numeric string": {
+			pattern: `{"detail": {"state": [{"numeric": [">", 0]}]}}`,
+		},
+		"exists": {
+			pattern:  `{"detail": {"state": [{"exists": true}], "missing": [{"exists": false}]}}`,
+			expected: true,
+		},
+		"exists object": {
+			pattern: `{"detail": [{"exists": true}]}`,
+		},
+		"wildcard": {
+			pattern:  `{"detail-type": [{"wildcard": "EC2 * State-change*"}]}`,
+			expected: true,
+		},
+		"wildcard no match": {
+			pattern: `{"detail-type": [{"wildcard": "*Spot*"}]}`,
+		},
+		"cidr": {
+			pattern:  `{"detail": {"ip": [{"cidr": "10.0.0.0/16"}]}}`,
+			expected: true,
+		},
+		"array of objects": {
+			pattern:  `{"detail": {"tags": {"key": ["team"], "value": [{"equals-ignore-case": "platform"}]}}}`,
+			expected: true,
+		},
+		"or": {
+			pattern:  `{"$or": [{"source": ["aws.s3"]}, {"detail": {"size": [{"numeric": [">=", 3]}]}}]}`,
+			expected: true,
+		},
+		"or no match": {
+			pattern: `{"$or": [{"source": ["aws.s3"]}, {"detail": {"state": ["stopped"]}}]}`,
+		},
+		"nested or": {
+			pattern:  `{"detail": {"$or": [{"state": ["stopped"]}, {"ip": [{"prefix": "10."}]}]}}`,
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Match(testCase.pattern, event)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if want := testCase.expected; got != want {
+				t.Errorf("Match() = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestMatchWildcard(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		pattern, s string
+		expected   bool
+	}{
+		{"*", "", true},
+		{"a*c", "abbbc", true},
+		{"a*c", "abbbd", false},
+		{"*.png", "dir/file.png", true},
+		{"a*b*c", "aXbYbZc", true},
+		{`a\*`, "a*", true},
+		{`a\*`, "ab", false},
+		{"abc", "abcd", false},
+	}
+
+	for _, testCase := range testCases {
+		if got, want := matchWildcard(testCase.pattern, testCase.s), testCase.expected; got != want {
+			t.Errorf("matchWildcard(%q, %q) = %t, want %t", testCase.pattern, testCase.s, got, want)
+		}
+	}
+}
@@ -0,0 +1,328 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package eventpattern validates Amazon EventBridge event patterns and matches events against them offline.
+package eventpattern
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+)
+
+// Event pattern reference:
+// https://docs.aws.amazon.com/eventbridge/latest/userguide/eb-event-patterns.html
+// https://docs.aws.amazon.com/eventbridge/latest/userguide/eb-create-pattern-operators.html
+
+const (
+	KeyOr = "$or"
+
+	MatcherAnythingBut      = "anything-but"
+	MatcherCIDR             = "cidr"
+	MatcherEqualsIgnoreCase = "equals-ignore-case"
+	MatcherExists           = "exists"
+	MatcherNumeric          = "numeric"
+	MatcherPrefix           = "prefix"
+	MatcherSuffix           = "suffix"
+	MatcherWildcard         = "wildcard"
+)
+
+const (
+	NumericOperatorEquals             = "="
+	NumericOperatorGreaterThan        = ">"
+	NumericOperatorGreaterThanOrEqual = ">="
+	NumericOperatorLessThan           = "<"
+	NumericOperatorLessThanOrEqual    = "<="
+)
+
+// Pattern is a parsed and validated event pattern.
+type Pattern struct {
+	root map[string]any
+}
+
+// Parse parses and validates an event pattern in JSON format.
+// All problems found are returned, joined.
+func Parse(pattern string) (*Pattern, error) {
+	v, err := decode(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid JSON: %w", err)
+	}
+
+	root, ok := v.(map[string]any)
+	if !ok {
+		return nil, errors.New("event pattern must be a JSON object")
+	}
+
+	var errs []error
+	validateObject("", root, &errs)
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return &Pattern{root: root}, nil
+}
+
+func decode(s string) (any, error) {
+	decoder := json.NewDecoder(strings.NewReader(s))
+	decoder.UseNumber()
+
+	var v any
+	if err := decoder.Decode(&v); err != nil {
+		return nil, err
+	}
+	if decoder.More() {
+		return nil, errors.New("unexpected data after top-level value")
+	}
+
+	return v, nil
+}
+
+func validateObject(path string, m map[string]any, errs *[]error) {
+	errorf := func(path, format string, a ...any) {
+		*errs = append(*errs, fmt.Errorf("%s: %s", path, fmt.Sprintf(format, a...)))
+	}
+
+	if len(m) == 0 {
+		if path == "" {
+			*errs = append(*errs, errors.New("event pattern must not be empty"))
+		} else {
+			errorf(path, "must not be empty")
+		}
+		return
+	}
+
+	for _, key := range sortedKeys(m) {
+		keyPath := joinPath(path, key)
+
+		switch value := m[key].(type) {
+		case map[string]any:
+			validateObject(keyPath, value, errs)
+
+		case []any:
+			if key == KeyOr {
+				if len(value) == 0 {
+					errorf(keyPath, "must not be empty")
+				}
+				for i, alternative := range value {
+					alternativePath := indexPath(keyPath, i)
+					if alternative, ok := alternative.(map[string]any); ok {
+						validateObject(alternativePath, alternative, errs)
+					} else {
+						errorf(alternativePath, "must be an object")
+					}
+				}
+				continue
+			}
+
+			if len(value) == 0 {
+				errorf(keyPath, "must not be empty")
+			}
+			for i, matcher := range value {
+				if err := validateMatcher(matcher); err != nil {
+					errorf(indexPath(keyPath, i), "%s", err)
+				}
+			}
+
+		default:
+			errorf(keyPath, "must be an object or an array")
+		}
+	}
+}
+
+func validateMatcher(matcher any) error {
+	switch matcher := matcher.(type) {
+	case nil, bool, json.Number, string:
+		return nil
+
+	case map[string]any:
+		if len(matcher) != 1 {
+			return fmt.Errorf("a content filter must have exactly one operator, found %d", len(matcher))
+		}
+
+		for operator, value := range matcher {
+			switch operator {
+			case MatcherAnythingBut:
+				return validateAnythingBut(value)
+
+			case MatcherCIDR:
+				s, ok := value.(string)
+				if !ok {
+					return fmt.Errorf("%s value must be a string", operator)
+				}
+				if _, _, err := net.ParseCIDR(s); err != nil {
+					return fmt.Errorf("%s value %q is not a valid CIDR block", operator, s)
+				}
+
+			case MatcherEqualsIgnoreCase, MatcherWildcard:
+				if _, ok := value.(string); !ok {
+					return fmt.Errorf("%s value must be a string", operator)
+				}
+
+			case MatcherExists:
+				if _, ok := value.(bool); !ok {
+					return fmt.Errorf("%s value must be a boolean", operator)
+				}
+
+			case MatcherNumeric:
+				_, err := parseNumericConditions(value)
+				return err
+
+			case MatcherPrefix, MatcherSuffix:
+				switch value := value.(type) {
+				case string:
+				case map[string]any:
+					if s, ok := value[MatcherEqualsIgnoreCase].(string); !ok || len(value) != 1 {
+						return fmt.Errorf("%s value must be a string or an object with a single %s string", operator, MatcherEqualsIgnoreCase)
+					} else if s == "" {
+						return fmt.Errorf("%s value must not be empty", operator)
+					}
+				default:
+					return fmt.Errorf("%s value must be a string", operator)
+				}
+
+			default:
+				return fmt.Errorf("unsupported content filter %q", operator)
+			}
+		}
+
+		return nil
+	}
+
+	return errors.New("must be a string, number, boolean, null or content filter object")
+}
+
+func validateAnythingBut(value any) error {
+	switch value := value.(type) {
+	case json.Number, string:
+		return nil
+
+	case []any:
+		if len(value) == 0 {
+			return fmt.Errorf("%s list must not be empty", MatcherAnythingBut)
+		}
+		for _, v := range value {
+			switch v.(type) {
+			case json.Number, string:
+			default:
+				return fmt.Errorf("%s list values must be strings or numbers", MatcherAnythingBut)
+			}
+		}
+		return nil
+
+	case map[string]any:
+		if len(value) != 1 {
+			return fmt.Errorf("%s object must have exactly one operator", MatcherAnythingBut)
+		}
+		for operator, v := range value {
+			switch operator {
+			case MatcherPrefix, MatcherSuffix:
+				if s, ok := v.(string); !ok || s == "" {
+					return fmt.Errorf("%s %s value must be a non-empty string", MatcherAnythingBut, operator)
+				}
+			case MatcherEqualsIgnoreCase, MatcherWildcard:
+				if _, err := stringOrStrings(v); err != nil {
+					return fmt.Errorf("%s %s value %w", MatcherAnythingBut, operator, err)
+				}
+			default:
+				return fmt.Errorf("unsupported %s operator %q", MatcherAnythingBut, operator)
+			}
+		}
+		return nil
+	}
+
+	return fmt.Errorf("%s value must be a string, number, list or object", MatcherAnythingBut)
+}
+
+type numericCondition struct {
+	operator string
+	value    float64
+}
+
+func parseNumericConditions(value any) ([]numericCondition, error) {
+	tokens, ok := value.([]any)
+	if !ok || len(tokens) == 0 || len(tokens)%2 != 0 {
+		return nil, fmt.Errorf("%s value must be a list of operator and number pairs", MatcherNumeric)
+	}
+
+	var conditions []numericCondition
+	var lower, upper int
+	for i := 0; i < len(tokens); i += 2 {
+		operator, ok := tokens[i].(string)
+		if !ok {
+			return nil, fmt.Errorf("%s operator must be a string", MatcherNumeric)
+		}
+
+		switch operator {
+		case NumericOperatorEquals:
+			if len(tokens) != 2 { //nolint:mnd // a single operator and number
+				return nil, fmt.Errorf("%s %q cannot be combined with other operators", MatcherNumeric, operator)
+			}
+		case NumericOperatorGreaterThan, NumericOperatorGreaterThanOrEqual:
+			lower++
+		case NumericOperatorLessThan, NumericOperatorLessThanOrEqual:
+			upper++
+		default:
+			return nil, fmt.Errorf("unsupported %s operator %q", MatcherNumeric, operator)
+		}
+
+		n, ok := numberValue(tokens[i+1])
+		if !ok {
+			return nil, fmt.Errorf("%s %q value must be a number", MatcherNumeric, operator)
+		}
+
+		conditions = append(conditions, numericCondition{operator: operator, value: n})
+	}
+
+	if lower > 1 || upper > 1 {
+		return nil, fmt.Errorf("%s range must have at most one lower and one upper bound", MatcherNumeric)
+	}
+
+	return conditions, nil
+}
+
+func stringOrStrings(v any) ([]string, error) {
+	switch v := v.(type) {
+	case string:
+		return []string{v}, nil
+	case []any:
+		if len(v) == 0 {
+			return nil, errors.New("must not be empty")
+		}
+		s := make([]string, 0, len(v))
+		for _, v := range v {
+			v, ok := v.(string)
+			if !ok {
+				return nil, errors.New("must be a string or a list of strings")
+			}
+			s = append(s, v)
+		}
+		return s, nil
+	}
+
+	return nil, errors.New("must be a string or a list of strings")
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	return keys
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+
+	return path + "." + key
+}
+
+func indexPath(path string, i int) string {
+	return fmt.Sprintf("%s[%d]", path, i)
+}
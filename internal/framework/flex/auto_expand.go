@@ -358,6 +358,17 @@ func (expander autoExpander) object(ctx context.Context, vFrom basetypes.ObjectV
 				return diags
 			}
 		}
+
+	case reflect.Interface:
+		//
+		// types.Object -> union.
+		//
+		if vFrom, ok := vFrom.(fwtypes.NestedObjectValue); ok {
+			if u, ok := lookupUnionType(tTo); ok {
+				diags.Append(expander.nestedObjectToUnion(ctx, vFrom, u, vTo)...)
+				return diags
+			}
+		}
 	}
 
 	tflog.Info(ctx, "AutoFlex Expand; incompatible types", map[string]interface{}{
@@ -704,17 +715,27 @@ func (expander autoExpander) nestedObjectCollection(ctx context.Context, vFrom f
 
 		case reflect.Interface:
 			//
-			// types.List(OfObject) -> []interface.
+			// types.List(OfObject) -> []union.
 			//
-			// Smithy union type handling not yet implemented. Silently skip.
+			if u, ok := lookupUnionType(tElem); ok {
+				diags.Append(expander.nestedObjectToUnionSlice(ctx, vFrom, u, tTo, vTo)...)
+				return diags
+			}
+
+			// Unregistered Smithy union types are silently skipped.
 			return diags
 		}
 
 	case reflect.Interface:
 		//
-		// types.List(OfObject) -> interface.
+		// types.List(OfObject) -> union.
 		//
-		// Smithy union type handling not yet implemented. Silently skip.
+		if u, ok := lookupUnionType(tTo); ok {
+			diags.Append(expander.nestedObjectToUnion(ctx, vFrom, u, vTo)...)
+			return diags
+		}
+
+		// Unregistered Smithy union types are silently skipped.
 		return diags
 	}
 
@@ -786,6 +807,112 @@ func (expander autoExpander) nestedObjectToSlice(ctx context.Context, vFrom fwty
 	return diags
 }
 
+// nestedObjectToUnion copies a Plugin Framework NestedObjectValue to a compatible AWS API union (interface) value.
+func (expander autoExpander) nestedObjectToUnion(ctx context.Context, vFrom fwtypes.NestedObjectValue, u *unionType, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Get the nested Object as a pointer.
+	from, d := vFrom.ToObjectPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	member, d := expander.union(ctx, from, u)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if member.IsValid() {
+		vTo.Set(member)
+	}
+
+	return diags
+}
+
+// nestedObjectToUnionSlice copies a Plugin Framework NestedObjectCollectionValue to a compatible AWS API []union value.
+// Elements with no union member set are skipped.
+func (expander autoExpander) nestedObjectToUnionSlice(ctx context.Context, vFrom fwtypes.NestedObjectCollectionValue, u *unionType, tSlice reflect.Type, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Get the nested Objects as a slice.
+	from, d := vFrom.ToObjectSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Create a new target slice and expand each element.
+	f := reflect.ValueOf(from)
+	n := f.Len()
+	t := reflect.MakeSlice(tSlice, 0, n)
+	for i := 0; i < n; i++ {
+		member, d := expander.union(ctx, f.Index(i).Interface(), u)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if member.IsValid() {
+			t = reflect.Append(t, member)
+		}
+	}
+
+	vTo.Set(t)
+
+	return diags
+}
+
+// union expands the Plugin Framework model of a union to the AWS API union member whose field is set.
+// An invalid value is returned if no field is set.
+func (expander autoExpander) union(ctx context.Context, from any, u *unionType) (reflect.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	valFrom := reflect.ValueOf(from)
+	if kind := valFrom.Kind(); kind == reflect.Ptr {
+		valFrom = valFrom.Elem()
+	}
+
+	var name string
+	for _, field := range u.fields {
+		v, d := u.modelField(valFrom, field)
+		diags.Append(d...)
+		if diags.HasError() {
+			return reflect.Value{}, diags
+		}
+
+		set, d := isUnionMemberSet(ctx, v.Interface().(attr.Value))
+		diags.Append(d...)
+		if diags.HasError() {
+			return reflect.Value{}, diags
+		}
+
+		if !set {
+			continue
+		}
+
+		if name != "" {
+			diags.AddError("AutoFlEx", fmt.Sprintf("union type %s: more than one member set (%s, %s)", u.name, name, field))
+			return reflect.Value{}, diags
+		}
+		name = field
+	}
+
+	if name == "" {
+		return reflect.Value{}, diags
+	}
+
+	// Create a new union member and expand its value.
+	member := reflect.New(u.members[name].Elem())
+	diags.Append(expander.convert(ctx, valFrom.FieldByName(name), member.Elem().FieldByName(unionMemberValueFieldName))...)
+	if diags.HasError() {
+		return reflect.Value{}, diags
+	}
+
+	return member, diags
+}
+
 // nestedKeyObjectToMap copies a Plugin Framework NestedObjectCollectionValue to a compatible AWS API map[string]struct value.
 func (expander autoExpander) nestedKeyObjectToMap(ctx context.Context, vFrom fwtypes.NestedObjectCollectionValue, tElem reflect.Type, vTo reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
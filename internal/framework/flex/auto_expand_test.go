@@ -1037,3 +1037,61 @@ func runAutoExpandTestCases(ctx context.Context, t *testing.T, testCases autoFle
 		})
 	}
 }
+
+func TestExpandUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	unionString := &TestFlexUnionTF01{
+		String: types.StringValue("a"),
+		Struct: fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx),
+	}
+	unionStruct := &TestFlexUnionTF01{
+		String: types.StringNull(),
+		Struct: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexTF01{Field1: types.StringValue("b")}),
+	}
+
+	testCases := autoFlexTestCases{
+		{
+			TestName:   "union member value",
+			Source:     &TestFlexUnionTF02{Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, unionString)},
+			Target:     &TestFlexUnionAWS01{},
+			WantTarget: &TestFlexUnionAWS01{Field1: &TestFlexAWSUnionMemberString{Value: "a"}},
+		},
+		{
+			TestName:   "union member struct",
+			Source:     &TestFlexUnionTF02{Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, unionStruct)},
+			Target:     &TestFlexUnionAWS01{},
+			WantTarget: &TestFlexUnionAWS01{Field1: &TestFlexAWSUnionMemberStruct{Value: TestFlexAWS01{Field1: "b"}}},
+		},
+		{
+			TestName: "union no member",
+			Source: &TestFlexUnionTF02{Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexUnionTF01{
+				String: types.StringNull(),
+				Struct: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []TestFlexTF01{}),
+			})},
+			Target:     &TestFlexUnionAWS01{},
+			WantTarget: &TestFlexUnionAWS01{},
+		},
+		{
+			TestName: "union multiple members",
+			Source: &TestFlexUnionTF02{Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexUnionTF01{
+				String: types.StringValue("a"),
+				Struct: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexTF01{Field1: types.StringValue("b")}),
+			})},
+			Target:  &TestFlexUnionAWS01{},
+			WantErr: true,
+		},
+		{
+			TestName: "slice of union",
+			Source:   &TestFlexUnionTF02{Field1: fwtypes.NewListNestedObjectValueOfSliceMust(ctx, []*TestFlexUnionTF01{unionString, unionStruct})},
+			Target:   &TestFlexUnionAWS02{},
+			WantTarget: &TestFlexUnionAWS02{Field1: []TestFlexAWSUnion{
+				&TestFlexAWSUnionMemberString{Value: "a"},
+				&TestFlexAWSUnionMemberStruct{Value: TestFlexAWS01{Field1: "b"}},
+			}},
+		},
+	}
+	runAutoExpandTestCases(ctx, t, testCases)
+}
@@ -356,6 +356,15 @@ func (flattener autoFlattener) interface_(ctx context.Context, vFrom reflect.Val
 
 		vTo.Set(reflect.ValueOf(v))
 		return diags
+
+	case fwtypes.NestedObjectType:
+		//
+		// union -> types.List(OfObject) or types.Object.
+		//
+		if u, ok := lookupUnionType(vFrom.Type()); ok {
+			diags.Append(flattener.unionToNestedObject(ctx, vFrom, u, tTo, vTo)...)
+			return diags
+		}
 	}
 
 	tflog.Info(ctx, "AutoFlex Flatten; incompatible types", map[string]interface{}{
@@ -564,7 +573,17 @@ func (flattener autoFlattener) slice(ctx context.Context, vFrom reflect.Value, t
 		}
 
 	case reflect.Interface:
-		// Smithy union type handling not yet implemented. Silently skip.
+		if tTo, ok := tTo.(fwtypes.NestedObjectCollectionType); ok {
+			if u, ok := lookupUnionType(tSliceElem); ok {
+				//
+				// []union -> types.List(OfObject).
+				//
+				diags.Append(flattener.sliceOfUnionNestedObjectCollection(ctx, vFrom, u, tTo, vTo)...)
+				return diags
+			}
+		}
+
+		// Unregistered Smithy union types are silently skipped.
 		return diags
 	}
 
@@ -937,6 +956,142 @@ func (flattener autoFlattener) sliceOfStructNestedObjectCollection(ctx context.C
 	return diags
 }
 
+// unionToNestedObject copies an AWS API union (interface) value to a compatible Plugin Framework NestedObjectValue value.
+func (flattener autoFlattener) unionToNestedObject(ctx context.Context, vFrom reflect.Value, u *unionType, tTo fwtypes.NestedObjectType, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if vFrom.IsNil() {
+		val, d := tTo.NullValue(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		vTo.Set(reflect.ValueOf(val))
+		return diags
+	}
+
+	// Create a new target structure and set the member's field.
+	to, d := tTo.NewObjectPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(flattener.union(ctx, vFrom, u, to)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Set the target structure as a mapped Object.
+	val, d := tTo.ValueFromObjectPtr(ctx, to)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	vTo.Set(reflect.ValueOf(val))
+	return diags
+}
+
+// sliceOfUnionNestedObjectCollection copies an AWS API []union value to a compatible Plugin Framework NestedObjectCollectionValue value.
+func (flattener autoFlattener) sliceOfUnionNestedObjectCollection(ctx context.Context, vFrom reflect.Value, u *unionType, tTo fwtypes.NestedObjectCollectionType, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if vFrom.IsNil() {
+		val, d := tTo.NullValue(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		vTo.Set(reflect.ValueOf(val))
+		return diags
+	}
+
+	// Create a new target slice and flatten each element.
+	n := vFrom.Len()
+	to, d := tTo.NewObjectSlice(ctx, n, n)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	t := reflect.ValueOf(to)
+	for i := 0; i < n; i++ {
+		target, d := tTo.NewObjectPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		diags.Append(flattener.union(ctx, vFrom.Index(i), u, target)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		t.Index(i).Set(reflect.ValueOf(target))
+	}
+
+	// Set the target structure as a nested Object.
+	val, d := tTo.ValueFromObjectSlice(ctx, to)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	vTo.Set(reflect.ValueOf(val))
+	return diags
+}
+
+// union copies the value of an AWS API union member to the corresponding field of the union's Plugin Framework model.
+// The model's other fields, or all fields for a nil union value, are set to null.
+func (flattener autoFlattener) union(ctx context.Context, vFrom reflect.Value, u *unionType, to any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	valTo := reflect.ValueOf(to).Elem()
+	for _, field := range u.fields {
+		v, d := u.modelField(valTo, field)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		null, err := nullAttrValue(ctx, v.Interface().(attr.Value))
+		if err != nil {
+			diags.AddError("AutoFlEx", err.Error())
+			return diags
+		}
+
+		if vNull := reflect.ValueOf(null); vNull.Type().AssignableTo(v.Type()) {
+			v.Set(vNull)
+		}
+	}
+
+	if vFrom.IsNil() {
+		return diags
+	}
+
+	member := vFrom.Elem()
+	name, ok := u.names[member.Type()]
+	if !ok {
+		// For example, a member added to the AWS API since the model was written.
+		tflog.Warn(ctx, "AutoFlex Flatten; unsupported union member", map[string]interface{}{
+			"union":  u.name,
+			"member": member.Type().String(),
+		})
+
+		return diags
+	}
+
+	diags.Append(flattener.convert(ctx, member.Elem().FieldByName(unionMemberValueFieldName), valTo.FieldByName(name))...)
+	if diags.HasError() {
+		return diags
+	}
+
+	return diags
+}
+
 // blockKeyMapSet takes a struct and assigns the value of the `key`
 func blockKeyMapSet(to any, key reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
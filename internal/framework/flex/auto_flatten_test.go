@@ -1187,3 +1187,59 @@ func runAutoFlattenTestCases(ctx context.Context, t *testing.T, testCases autoFl
 		})
 	}
 }
+
+func TestFlattenUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	unionString := &TestFlexUnionTF01{
+		String: types.StringValue("a"),
+		Struct: fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx),
+	}
+	unionStruct := &TestFlexUnionTF01{
+		String: types.StringNull(),
+		Struct: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &TestFlexTF01{Field1: types.StringValue("b")}),
+	}
+	unionNull := &TestFlexUnionTF01{
+		String: types.StringNull(),
+		Struct: fwtypes.NewListNestedObjectValueOfNull[TestFlexTF01](ctx),
+	}
+
+	testCases := autoFlexTestCases{
+		{
+			TestName:   "union member value",
+			Source:     &TestFlexUnionAWS01{Field1: &TestFlexAWSUnionMemberString{Value: "a"}},
+			Target:     &TestFlexUnionTF02{},
+			WantTarget: &TestFlexUnionTF02{Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, unionString)},
+		},
+		{
+			TestName:   "union member struct",
+			Source:     &TestFlexUnionAWS01{Field1: &TestFlexAWSUnionMemberStruct{Value: TestFlexAWS01{Field1: "b"}}},
+			Target:     &TestFlexUnionTF02{},
+			WantTarget: &TestFlexUnionTF02{Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, unionStruct)},
+		},
+		{
+			TestName:   "union nil",
+			Source:     &TestFlexUnionAWS01{},
+			Target:     &TestFlexUnionTF02{},
+			WantTarget: &TestFlexUnionTF02{Field1: fwtypes.NewListNestedObjectValueOfNull[TestFlexUnionTF01](ctx)},
+		},
+		{
+			TestName:   "union unregistered member",
+			Source:     &TestFlexUnionAWS01{Field1: &TestFlexAWSUnionMemberUnregistered{Value: "c"}},
+			Target:     &TestFlexUnionTF02{},
+			WantTarget: &TestFlexUnionTF02{Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, unionNull)},
+		},
+		{
+			TestName: "slice of union",
+			Source: &TestFlexUnionAWS02{Field1: []TestFlexAWSUnion{
+				&TestFlexAWSUnionMemberString{Value: "a"},
+				&TestFlexAWSUnionMemberStruct{Value: TestFlexAWS01{Field1: "b"}},
+			}},
+			Target:     &TestFlexUnionTF02{},
+			WantTarget: &TestFlexUnionTF02{Field1: fwtypes.NewListNestedObjectValueOfSliceMust(ctx, []*TestFlexUnionTF01{unionString, unionStruct})},
+		},
+	}
+	runAutoFlattenTestCases(ctx, t, testCases)
+}
@@ -361,3 +361,51 @@ type TestFlexAWS21 struct {
 type TestFlexAWS22 struct {
 	Field1 map[string]map[string]*string
 }
+
+// TestFlexAWSUnion is a Smithy union type.
+type TestFlexAWSUnion interface {
+	isTestFlexAWSUnion()
+}
+
+type TestFlexAWSUnionMemberString struct {
+	Value string
+}
+
+func (*TestFlexAWSUnionMemberString) isTestFlexAWSUnion() {}
+
+type TestFlexAWSUnionMemberStruct struct {
+	Value TestFlexAWS01
+}
+
+func (*TestFlexAWSUnionMemberStruct) isTestFlexAWSUnion() {}
+
+// TestFlexAWSUnionMemberUnregistered is not in the union's discriminator mapping.
+type TestFlexAWSUnionMemberUnregistered struct {
+	Value string
+}
+
+func (*TestFlexAWSUnionMemberUnregistered) isTestFlexAWSUnion() {}
+
+func init() {
+	RegisterUnionType(map[string]TestFlexAWSUnion{
+		"String": &TestFlexAWSUnionMemberString{},
+		"Struct": &TestFlexAWSUnionMemberStruct{},
+	})
+}
+
+type TestFlexUnionTF01 struct {
+	String types.String                                  `tfsdk:"string"`
+	Struct fwtypes.ListNestedObjectValueOf[TestFlexTF01] `tfsdk:"struct"`
+}
+
+type TestFlexUnionTF02 struct {
+	Field1 fwtypes.ListNestedObjectValueOf[TestFlexUnionTF01] `tfsdk:"field1"`
+}
+
+type TestFlexUnionAWS01 struct {
+	Field1 TestFlexAWSUnion
+}
+
+type TestFlexUnionAWS02 struct {
+	Field1 []TestFlexAWSUnion
+}
@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// AWS SDK for Go v2 union types are interfaces implemented by a set of member types,
+// each of which is a struct with a single `Value` field holding the member's value.
+// The Plugin Framework model of a union is a nested object with one field per member,
+// of which at most one is set.
+
+// unionMemberValueFieldName is the name of the field holding an AWS SDK for Go v2 union member's value.
+const unionMemberValueFieldName = "Value"
+
+// unionType is the discriminator mapping for an AWS SDK for Go v2 union type.
+type unionType struct {
+	name    string
+	fields  []string                // Model field names, sorted.
+	members map[string]reflect.Type // Member (pointer) types, keyed by model field name.
+	names   map[reflect.Type]string // Model field names, keyed by member (pointer) type.
+}
+
+var (
+	unionTypesMu sync.RWMutex
+	unionTypes   = make(map[reflect.Type]*unionType)
+)
+
+// RegisterUnionType registers the discriminator mapping for the AWS SDK for Go v2 union (interface) type `T`,
+// enabling AutoFlEx to expand and flatten values of the type.
+// `members` maps the name of each field of the Plugin Framework model of the union to an instance of the corresponding union member type.
+// For example:
+//
+//	flex.RegisterUnionType(map[string]awstypes.PolicyDefinition{
+//		"Static":         &awstypes.PolicyDefinitionMemberStatic{},
+//		"TemplateLinked": &awstypes.PolicyDefinitionMemberTemplateLinked{},
+//	})
+//
+// RegisterUnionType is intended to be called from an init function and panics if the mapping is invalid.
+func RegisterUnionType[T any](members map[string]T) {
+	tUnion := reflect.TypeOf((*T)(nil)).Elem()
+	if tUnion.Kind() != reflect.Interface {
+		panic(fmt.Sprintf("AutoFlEx: union type %s is not an interface", tUnion))
+	}
+
+	u := &unionType{
+		name:    tUnion.String(),
+		members: make(map[string]reflect.Type, len(members)),
+		names:   make(map[reflect.Type]string, len(members)),
+	}
+	for name, member := range members {
+		tMember := reflect.TypeOf(member)
+		if tMember == nil || tMember.Kind() != reflect.Ptr || tMember.Elem().Kind() != reflect.Struct {
+			panic(fmt.Sprintf("AutoFlEx: union type %s member %s (%T) is not a pointer to struct", u.name, name, member))
+		}
+		if _, ok := tMember.Elem().FieldByName(unionMemberValueFieldName); !ok {
+			panic(fmt.Sprintf("AutoFlEx: union type %s member %s (%T) has no %s field", u.name, name, member, unionMemberValueFieldName))
+		}
+		if v, ok := u.names[tMember]; ok {
+			panic(fmt.Sprintf("AutoFlEx: union type %s member %T mapped to both %s and %s", u.name, member, v, name))
+		}
+
+		u.fields = append(u.fields, name)
+		u.members[name] = tMember
+		u.names[tMember] = name
+	}
+	slices.Sort(u.fields)
+
+	unionTypesMu.Lock()
+	defer unionTypesMu.Unlock()
+
+	unionTypes[tUnion] = u
+}
+
+// lookupUnionType returns the registered discriminator mapping for the specified type.
+func lookupUnionType(t reflect.Type) (*unionType, bool) {
+	unionTypesMu.RLock()
+	defer unionTypesMu.RUnlock()
+
+	u, ok := unionTypes[t]
+	return u, ok
+}
+
+// modelField returns the specified field of a union's Plugin Framework model.
+func (u *unionType) modelField(valModel reflect.Value, name string) (reflect.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	v := valModel.FieldByName(name)
+	if !v.IsValid() || !v.CanSet() {
+		diags.AddError("AutoFlEx", fmt.Sprintf("union type %s: model %s has no settable field %s", u.name, valModel.Type(), name))
+		return reflect.Value{}, diags
+	}
+	if _, ok := v.Interface().(attr.Value); !ok {
+		diags.AddError("AutoFlEx", fmt.Sprintf("union type %s: model field %s does not implement attr.Value", u.name, name))
+		return reflect.Value{}, diags
+	}
+
+	return v, diags
+}
+
+// isUnionMemberSet returns whether a union member's Plugin Framework value is set.
+// Empty lists and sets are treated as not set so that unset nested blocks are ignored.
+func isUnionMemberSet(ctx context.Context, v attr.Value) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() || v.IsUnknown() {
+		return false, diags
+	}
+
+	switch v := v.(type) {
+	case basetypes.ListValuable:
+		l, d := v.ToListValue(ctx)
+		diags.Append(d...)
+		return len(l.Elements()) > 0, diags
+
+	case basetypes.SetValuable:
+		s, d := v.ToSetValue(ctx)
+		diags.Append(d...)
+		return len(s.Elements()) > 0, diags
+	}
+
+	return true, diags
+}
+
+// nullAttrValue returns the null value of the specified Plugin Framework value's type.
+func nullAttrValue(ctx context.Context, v attr.Value) (attr.Value, error) {
+	t := v.Type(ctx)
+
+	return t.ValueFromTerraform(ctx, tftypes.NewValue(t.TerraformType(ctx), nil))
+}
@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"fmt"
+	"math/big"
+	"net/netip"
+
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// parseCIDRBlock parses the specified CIDR block, which must be the CIDR block for its network.
+func parseCIDRBlock(cidr string) (netip.Prefix, error) {
+	if err := itypes.ValidateCIDRBlock(cidr); err != nil {
+		return netip.Prefix{}, err
+	}
+
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("%q is not a valid CIDR block: %w", cidr, err)
+	}
+
+	return prefix.Masked(), nil
+}
+
+// cidrSubnet returns the num'th subnet of base whose prefix length is extended by newbits.
+func cidrSubnet(base netip.Prefix, newbits int, num *big.Int) (netip.Prefix, error) {
+	bitLen := base.Addr().BitLen()
+	bits := base.Bits() + newbits
+
+	if newbits < 0 {
+		return netip.Prefix{}, fmt.Errorf("newbits (%d) must not be negative", newbits)
+	}
+	if bits > bitLen {
+		return netip.Prefix{}, fmt.Errorf("insufficient address space to extend prefix of %d by %d", base.Bits(), newbits)
+	}
+	if max := new(big.Int).Lsh(big.NewInt(1), uint(newbits)); num.Sign() < 0 || num.Cmp(max) >= 0 {
+		return netip.Prefix{}, fmt.Errorf("prefix extension of %d does not accommodate a subnet numbered %s", newbits, num)
+	}
+
+	v := addrToInt(base.Addr())
+	v.Or(v, new(big.Int).Lsh(num, uint(bitLen-bits)))
+
+	return netip.PrefixFrom(intToAddr(v, base.Addr().Is4()), bits), nil
+}
+
+// cidrAddressOffset returns the address offset by n from the network address of the specified prefix.
+func cidrAddressOffset(prefix netip.Prefix, n int64) netip.Addr {
+	v := addrToInt(prefix.Masked().Addr())
+	v.Add(v, big.NewInt(n))
+
+	return intToAddr(v, prefix.Addr().Is4())
+}
+
+func addrToInt(addr netip.Addr) *big.Int {
+	return new(big.Int).SetBytes(addr.AsSlice())
+}
+
+func intToAddr(v *big.Int, is4 bool) netip.Addr {
+	if is4 {
+		return netip.AddrFrom4([4]byte(v.FillBytes(make([]byte, 4))))
+	}
+
+	return netip.AddrFrom16([16]byte(v.FillBytes(make([]byte, 16))))
+}
@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = cidrContainsFunction{}
+
+func NewCIDRContainsFunction() function.Function {
+	return &cidrContainsFunction{}
+}
+
+type cidrContainsFunction struct{}
+
+func (f cidrContainsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_contains"
+}
+
+func (f cidrContainsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "cidr_contains Function",
+		MarkdownDescription: "Checks whether an IP address or CIDR block is contained within a CIDR block. " +
+			"Addresses and CIDR blocks of a different IP address family are never contained.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "containing_cidr_block",
+				MarkdownDescription: "IPv4 or IPv6 CIDR block",
+			},
+			function.StringParameter{
+				Name:                "contained_ip_address_or_cidr_block",
+				MarkdownDescription: "IP address or CIDR block to check",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f cidrContainsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var containing, contained string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &containing, &contained))
+	if resp.Error != nil {
+		return
+	}
+
+	container, err := parseCIDRBlock(containing)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	result, err := cidrContains(container, contained)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// cidrContains returns whether the specified IP address or CIDR block is contained within container.
+func cidrContains(container netip.Prefix, s string) (bool, error) {
+	var prefix netip.Prefix
+
+	if strings.Contains(s, "/") {
+		v, err := parseCIDRBlock(s)
+		if err != nil {
+			return false, err
+		}
+		prefix = v
+	} else {
+		addr, err := netip.ParseAddr(s)
+		if err != nil {
+			return false, fmt.Errorf("%q is not a valid IP address: %w", s, err)
+		}
+		if addr.Zone() != "" {
+			return false, fmt.Errorf("%q is not a valid IP address: zones are not supported", s)
+		}
+		prefix = netip.PrefixFrom(addr, addr.BitLen())
+	}
+
+	if prefix.Addr().Is4() != container.Addr().Is4() {
+		return false, nil
+	}
+
+	return prefix.Bits() >= container.Bits() && container.Contains(prefix.Addr()), nil
+}
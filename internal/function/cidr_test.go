@@ -0,0 +1,279 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"math"
+	"net/netip"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCIDRContains(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		container string
+		contained string
+		expected  bool
+		wantErr   bool
+	}{
+		"ipv4 address inside": {
+			container: "10.0.0.0/16",
+			contained: "10.0.1.1",
+			expected:  true,
+		},
+		"ipv4 address outside": {
+			container: "10.0.0.0/16",
+			contained: "10.1.0.0",
+		},
+		"ipv4 cidr block inside": {
+			container: "10.0.0.0/16",
+			contained: "10.0.128.0/17",
+			expected:  true,
+		},
+		"ipv4 cidr block equal": {
+			container: "10.0.0.0/16",
+			contained: "10.0.0.0/16",
+			expected:  true,
+		},
+		"ipv4 cidr block larger": {
+			container: "10.0.0.0/16",
+			contained: "10.0.0.0/8",
+		},
+		"ipv6 address inside": {
+			container: "2600:1f14:abc:de00::/56",
+			contained: "2600:1f14:abc:deff::1",
+			expected:  true,
+		},
+		"mixed address families": {
+			container: "10.0.0.0/8",
+			contained: "2600:1f14:abc:de00::/56",
+		},
+		"invalid address": {
+			container: "10.0.0.0/8",
+			contained: "10.0.0",
+			wantErr:   true,
+		},
+		"zoned address": {
+			container: "fe80::/10",
+			contained: "fe80::1%eth0",
+			wantErr:   true,
+		},
+		"invalid cidr block": {
+			container: "10.0.0.0/8",
+			contained: "10.0.0.1/24",
+			wantErr:   true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := cidrContains(netip.MustParsePrefix(testCase.container), testCase.contained)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("cidrContains() err %t, want %t (%v)", got, want, err)
+			}
+			if got != testCase.expected {
+				t.Errorf("cidrContains() = %t, want %t", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestVPCSubnetPlan(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		cidrBlock         string
+		availabilityZones []string
+		newbits           int64
+		expected          []plannedSubnet
+		wantErr           bool
+	}{
+		"two zones": {
+			cidrBlock:         "10.0.0.0/24",
+			availabilityZones: []string{"us-west-2a", "us-west-2b"},
+			newbits:           2,
+			expected: []plannedSubnet{
+				{"us-west-2a", "10.0.0.0/26", "10.0.0.4", "10.0.0.62", 59},
+				{"us-west-2b", "10.0.0.64/26", "10.0.0.68", "10.0.0.126", 59},
+				{"us-west-2a", "10.0.0.128/26", "10.0.0.132", "10.0.0.190", 59},
+				{"us-west-2b", "10.0.0.192/26", "10.0.0.196", "10.0.0.254", 59},
+			},
+		},
+		"three zones drops remainder": {
+			cidrBlock:         "10.0.0.0/26",
+			availabilityZones: []string{"a", "b", "c"},
+			newbits:           2,
+			expected: []plannedSubnet{
+				{"a", "10.0.0.0/28", "10.0.0.4", "10.0.0.14", 11},
+				{"b", "10.0.0.16/28", "10.0.0.20", "10.0.0.30", 11},
+				{"c", "10.0.0.32/28", "10.0.0.36", "10.0.0.46", 11},
+			},
+		},
+		"remainder unallocated": {
+			cidrBlock:         "10.0.0.0/16",
+			availabilityZones: []string{"a", "b", "c"},
+			newbits:           2,
+			expected: []plannedSubnet{
+				{"a", "10.0.0.0/18", "10.0.0.4", "10.0.63.254", 16379},
+				{"b", "10.0.64.0/18", "10.0.64.4", "10.0.127.254", 16379},
+				{"c", "10.0.128.0/18", "10.0.128.4", "10.0.191.254", 16379},
+			},
+		},
+		"no extension": {
+			cidrBlock:         "192.168.0.0/28",
+			availabilityZones: []string{"a"},
+			newbits:           0,
+			expected: []plannedSubnet{
+				{"a", "192.168.0.0/28", "192.168.0.4", "192.168.0.14", 11},
+			},
+		},
+		"too few subnets": {
+			cidrBlock:         "10.0.0.0/16",
+			availabilityZones: []string{"a", "b", "c"},
+			newbits:           1,
+			wantErr:           true,
+		},
+		"subnet too small": {
+			cidrBlock:         "10.0.0.0/26",
+			availabilityZones: []string{"a"},
+			newbits:           3,
+			wantErr:           true,
+		},
+		"newbits overflow": {
+			cidrBlock:         "10.0.0.0/16",
+			availabilityZones: []string{"a"},
+			newbits:           math.MaxInt64,
+			wantErr:           true,
+		},
+		"negative newbits": {
+			cidrBlock:         "10.0.0.0/16",
+			availabilityZones: []string{"a"},
+			newbits:           -1,
+			wantErr:           true,
+		},
+		"vpc too large": {
+			cidrBlock:         "10.0.0.0/15",
+			availabilityZones: []string{"a"},
+			newbits:           1,
+			wantErr:           true,
+		},
+		"ipv6": {
+			cidrBlock:         "2600:1f14:abc:de00::/56",
+			availabilityZones: []string{"a"},
+			newbits:           8,
+			wantErr:           true,
+		},
+		"no zones": {
+			cidrBlock: "10.0.0.0/16",
+			newbits:   1,
+			wantErr:   true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := vpcSubnetPlan(testCase.cidrBlock, testCase.availabilityZones, testCase.newbits)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("vpcSubnetPlan() err %t, want %t (%v)", got, want, err)
+			}
+			if diff := cmp.Diff(got, testCase.expected, cmp.AllowUnexported(plannedSubnet{})); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestIPv6CIDRSubnets(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		cidrBlock string
+		count     int64
+		expected  []string
+		wantErr   bool
+	}{
+		"slash 56": {
+			cidrBlock: "2600:1f14:abc:de00::/56",
+			count:     2,
+			expected:  []string{"2600:1f14:abc:de00::/64", "2600:1f14:abc:de01::/64"},
+		},
+		"slash 56 all": {
+			cidrBlock: "2600:1f14:abc:de00::/56",
+			count:     256,
+		},
+		"slash 64": {
+			cidrBlock: "2600:1f14:abc:de00::/64",
+			count:     1,
+			expected:  []string{"2600:1f14:abc:de00::/64"},
+		},
+		"count exceeds block": {
+			cidrBlock: "2600:1f14:abc:de00::/56",
+			count:     257,
+			wantErr:   true,
+		},
+		"count exceeds maximum": {
+			cidrBlock: "::/0",
+			count:     math.MaxInt64,
+			wantErr:   true,
+		},
+		"count exceeds maximum large block": {
+			cidrBlock: "2600::/32",
+			count:     4294967296,
+			wantErr:   true,
+		},
+		"count maximum": {
+			cidrBlock: "2600::/32",
+			count:     4096,
+		},
+		"count zero": {
+			cidrBlock: "2600:1f14:abc:de00::/56",
+			wantErr:   true,
+		},
+		"prefix too long": {
+			cidrBlock: "2600:1f14:abc:de00::/80",
+			count:     1,
+			wantErr:   true,
+		},
+		"ipv4": {
+			cidrBlock: "10.0.0.0/16",
+			count:     1,
+			wantErr:   true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ipv6CIDRSubnets(testCase.cidrBlock, testCase.count)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("ipv6CIDRSubnets() err %t, want %t (%v)", got, want, err)
+			}
+			if err != nil {
+				return
+			}
+			if got, want := int64(len(got)), testCase.count; got != want {
+				t.Errorf("ipv6CIDRSubnets() returned %d subnets, want %d", got, want)
+			}
+			if testCase.expected != nil {
+				if diff := cmp.Diff(got, testCase.expected); diff != "" {
+					t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestEventPatternMatchesFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testEventPatternMatchesFunctionConfig("running"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "true"),
+				),
+			},
+			{
+				Config: testEventPatternMatchesFunctionConfig("stopped"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestEventPatternMatchesFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+  value = provider::aws::event_pattern_matches(jsonencode({ source = [{ contains = "ec2" }] }), jsonencode({ source = "aws.ec2" }))
+}
+`,
+				ExpectError: regexache.MustCompile(`unsupported[\s\n]*content[\s\n]*filter`),
+			},
+		},
+	})
+}
+
+func testEventPatternMatchesFunctionConfig(state string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::event_pattern_matches(
+    jsonencode({
+      source = ["aws.ec2"]
+      detail = {
+        state = [{ "anything-but" = ["stopped", "terminated"] }]
+        size  = [{ numeric = [">", 0, "<=", 5] }]
+      }
+    }),
+    jsonencode({
+      source = "aws.ec2"
+      detail = {
+        state = %[1]q
+        size  = 3
+      }
+    }),
+  )
+}
+`, state)
+}
@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/iampolicy"
+)
+
+var iamPolicyEvaluateMatchedStatementAttrTypes = map[string]attr.Type{
+	"policy_type":  types.StringType,
+	"policy_index": types.Int64Type,
+	"sid":          types.StringType,
+	"effect":       types.StringType,
+}
+
+var iamPolicyEvaluateResultAttrTypes = map[string]attr.Type{
+	"decision": types.StringType,
+	"allowed":  types.BoolType,
+	"matched_statements": types.ListType{
+		ElemType: types.ObjectType{AttrTypes: iamPolicyEvaluateMatchedStatementAttrTypes},
+	},
+	"missing_context_keys": types.ListType{
+		ElemType: types.StringType,
+	},
+}
+
+var _ function.Function = iamPolicyEvaluateFunction{}
+
+func NewIAMPolicyEvaluateFunction() function.Function {
+	return &iamPolicyEvaluateFunction{}
+}
+
+type iamPolicyEvaluateFunction struct{}
+
+func (f iamPolicyEvaluateFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "iam_policy_evaluate"
+}
+
+func (f iamPolicyEvaluateFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "iam_policy_evaluate Function",
+		MarkdownDescription: "Evaluates whether IAM policies allow a request, offline and without credentials. " +
+			"Identity-based policies, a resource-based policy, a permissions boundary and service control policies are " +
+			"combined following the IAM policy evaluation logic for a request within a single account.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "action",
+				MarkdownDescription: "Action to evaluate, such as `s3:GetObject`",
+			},
+			function.StringParameter{
+				Name:                "resource",
+				MarkdownDescription: "ARN of the resource to evaluate, or `*`",
+			},
+			function.MapParameter{
+				Name: "policies",
+				ElementType: types.ListType{
+					ElemType: types.StringType,
+				},
+				MarkdownDescription: "IAM policy documents in JSON format, keyed by policy type: `identity`, `resource`, " +
+					"`permissions_boundary` and `service_control`",
+			},
+			function.MapParameter{
+				Name: "context",
+				ElementType: types.ListType{
+					ElemType: types.StringType,
+				},
+				MarkdownDescription: "Request context values, keyed by condition key. " +
+					"The `aws:PrincipalArn` key identifies the calling principal to resource-based policies",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: iamPolicyEvaluateResultAttrTypes,
+		},
+	}
+}
+
+func (f iamPolicyEvaluateFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var action, resource string
+	var policies, requestContext map[string][]string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &action, &resource, &policies, &requestContext))
+	if resp.Error != nil {
+		return
+	}
+
+	var input iampolicy.Policies
+	for k, v := range policies {
+		docs := make([]*iampolicy.Document, 0, len(v))
+		for i, policy := range v {
+			doc, err := iampolicy.Parse(policy)
+			if err != nil {
+				resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(2, fmt.Sprintf("%s policy %d: %s", k, i, err)))
+				return
+			}
+			docs = append(docs, doc)
+		}
+
+		switch iampolicy.PolicyType(k) {
+		case iampolicy.PolicyTypeIdentity:
+			input.Identity = docs
+		case iampolicy.PolicyTypePermissionsBoundary:
+			input.PermissionsBoundary = docs
+		case iampolicy.PolicyTypeResource:
+			if len(docs) > 1 {
+				resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(2, "at most one resource policy can be specified"))
+				return
+			}
+			if len(docs) == 1 {
+				input.Resource = docs[0]
+			}
+		case iampolicy.PolicyTypeServiceControl:
+			input.ServiceControl = docs
+		default:
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(2, fmt.Sprintf("unsupported policy type %q, expected one of %v", k, enum.Values[iampolicy.PolicyType]())))
+			return
+		}
+	}
+
+	result, err := iampolicy.Evaluate(input, iampolicy.Request{
+		Action:   action,
+		Resource: resource,
+		Context:  requestContext,
+	})
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(2, err.Error()))
+		return
+	}
+
+	matchedStatements := make([]attr.Value, 0, len(result.MatchedStatements))
+	for _, v := range result.MatchedStatements {
+		matchedStatement, d := types.ObjectValue(iamPolicyEvaluateMatchedStatementAttrTypes, map[string]attr.Value{
+			"policy_type":  types.StringValue(string(v.PolicyType)),
+			"policy_index": types.Int64Value(int64(v.PolicyIndex)),
+			"sid":          types.StringValue(v.Sid),
+			"effect":       types.StringValue(v.Effect),
+		})
+		if d.HasError() {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+			return
+		}
+
+		matchedStatements = append(matchedStatements, matchedStatement)
+	}
+
+	missingContextKeys := make([]attr.Value, 0, len(result.MissingContextKeys))
+	for _, v := range result.MissingContextKeys {
+		missingContextKeys = append(missingContextKeys, types.StringValue(v))
+	}
+
+	value := map[string]attr.Value{
+		"decision":             types.StringValue(string(result.Decision)),
+		"allowed":              types.BoolValue(result.Allowed()),
+		"matched_statements":   types.ListValueMust(types.ObjectType{AttrTypes: iamPolicyEvaluateMatchedStatementAttrTypes}, matchedStatements),
+		"missing_context_keys": types.ListValueMust(types.StringType, missingContextKeys),
+	}
+
+	resultValue, d := types.ObjectValue(iamPolicyEvaluateResultAttrTypes, value)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, resultValue))
+}
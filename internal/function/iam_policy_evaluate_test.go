@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestIAMPolicyEvaluateFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyEvaluateFunctionConfig("s3:GetObject", "arn:aws:s3:::test/key"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("decision", "allowed"),
+					resource.TestCheckOutput("allowed", "true"),
+					resource.TestCheckOutput("sid", "AllowRead"),
+				),
+			},
+			{
+				Config: testIAMPolicyEvaluateFunctionConfig("s3:GetObject", "arn:aws:s3:::test/secret/key"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("decision", "explicitDeny"),
+					resource.TestCheckOutput("allowed", "false"),
+					resource.TestCheckOutput("sid", "DenySecret"),
+				),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyEvaluateFunction_implicitDeny(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyEvaluateFunctionConfig("s3:PutObject", "arn:aws:s3:::test/key"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("decision", "implicitDeny"),
+					resource.TestCheckOutput("allowed", "false"),
+					resource.TestCheckOutput("matched_statement_count", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyEvaluateFunction_invalidPolicyType(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+  value = provider::aws::iam_policy_evaluate("s3:GetObject", "*", { session = [] }, {})
+}
+`,
+				ExpectError: regexache.MustCompile(`unsupported[\s\n]*policy[\s\n]*type`),
+			},
+		},
+	})
+}
+
+func testIAMPolicyEvaluateFunctionConfig(action, resource string) string {
+	return fmt.Sprintf(`
+locals {
+  identity_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Sid      = "AllowRead"
+        Effect   = "Allow"
+        Action   = "s3:Get*"
+        Resource = "arn:aws:s3:::test/*"
+      },
+      {
+        Sid      = "DenySecret"
+        Effect   = "Deny"
+        Action   = "s3:*"
+        Resource = "arn:aws:s3:::test/secret/*"
+      },
+    ]
+  })
+
+  result = provider::aws::iam_policy_evaluate(%[1]q, %[2]q, {
+    identity = [local.identity_policy]
+  }, {})
+}
+
+output "decision" {
+  value = local.result.decision
+}
+
+output "allowed" {
+  value = local.result.allowed
+}
+
+output "sid" {
+  value = length(local.result.matched_statements) > 0 ? local.result.matched_statements[0].sid : ""
+}
+
+output "matched_statement_count" {
+  value = length(local.result.matched_statements)
+}
+`, action, resource)
+}
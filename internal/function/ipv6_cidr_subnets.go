@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// Subnet IPv6 CIDR block reference:
+	// https://docs.aws.amazon.com/vpc/latest/userguide/subnet-sizing.html#subnet-sizing-ipv6
+
+	// subnetIPv6PrefixLength is the prefix length of the IPv6 CIDR blocks carved for subnets.
+	subnetIPv6PrefixLength = 64
+
+	// ipv6CIDRSubnetsMaxCount bounds the number of subnet CIDR blocks that can be carved in a single call.
+	// It accommodates every /64 in a /52, well beyond the 200 subnets per VPC default quota.
+	ipv6CIDRSubnetsMaxCount = 4096
+)
+
+var _ function.Function = ipv6CIDRSubnetsFunction{}
+
+func NewIPv6CIDRSubnetsFunction() function.Function {
+	return &ipv6CIDRSubnetsFunction{}
+}
+
+type ipv6CIDRSubnetsFunction struct{}
+
+func (f ipv6CIDRSubnetsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "ipv6_cidr_subnets"
+}
+
+func (f ipv6CIDRSubnetsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "ipv6_cidr_subnets Function",
+		MarkdownDescription: "Carves consecutive /64 subnet CIDR blocks from an IPv6 CIDR block, " +
+			"such as the /56 CIDR block associated with a VPC. At most 4096 subnet CIDR blocks can be carved.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "cidr_block",
+				MarkdownDescription: "IPv6 CIDR block with a prefix length of at most /64",
+			},
+			function.Int64Parameter{
+				Name:                "count",
+				MarkdownDescription: "Number of /64 subnet CIDR blocks to carve, between 1 and 4096",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f ipv6CIDRSubnetsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidrBlock string
+	var count int64
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cidrBlock, &count))
+	if resp.Error != nil {
+		return
+	}
+
+	result, funcErr := ipv6CIDRSubnets(cidrBlock, count)
+	if funcErr != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, funcErr)
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// ipv6CIDRSubnets returns the first count /64 subnets of the specified IPv6 CIDR block.
+func ipv6CIDRSubnets(cidrBlock string, count int64) ([]string, *function.FuncError) {
+	base, err := parseCIDRBlock(cidrBlock)
+	if err != nil {
+		return nil, function.NewArgumentFuncError(0, err.Error())
+	}
+	if base.Addr().Is4() {
+		return nil, function.NewArgumentFuncError(0, fmt.Sprintf("%q is not an IPv6 CIDR block", cidrBlock))
+	}
+	if bits := base.Bits(); bits > subnetIPv6PrefixLength {
+		return nil, function.NewArgumentFuncError(0, fmt.Sprintf("IPv6 CIDR block prefix length must be at most /%d, got /%d", subnetIPv6PrefixLength, bits))
+	}
+
+	if count < 1 || count > ipv6CIDRSubnetsMaxCount {
+		return nil, function.NewArgumentFuncError(1, fmt.Sprintf("count must be between 1 and %d, got %d", ipv6CIDRSubnetsMaxCount, count))
+	}
+
+	newbits := subnetIPv6PrefixLength - base.Bits()
+	if available := new(big.Int).Lsh(big.NewInt(1), uint(newbits)); big.NewInt(count).Cmp(available) > 0 {
+		return nil, function.NewArgumentFuncError(1, fmt.Sprintf("%q accommodates only %s /%d subnet CIDR blocks, got count %d", cidrBlock, available, subnetIPv6PrefixLength, count))
+	}
+
+	subnets := make([]string, 0, count)
+	for i := int64(0); i < count; i++ {
+		prefix, err := cidrSubnet(base, newbits, big.NewInt(i))
+		if err != nil {
+			return nil, function.NewFuncError(err.Error())
+		}
+
+		subnets = append(subnets, prefix.String())
+	}
+
+	return subnets, nil
+}
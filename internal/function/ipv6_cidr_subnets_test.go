@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestIPv6CIDRSubnetsFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIPv6CIDRSubnetsFunctionConfig("2600:1f14:abc:de00::/56", 3),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "2600:1f14:abc:de00::/64,2600:1f14:abc:de01::/64,2600:1f14:abc:de02::/64"),
+				),
+			},
+		},
+	})
+}
+
+func TestIPv6CIDRSubnetsFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testIPv6CIDRSubnetsFunctionConfig("10.0.0.0/16", 1),
+				ExpectError: regexache.MustCompile(`not[\s\n]*an[\s\n]*IPv6[\s\n]*CIDR[\s\n]*block`),
+			},
+			{
+				Config:      testIPv6CIDRSubnetsFunctionConfig("2600:1f14:abc:de00::/56", 257),
+				ExpectError: regexache.MustCompile(`accommodates[\s\n]*only[\s\n]*256`),
+			},
+			{
+				Config:      testIPv6CIDRSubnetsFunctionConfig("::/0", 9223372036854775807),
+				ExpectError: regexache.MustCompile(`count[\s\n]*must[\s\n]*be[\s\n]*between[\s\n]*1[\s\n]*and[\s\n]*4096`),
+			},
+		},
+	})
+}
+
+func testIPv6CIDRSubnetsFunctionConfig(cidrBlock string, count int64) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = join(",", provider::aws::ipv6_cidr_subnets(%[1]q, %[2]d))
+}
+`, cidrBlock, count)
+}
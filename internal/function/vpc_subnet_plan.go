@@ -0,0 +1,191 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// VPC and subnet sizing reference:
+	// https://docs.aws.amazon.com/vpc/latest/userguide/subnet-sizing.html
+
+	// vpcIPv4MinPrefixLength and vpcIPv4MaxPrefixLength bound the size of VPC and subnet IPv4 CIDR blocks.
+	vpcIPv4MinPrefixLength = 16
+	vpcIPv4MaxPrefixLength = 28
+
+	// subnetReservedAddressesHead is the number of addresses AWS reserves at the start of each subnet:
+	// the network address, the VPC router, the DNS server and one reserved for future use.
+	subnetReservedAddressesHead = 4
+	// subnetReservedAddressesTail is the number of addresses AWS reserves at the end of each subnet:
+	// the network broadcast address.
+	subnetReservedAddressesTail = 1
+)
+
+var vpcSubnetPlanResultAttrTypes = map[string]attr.Type{
+	"availability_zone":    types.StringType,
+	"cidr_block":           types.StringType,
+	"first_usable_address": types.StringType,
+	"last_usable_address":  types.StringType,
+	"usable_address_count": types.Int64Type,
+}
+
+var _ function.Function = vpcSubnetPlanFunction{}
+
+func NewVPCSubnetPlanFunction() function.Function {
+	return &vpcSubnetPlanFunction{}
+}
+
+type vpcSubnetPlanFunction struct{}
+
+func (f vpcSubnetPlanFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "vpc_subnet_plan"
+}
+
+func (f vpcSubnetPlanFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "vpc_subnet_plan Function",
+		MarkdownDescription: "Splits a VPC IPv4 CIDR block into equally sized subnets balanced across Availability Zones. " +
+			"Subnets are assigned to Availability Zones in round-robin order and the usable address range of each " +
+			"subnet excludes the five addresses reserved by AWS. When the number of subnets is not a multiple of " +
+			"the number of Availability Zones, the remaining subnets at the end of the VPC CIDR block are left unallocated.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "cidr_block",
+				MarkdownDescription: "VPC IPv4 CIDR block",
+			},
+			function.ListParameter{
+				Name:                "availability_zones",
+				ElementType:         types.StringType,
+				MarkdownDescription: "Availability Zones across which to balance the subnets",
+			},
+			function.Int64Parameter{
+				Name:                "newbits",
+				MarkdownDescription: "Number of additional prefix bits with which to extend the VPC CIDR block for each subnet",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.ObjectType{
+				AttrTypes: vpcSubnetPlanResultAttrTypes,
+			},
+		},
+	}
+}
+
+func (f vpcSubnetPlanFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidrBlock string
+	var availabilityZones []string
+	var newbits int64
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cidrBlock, &availabilityZones, &newbits))
+	if resp.Error != nil {
+		return
+	}
+
+	subnets, funcErr := vpcSubnetPlan(cidrBlock, availabilityZones, newbits)
+	if funcErr != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, funcErr)
+		return
+	}
+
+	elements := make([]attr.Value, 0, len(subnets))
+	for _, subnet := range subnets {
+		value := map[string]attr.Value{
+			"availability_zone":    types.StringValue(subnet.availabilityZone),
+			"cidr_block":           types.StringValue(subnet.cidrBlock),
+			"first_usable_address": types.StringValue(subnet.firstUsableAddress),
+			"last_usable_address":  types.StringValue(subnet.lastUsableAddress),
+			"usable_address_count": types.Int64Value(subnet.usableAddressCount),
+		}
+
+		element, d := types.ObjectValue(vpcSubnetPlanResultAttrTypes, value)
+		if d.HasError() {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+			return
+		}
+
+		elements = append(elements, element)
+	}
+
+	result, d := types.ListValue(types.ObjectType{AttrTypes: vpcSubnetPlanResultAttrTypes}, elements)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+type plannedSubnet struct {
+	availabilityZone   string
+	cidrBlock          string
+	firstUsableAddress string
+	lastUsableAddress  string
+	usableAddressCount int64
+}
+
+// vpcSubnetPlan carves the VPC CIDR block into the largest number of subnets, each extending the
+// VPC prefix by newbits, that can be spread evenly across the specified Availability Zones.
+// Any remaining subnets at the end of the VPC CIDR block are left unallocated.
+func vpcSubnetPlan(cidrBlock string, availabilityZones []string, newbits int64) ([]plannedSubnet, *function.FuncError) {
+	vpc, err := parseCIDRBlock(cidrBlock)
+	if err != nil {
+		return nil, function.NewArgumentFuncError(0, err.Error())
+	}
+	if !vpc.Addr().Is4() {
+		return nil, function.NewArgumentFuncError(0, fmt.Sprintf("%q is not an IPv4 CIDR block", cidrBlock))
+	}
+	if bits := vpc.Bits(); bits < vpcIPv4MinPrefixLength || bits > vpcIPv4MaxPrefixLength {
+		return nil, function.NewArgumentFuncError(0, fmt.Sprintf("VPC CIDR block prefix length must be between /%d and /%d, got /%d", vpcIPv4MinPrefixLength, vpcIPv4MaxPrefixLength, bits))
+	}
+
+	if len(availabilityZones) == 0 {
+		return nil, function.NewArgumentFuncError(1, "at least one Availability Zone must be specified")
+	}
+	seen := make(map[string]struct{}, len(availabilityZones))
+	for _, az := range availabilityZones {
+		if az == "" {
+			return nil, function.NewArgumentFuncError(1, "Availability Zones must not be empty")
+		}
+		if _, ok := seen[az]; ok {
+			return nil, function.NewArgumentFuncError(1, fmt.Sprintf("duplicate Availability Zone: %q", az))
+		}
+		seen[az] = struct{}{}
+	}
+
+	if maxNewbits := int64(vpcIPv4MaxPrefixLength - vpc.Bits()); newbits < 0 || newbits > maxNewbits {
+		return nil, function.NewArgumentFuncError(2, fmt.Sprintf("newbits must be between 0 and %d so that the subnet CIDR block prefix length is at most /%d, got %d", maxNewbits, vpcIPv4MaxPrefixLength, newbits))
+	}
+
+	total := int64(1) << newbits
+	count := total - total%int64(len(availabilityZones))
+	if count == 0 {
+		return nil, function.NewArgumentFuncError(2, fmt.Sprintf("prefix extension of %d yields %d subnets, fewer than the %d Availability Zones", newbits, total, len(availabilityZones)))
+	}
+
+	subnets := make([]plannedSubnet, 0, count)
+	for i := int64(0); i < count; i++ {
+		prefix, err := cidrSubnet(vpc, int(newbits), big.NewInt(i))
+		if err != nil {
+			return nil, function.NewFuncError(err.Error())
+		}
+
+		hostBits := prefix.Addr().BitLen() - prefix.Bits()
+		subnets = append(subnets, plannedSubnet{
+			availabilityZone:   availabilityZones[i%int64(len(availabilityZones))],
+			cidrBlock:          prefix.String(),
+			firstUsableAddress: cidrAddressOffset(prefix, subnetReservedAddressesHead).String(),
+			lastUsableAddress:  cidrAddressOffset(prefix, (int64(1)<<hostBits)-1-subnetReservedAddressesTail).String(),
+			usableAddressCount: (int64(1) << hostBits) - subnetReservedAddressesHead - subnetReservedAddressesTail,
+		})
+	}
+
+	return subnets, nil
+}
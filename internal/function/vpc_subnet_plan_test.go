@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestVPCSubnetPlanFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testVPCSubnetPlanFunctionConfig("10.0.0.0/16", `["us-west-2a", "us-west-2b", "us-west-2c"]`, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("count", "3"),
+					resource.TestCheckOutput("cidr_blocks", "10.0.0.0/18,10.0.64.0/18,10.0.128.0/18"),
+					resource.TestCheckOutput("availability_zones", "us-west-2a,us-west-2b,us-west-2c"),
+					resource.TestCheckOutput("first_usable_address", "10.0.0.4"),
+					resource.TestCheckOutput("last_usable_address", "10.0.63.254"),
+					resource.TestCheckOutput("usable_address_count", "16379"),
+				),
+			},
+		},
+	})
+}
+
+func TestVPCSubnetPlanFunction_remainderUnallocated(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				// 2^3 = 8 subnets across 3 Availability Zones leaves the last 2 subnets (10.0.192.0/19 and 10.0.224.0/19) unallocated.
+				Config: testVPCSubnetPlanFunctionConfig("10.0.0.0/16", `["us-west-2a", "us-west-2b", "us-west-2c"]`, 3),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("count", "6"),
+					resource.TestCheckOutput("cidr_blocks", "10.0.0.0/19,10.0.32.0/19,10.0.64.0/19,10.0.96.0/19,10.0.128.0/19,10.0.160.0/19"),
+					resource.TestCheckOutput("availability_zones", "us-west-2a,us-west-2b,us-west-2c,us-west-2a,us-west-2b,us-west-2c"),
+				),
+			},
+		},
+	})
+}
+
+func TestVPCSubnetPlanFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testVPCSubnetPlanFunctionConfig("10.0.0.0/8", `["us-west-2a"]`, 8),
+				ExpectError: regexache.MustCompile(`prefix[\s\n]*length[\s\n]*must[\s\n]*be[\s\n]*between`),
+			},
+			{
+				Config:      testVPCSubnetPlanFunctionConfig("10.0.0.0/24", `["us-west-2a"]`, 6),
+				ExpectError: regexache.MustCompile(`newbits[\s\n]*must[\s\n]*be[\s\n]*between[\s\n]*0[\s\n]*and[\s\n]*4`),
+			},
+			{
+				Config:      testVPCSubnetPlanFunctionConfig("10.0.0.0/16", `["us-west-2a"]`, 9223372036854775807),
+				ExpectError: regexache.MustCompile(`newbits[\s\n]*must[\s\n]*be[\s\n]*between[\s\n]*0[\s\n]*and[\s\n]*12`),
+			},
+			{
+				Config:      testVPCSubnetPlanFunctionConfig("10.0.0.0/16", `["us-west-2a", "us-west-2a"]`, 2),
+				ExpectError: regexache.MustCompile(`duplicate[\s\n]*Availability[\s\n]*Zone`),
+			},
+		},
+	})
+}
+
+func testVPCSubnetPlanFunctionConfig(cidrBlock, availabilityZones string, newbits int64) string {
+	return fmt.Sprintf(`
+locals {
+  plan = provider::aws::vpc_subnet_plan(%[1]q, %[2]s, %[3]d)
+}
+
+output "count" {
+  value = length(local.plan)
+}
+
+output "cidr_blocks" {
+  value = join(",", local.plan[*].cidr_block)
+}
+
+output "availability_zones" {
+  value = join(",", local.plan[*].availability_zone)
+}
+
+output "first_usable_address" {
+  value = local.plan[0].first_usable_address
+}
+
+output "last_usable_address" {
+  value = local.plan[0].last_usable_address
+}
+
+output "usable_address_count" {
+  value = local.plan[0].usable_address_count
+}
+`, cidrBlock, availabilityZones, newbits)
+}
@@ -19,6 +19,7 @@ import (
 
 func main() {
 	filename := `service_packages_gen.go`
+	serviceDir := flag.String("servicedir", "../service", "path to the service packages directory")
 
 	flag.Parse()
 	args := flag.Args()
@@ -46,7 +47,7 @@ func main() {
 		// See internal/generate/namesconsts/main.go.
 		p := l.ProviderPackage()
 
-		spdFile := fmt.Sprintf("../service/%s/service_package_gen.go", p)
+		spdFile := fmt.Sprintf("%s/%s/service_package_gen.go", *serviceDir, p)
 
 		if _, err := os.Stat(spdFile); err != nil {
 			continue
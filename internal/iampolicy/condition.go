@@ -0,0 +1,316 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iampolicy
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+)
+
+// Condition operator reference:
+// https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_policies_elements_condition_operators.html
+
+const (
+	conditionPrefixForAllValues = "ForAllValues:"
+	conditionPrefixForAnyValue  = "ForAnyValue:"
+	conditionSuffixIfExists     = "IfExists"
+	conditionOperatorNull       = "Null"
+)
+
+// conditionComparator reports whether a single request context value matches a single policy value.
+type conditionComparator func(e *evaluator, policyValue, requestValue string) (bool, error)
+
+type conditionOperator struct {
+	compare conditionComparator
+	// negated operators match if no policy value matches, and match if the key is absent.
+	negated bool
+}
+
+var conditionOperators = map[string]conditionOperator{
+	"StringEquals":              {compare: compareStringEquals(false)},
+	"StringNotEquals":           {compare: compareStringEquals(false), negated: true},
+	"StringEqualsIgnoreCase":    {compare: compareStringEquals(true)},
+	"StringNotEqualsIgnoreCase": {compare: compareStringEquals(true), negated: true},
+	"StringLike":                {compare: compareStringLike},
+	"StringNotLike":             {compare: compareStringLike, negated: true},
+	"NumericEquals":             {compare: compareNumeric(func(c int) bool { return c == 0 })},
+	"NumericNotEquals":          {compare: compareNumeric(func(c int) bool { return c == 0 }), negated: true},
+	"NumericLessThan":           {compare: compareNumeric(func(c int) bool { return c < 0 })},
+	"NumericLessThanEquals":     {compare: compareNumeric(func(c int) bool { return c <= 0 })},
+	"NumericGreaterThan":        {compare: compareNumeric(func(c int) bool { return c > 0 })},
+	"NumericGreaterThanEquals":  {compare: compareNumeric(func(c int) bool { return c >= 0 })},
+	"DateEquals":                {compare: compareDate(func(c int) bool { return c == 0 })},
+	"DateNotEquals":             {compare: compareDate(func(c int) bool { return c == 0 }), negated: true},
+	"DateLessThan":              {compare: compareDate(func(c int) bool { return c < 0 })},
+	"DateLessThanEquals":        {compare: compareDate(func(c int) bool { return c <= 0 })},
+	"DateGreaterThan":           {compare: compareDate(func(c int) bool { return c > 0 })},
+	"DateGreaterThanEquals":     {compare: compareDate(func(c int) bool { return c >= 0 })},
+	"Bool":                      {compare: compareBool},
+	"BinaryEquals":              {compare: compareBinary},
+	"IpAddress":                 {compare: compareIPAddress},
+	"NotIpAddress":              {compare: compareIPAddress, negated: true},
+	"ArnEquals":                 {compare: compareARN},
+	"ArnLike":                   {compare: compareARN},
+	"ArnNotEquals":              {compare: compareARN, negated: true},
+	"ArnNotLike":                {compare: compareARN, negated: true},
+}
+
+// conditionsMatch reports whether every condition in a statement's Condition element matches the request.
+// All conditions are evaluated, in a stable order, so that every missing context key is recorded.
+func (e *evaluator) conditionsMatch(conditions map[string]map[string]StringSet) (bool, error) {
+	result := true
+
+	operators := tfmaps.Keys(conditions)
+	slices.Sort(operators)
+
+	for _, operator := range operators {
+		keys := tfmaps.Keys(conditions[operator])
+		slices.Sort(keys)
+
+		for _, key := range keys {
+			matched, err := e.conditionMatches(operator, key, conditions[operator][key])
+			if err != nil {
+				return false, fmt.Errorf("condition %s %s: %w", operator, key, err)
+			}
+
+			result = result && matched
+		}
+	}
+
+	return result, nil
+}
+
+func (e *evaluator) conditionMatches(operator, key string, policyValues StringSet) (bool, error) {
+	if operator == conditionOperatorNull {
+		_, present := e.context[strings.ToLower(key)]
+
+		for _, v := range policyValues {
+			absent, err := strconv.ParseBool(v)
+			if err != nil {
+				return false, fmt.Errorf("invalid value %q", v)
+			}
+			if absent != present {
+				return true, nil
+			}
+		}
+
+		return false, nil
+	}
+
+	name := operator
+	forAllValues, forAnyValue := false, false
+	if v, ok := strings.CutPrefix(name, conditionPrefixForAllValues); ok {
+		name, forAllValues = v, true
+	} else if v, ok := strings.CutPrefix(name, conditionPrefixForAnyValue); ok {
+		name, forAnyValue = v, true
+	}
+	name, ifExists := strings.CutSuffix(name, conditionSuffixIfExists)
+
+	op, ok := conditionOperators[name]
+	if !ok {
+		return false, fmt.Errorf("unsupported condition operator %q", operator)
+	}
+
+	requestValues, present := e.contextValues(key)
+	if !present {
+		switch {
+		case ifExists, forAllValues:
+			return true, nil
+		case forAnyValue:
+			return false, nil
+		default:
+			return op.negated, nil
+		}
+	}
+
+	// valueMatches reports whether a single request value satisfies the operator.
+	valueMatches := func(requestValue string) (bool, error) {
+		for _, policyValue := range policyValues {
+			matched, err := op.compare(e, policyValue, requestValue)
+			if err != nil {
+				return false, err
+			}
+			if matched {
+				return !op.negated, nil
+			}
+		}
+
+		return op.negated, nil
+	}
+
+	// Without a set operator, positive operators match any request value and negated operators must hold for all.
+	requireAll := forAllValues || (!forAnyValue && op.negated)
+
+	for _, requestValue := range requestValues {
+		matched, err := valueMatches(requestValue)
+		if err != nil {
+			return false, err
+		}
+
+		if requireAll && !matched {
+			return false, nil
+		}
+		if !requireAll && matched {
+			return true, nil
+		}
+	}
+
+	return requireAll, nil
+}
+
+func compareStringEquals(ignoreCase bool) conditionComparator {
+	return func(e *evaluator, policyValue, requestValue string) (bool, error) {
+		v, ok, err := e.substituteVariables(policyValue)
+		if err != nil || !ok {
+			return false, err
+		}
+
+		if ignoreCase {
+			return strings.EqualFold(v, requestValue), nil
+		}
+
+		return v == requestValue, nil
+	}
+}
+
+func compareStringLike(e *evaluator, policyValue, requestValue string) (bool, error) {
+	re, ok, err := e.compilePattern(policyValue, false)
+	if err != nil || !ok {
+		return false, err
+	}
+
+	return re.MatchString(requestValue), nil
+}
+
+func compareNumeric(f func(int) bool) conditionComparator {
+	return func(_ *evaluator, policyValue, requestValue string) (bool, error) {
+		p, err := strconv.ParseFloat(policyValue, 64)
+		if err != nil {
+			return false, fmt.Errorf("invalid numeric value %q", policyValue)
+		}
+		r, err := strconv.ParseFloat(requestValue, 64)
+		if err != nil {
+			return false, nil
+		}
+
+		switch {
+		case r < p:
+			return f(-1), nil
+		case r > p:
+			return f(1), nil
+		default:
+			return f(0), nil
+		}
+	}
+}
+
+func compareDate(f func(int) bool) conditionComparator {
+	return func(_ *evaluator, policyValue, requestValue string) (bool, error) {
+		p, err := parseConditionDate(policyValue)
+		if err != nil {
+			return false, err
+		}
+		r, err := parseConditionDate(requestValue)
+		if err != nil {
+			return false, nil
+		}
+
+		return f(r.Compare(p)), nil
+	}
+}
+
+// parseConditionDate parses an ISO 8601 date or UNIX epoch time.
+func parseConditionDate(s string) (time.Time, error) {
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02T15:04Z07:00", time.DateOnly} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+
+	if v, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return time.Unix(v, 0), nil
+	}
+
+	return time.Time{}, fmt.Errorf("invalid date value %q", s)
+}
+
+func compareBool(_ *evaluator, policyValue, requestValue string) (bool, error) {
+	p, err := strconv.ParseBool(policyValue)
+	if err != nil {
+		return false, fmt.Errorf("invalid boolean value %q", policyValue)
+	}
+	r, err := strconv.ParseBool(requestValue)
+	if err != nil {
+		return false, nil
+	}
+
+	return p == r, nil
+}
+
+func compareBinary(_ *evaluator, policyValue, requestValue string) (bool, error) {
+	p, err := base64.StdEncoding.DecodeString(policyValue)
+	if err != nil {
+		return false, fmt.Errorf("invalid base64 value %q", policyValue)
+	}
+	r, err := base64.StdEncoding.DecodeString(requestValue)
+	if err != nil {
+		return false, nil
+	}
+
+	return bytes.Equal(p, r), nil
+}
+
+func compareIPAddress(_ *evaluator, policyValue, requestValue string) (bool, error) {
+	var prefix netip.Prefix
+	if strings.Contains(policyValue, "/") {
+		v, err := netip.ParsePrefix(policyValue)
+		if err != nil {
+			return false, fmt.Errorf("invalid IP address value %q", policyValue)
+		}
+		prefix = v.Masked()
+	} else {
+		v, err := netip.ParseAddr(policyValue)
+		if err != nil {
+			return false, fmt.Errorf("invalid IP address value %q", policyValue)
+		}
+		prefix = netip.PrefixFrom(v, v.BitLen())
+	}
+
+	addr, err := netip.ParseAddr(requestValue)
+	if err != nil {
+		return false, nil
+	}
+
+	return prefix.Contains(addr.Unmap()), nil
+}
+
+// compareARN matches each of the six colon-delimited ARN components separately; wildcards do not span components.
+func compareARN(e *evaluator, policyValue, requestValue string) (bool, error) {
+	v, ok, err := e.substituteVariables(policyValue)
+	if err != nil || !ok {
+		return false, err
+	}
+
+	const arnSections = 6
+	p, r := strings.SplitN(v, ":", arnSections), strings.SplitN(requestValue, ":", arnSections)
+	if len(p) != arnSections || len(r) != arnSections {
+		return false, nil
+	}
+
+	for i := range p {
+		if !wildcardMatch(p[i], r[i], false) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
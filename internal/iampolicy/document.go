@@ -0,0 +1,292 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package iampolicy models, normalizes and evaluates IAM JSON policy documents offline.
+package iampolicy
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+)
+
+const (
+	// IAM JSON policy element reference:
+	// https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_policies_elements.html
+
+	EffectAllow = "Allow"
+	EffectDeny  = "Deny"
+
+	wildcard = "*"
+)
+
+// Document is the canonical model of an IAM JSON policy document.
+// Marshalling a decoded document produces its normalized form:
+// element order is fixed, set-valued elements are sorted and de-duplicated and
+// single-valued sets are collapsed to a string.
+type Document struct {
+	Version   string     `json:",omitempty"`
+	Id        string     `json:",omitempty"`
+	Statement Statements `json:",omitempty"`
+}
+
+type Statement struct {
+	Sid          string                          `json:",omitempty"`
+	Effect       string                          `json:",omitempty"`
+	Principal    *Principal                      `json:",omitempty"`
+	NotPrincipal *Principal                      `json:",omitempty"`
+	Action       StringSet                       `json:",omitempty"`
+	NotAction    StringSet                       `json:",omitempty"`
+	Resource     StringSet                       `json:",omitempty"`
+	NotResource  StringSet                       `json:",omitempty"`
+	Condition    map[string]map[string]StringSet `json:",omitempty"`
+}
+
+// Statements accepts either a single statement object or an array of statements.
+type Statements []*Statement
+
+func (s *Statements) UnmarshalJSON(b []byte) error {
+	if b = bytes.TrimSpace(b); len(b) > 0 && b[0] == '{' {
+		var statement Statement
+		if err := unmarshalStrict(b, &statement); err != nil {
+			return err
+		}
+
+		*s = Statements{&statement}
+		return nil
+	}
+
+	var raw []json.RawMessage
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+
+	statements := make(Statements, 0, len(raw))
+	for _, v := range raw {
+		var statement Statement
+		if err := unmarshalStrict(v, &statement); err != nil {
+			return err
+		}
+
+		statements = append(statements, &statement)
+	}
+
+	*s = statements
+	return nil
+}
+
+// Principal is either the anonymous "*" principal or a map of principal type to identifiers.
+type Principal struct {
+	anonymous   bool
+	identifiers map[string]StringSet
+}
+
+func (p Principal) MarshalJSON() ([]byte, error) {
+	if p.anonymous {
+		return marshalJSONNoEscapeHTML(wildcard)
+	}
+
+	return marshalJSONNoEscapeHTML(p.identifiers)
+}
+
+func (p *Principal) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		if s != wildcard {
+			return fmt.Errorf("unsupported principal %q", s)
+		}
+
+		*p = Principal{anonymous: true}
+		return nil
+	}
+
+	var identifiers map[string]StringSet
+	if err := json.Unmarshal(b, &identifiers); err != nil {
+		return fmt.Errorf("decoding principal: %w", err)
+	}
+
+	*p = Principal{identifiers: identifiers}
+	return nil
+}
+
+// StringSet accepts either a scalar or an array of scalars.
+// Boolean and numeric scalars, valid in condition values, are converted to strings.
+type StringSet []string
+
+func (s StringSet) MarshalJSON() ([]byte, error) {
+	if len(s) == 1 {
+		return marshalJSONNoEscapeHTML(s[0])
+	}
+
+	return marshalJSONNoEscapeHTML([]string(s))
+}
+
+func (s *StringSet) UnmarshalJSON(b []byte) error {
+	var raw any
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+
+	var values []string
+	switch v := raw.(type) {
+	case []any:
+		for _, v := range v {
+			value, err := scalarString(v)
+			if err != nil {
+				return err
+			}
+			values = append(values, value)
+		}
+	default:
+		value, err := scalarString(v)
+		if err != nil {
+			return err
+		}
+		values = append(values, value)
+	}
+
+	slices.Sort(values)
+	*s = slices.Compact(values)
+
+	return nil
+}
+
+func scalarString(v any) (string, error) {
+	switch v := v.(type) {
+	case string:
+		return v, nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	default:
+		return "", fmt.Errorf("unsupported value type %T", v)
+	}
+}
+
+// Parse decodes and validates an IAM JSON policy document.
+func Parse(s string) (*Document, error) {
+	var doc Document
+	if err := unmarshalStrict([]byte(s), &doc); err != nil {
+		return nil, fmt.Errorf("decoding IAM policy: %w", err)
+	}
+
+	for i, statement := range doc.Statement {
+		if err := statement.validate(); err != nil {
+			if statement.Sid != "" {
+				return nil, fmt.Errorf("IAM policy statement %q: %w", statement.Sid, err)
+			}
+			return nil, fmt.Errorf("IAM policy statement %d: %w", i, err)
+		}
+	}
+
+	return &doc, nil
+}
+
+func (s *Statement) validate() error {
+	if s.Effect != EffectAllow && s.Effect != EffectDeny {
+		return fmt.Errorf("statement Effect must be %q or %q, got %q", EffectAllow, EffectDeny, s.Effect)
+	}
+	if s.Principal != nil && s.NotPrincipal != nil {
+		return errors.New("only one of Principal or NotPrincipal can be specified")
+	}
+	if (len(s.Action) == 0) == (len(s.NotAction) == 0) {
+		return errors.New("exactly one of Action or NotAction must be specified")
+	}
+	if len(s.Resource) > 0 && len(s.NotResource) > 0 {
+		return errors.New("only one of Resource or NotResource can be specified")
+	}
+
+	return nil
+}
+
+// Normalize returns the normalized JSON representation of the policy document.
+func (d *Document) Normalize() (string, error) {
+	b, err := marshalJSONNoEscapeHTML(d)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// Merge merges the statements of the specified policy documents in order.
+// The highest Version and the last non-empty Id are adopted. Statements that are
+// identical once normalized are only included once, and statements that share a Sid
+// but are otherwise different are reported as a conflict.
+func Merge(docs []*Document) (*Document, error) {
+	type seenStatement struct {
+		doc        int
+		normalized string
+	}
+
+	result := &Document{}
+	bySid := make(map[string]seenStatement)
+	byValue := make(map[string]struct{})
+
+	for i, doc := range docs {
+		if doc.Version > result.Version {
+			result.Version = doc.Version
+		}
+		if doc.Id != "" {
+			result.Id = doc.Id
+		}
+
+		for _, statement := range doc.Statement {
+			b, err := marshalJSONNoEscapeHTML(statement)
+			if err != nil {
+				return nil, err
+			}
+			normalized := string(b)
+
+			if statement.Sid != "" {
+				if seen, ok := bySid[statement.Sid]; ok {
+					if seen.normalized != normalized {
+						return nil, fmt.Errorf("conflicting statements with Sid %q in policies %d and %d", statement.Sid, seen.doc, i)
+					}
+					continue
+				}
+				bySid[statement.Sid] = seenStatement{doc: i, normalized: normalized}
+			}
+
+			if _, ok := byValue[normalized]; ok {
+				continue
+			}
+			byValue[normalized] = struct{}{}
+
+			result.Statement = append(result.Statement, statement)
+		}
+	}
+
+	return result, nil
+}
+
+func unmarshalStrict(b []byte, v any) error {
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(v); err != nil {
+		return err
+	}
+	if decoder.More() {
+		return errors.New("unexpected data after JSON value")
+	}
+
+	return nil
+}
+
+// marshalJSONNoEscapeHTML is json.Marshal without escaping of HTML characters, which are common in policy conditions.
+func marshalJSONNoEscapeHTML(v any) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+
+	if err := encoder.Encode(v); err != nil {
+		return nil, err
+	}
+
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
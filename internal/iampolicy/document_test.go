@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iampolicy
+
+import (
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		policy   string
+		expected string
+		wantErr  bool
+	}{
+		"single statement object": {
+			policy:   `{"Statement":{"Effect":"Allow","Action":["s3:GetObject"],"Resource":"*"},"Version":"2012-10-17"}`,
+			expected: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+		},
+		"sorted and de-duplicated": {
+			policy:   `{"Version":"2012-10-17","Statement":[{"Sid":"A","Effect":"Deny","NotAction":["s3:PutObject","iam:*","s3:PutObject"],"NotResource":["b","a"]}]}`,
+			expected: `{"Version":"2012-10-17","Statement":[{"Sid":"A","Effect":"Deny","NotAction":["iam:*","s3:PutObject"],"NotResource":["a","b"]}]}`,
+		},
+		"principals": {
+			policy:   `{"Statement":[{"Effect":"Allow","Principal":{"Service":["lambda.amazonaws.com"],"AWS":["arn:aws:iam::123456789012:root","111122223333"]},"Action":"sts:AssumeRole"}]}`,
+			expected: `{"Statement":[{"Effect":"Allow","Principal":{"AWS":["111122223333","arn:aws:iam::123456789012:root"],"Service":"lambda.amazonaws.com"},"Action":"sts:AssumeRole"}]}`,
+		},
+		"anonymous principal": {
+			policy:   `{"Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"arn:aws:s3:::example/*"}]}`,
+			expected: `{"Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"arn:aws:s3:::example/*"}]}`,
+		},
+		"conditions": {
+			policy:   `{"Statement":[{"Effect":"Allow","Action":"s3:ListBucket","Resource":"*","Condition":{"NumericLessThanEquals":{"s3:max-keys":10},"Bool":{"aws:SecureTransport":true},"StringLike":{"s3:prefix":["home/","home/&"]}}}]}`,
+			expected: `{"Statement":[{"Effect":"Allow","Action":"s3:ListBucket","Resource":"*","Condition":{"Bool":{"aws:SecureTransport":"true"},"NumericLessThanEquals":{"s3:max-keys":"10"},"StringLike":{"s3:prefix":["home/","home/&"]}}}]}`,
+		},
+		"invalid json": {
+			policy:  `{"Statement":`,
+			wantErr: true,
+		},
+		"unknown element": {
+			policy:  `{"Statement":[{"Effect":"Allow","Actions":"s3:GetObject","Resource":"*"}]}`,
+			wantErr: true,
+		},
+		"invalid effect": {
+			policy:  `{"Statement":[{"Effect":"Permit","Action":"s3:GetObject","Resource":"*"}]}`,
+			wantErr: true,
+		},
+		"action and not action": {
+			policy:  `{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","NotAction":"s3:PutObject","Resource":"*"}]}`,
+			wantErr: true,
+		},
+		"invalid principal": {
+			policy:  `{"Statement":[{"Effect":"Allow","Principal":"someone","Action":"s3:GetObject"}]}`,
+			wantErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			doc, err := Parse(testCase.policy)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("Parse() err %t, want %t (%v)", got, want, err)
+			}
+			if err != nil {
+				return
+			}
+
+			got, err := doc.Normalize()
+			if err != nil {
+				t.Fatalf("normalize() err %v", err)
+			}
+			if got != testCase.expected {
+				t.Errorf("normalize() = %s, want %s", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestMerge(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		policies []string
+		expected string
+		wantErr  bool
+	}{
+		"distinct statements": {
+			policies: []string{
+				`{"Version":"2008-10-17","Id":"first","Statement":[{"Sid":"A","Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+				`{"Version":"2012-10-17","Statement":[{"Sid":"B","Effect":"Deny","Action":"s3:DeleteObject","Resource":"*"}]}`,
+			},
+			expected: `{"Version":"2012-10-17","Id":"first","Statement":[{"Sid":"A","Effect":"Allow","Action":"s3:GetObject","Resource":"*"},{"Sid":"B","Effect":"Deny","Action":"s3:DeleteObject","Resource":"*"}]}`,
+		},
+		"equivalent duplicate sid": {
+			policies: []string{
+				`{"Statement":[{"Sid":"A","Effect":"Allow","Action":["s3:PutObject","s3:GetObject"],"Resource":"*"}]}`,
+				`{"Statement":[{"Sid":"A","Effect":"Allow","Action":["s3:GetObject","s3:PutObject"],"Resource":["*"]}]}`,
+			},
+			expected: `{"Statement":[{"Sid":"A","Effect":"Allow","Action":["s3:GetObject","s3:PutObject"],"Resource":"*"}]}`,
+		},
+		"duplicate unnamed statement": {
+			policies: []string{
+				`{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+				`{"Statement":{"Effect":"Allow","Action":["s3:GetObject"],"Resource":"*"}}`,
+			},
+			expected: `{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+		},
+		"conflicting sid": {
+			policies: []string{
+				`{"Statement":[{"Sid":"A","Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+				`{"Statement":[{"Sid":"A","Effect":"Deny","Action":"s3:GetObject","Resource":"*"}]}`,
+			},
+			wantErr: true,
+		},
+		"no policies": {
+			expected: `{}`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var docs []*Document
+			for _, policy := range testCase.policies {
+				doc, err := Parse(policy)
+				if err != nil {
+					t.Fatalf("Parse() err %v", err)
+				}
+				docs = append(docs, doc)
+			}
+
+			doc, err := Merge(docs)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("Merge() err %t, want %t (%v)", got, want, err)
+			}
+			if err != nil {
+				return
+			}
+
+			got, err := doc.Normalize()
+			if err != nil {
+				t.Fatalf("normalize() err %v", err)
+			}
+			if got != testCase.expected {
+				t.Errorf("Merge() = %s, want %s", got, testCase.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,366 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iampolicy
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+)
+
+// Policy evaluation logic reference:
+// https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_policies_evaluation-logic.html
+
+type PolicyType string
+
+const (
+	PolicyTypeIdentity            PolicyType = "identity"
+	PolicyTypePermissionsBoundary PolicyType = "permissions_boundary"
+	PolicyTypeResource            PolicyType = "resource"
+	PolicyTypeServiceControl      PolicyType = "service_control"
+)
+
+func (PolicyType) Values() []PolicyType {
+	return []PolicyType{
+		PolicyTypeIdentity,
+		PolicyTypePermissionsBoundary,
+		PolicyTypeResource,
+		PolicyTypeServiceControl,
+	}
+}
+
+// Decision values match those returned by the IAM policy simulator.
+type Decision string
+
+const (
+	DecisionAllowed      Decision = "allowed"
+	DecisionExplicitDeny Decision = "explicitDeny"
+	DecisionImplicitDeny Decision = "implicitDeny"
+)
+
+const (
+	// ContextKeyPrincipalARN is the request context key holding the ARN of the calling principal.
+	// It is matched against the Principal element of resource-based policies.
+	ContextKeyPrincipalARN = "aws:PrincipalArn"
+	// ContextKeyPrincipalAccount is the request context key holding the account of the calling principal.
+	// If not set, it is derived from ContextKeyPrincipalARN.
+	ContextKeyPrincipalAccount = "aws:PrincipalAccount"
+)
+
+// Policies are the policies that apply to a request.
+type Policies struct {
+	// Identity-based policies attached to the calling principal.
+	Identity []*Document
+	// Resource-based policy attached to the target resource.
+	Resource *Document
+	// Permissions boundary of the calling principal.
+	PermissionsBoundary []*Document
+	// Service control policies, one per level of the organization hierarchy. Every level must allow a request.
+	ServiceControl []*Document
+}
+
+// Request is an offline request to be evaluated.
+type Request struct {
+	Action   string
+	Resource string
+	// Context keys are case-insensitive.
+	Context map[string][]string
+}
+
+type MatchedStatement struct {
+	PolicyType  PolicyType
+	PolicyIndex int
+	Sid         string
+	Effect      string
+}
+
+type Result struct {
+	Decision          Decision
+	MatchedStatements []MatchedStatement
+	// Condition and policy variable keys referenced by the policies but absent from the request context.
+	MissingContextKeys []string
+}
+
+func (r *Result) Allowed() bool {
+	return r.Decision == DecisionAllowed
+}
+
+// Evaluate evaluates a request against the specified policies, following the IAM policy evaluation logic
+// for a request within a single account:
+//
+//  1. An explicit Deny in any policy denies the request.
+//  2. If any service control policies are specified, each must allow the request.
+//  3. An Allow in the resource-based policy allows the request.
+//  4. If a permissions boundary is specified, it must allow the request.
+//  5. An Allow in an identity-based policy allows the request.
+//
+// Otherwise the request is implicitly denied.
+func Evaluate(policies Policies, request Request) (*Result, error) {
+	e := newEvaluator(request)
+
+	identity, err := e.evaluatePolicySet(PolicyTypeIdentity, policies.Identity)
+	if err != nil {
+		return nil, err
+	}
+	var resource policySetResult
+	if policies.Resource != nil {
+		resource, err = e.evaluatePolicySet(PolicyTypeResource, []*Document{policies.Resource})
+		if err != nil {
+			return nil, err
+		}
+	}
+	boundary, err := e.evaluatePolicySet(PolicyTypePermissionsBoundary, policies.PermissionsBoundary)
+	if err != nil {
+		return nil, err
+	}
+	scp, err := e.evaluatePolicySet(PolicyTypeServiceControl, policies.ServiceControl)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Result{
+		Decision:           DecisionImplicitDeny,
+		MissingContextKeys: e.missingContextKeys(),
+	}
+
+	if denies := slices.Concat(identity.denies, resource.denies, boundary.denies, scp.denies); len(denies) > 0 {
+		result.Decision = DecisionExplicitDeny
+		result.MatchedStatements = denies
+		return result, nil
+	}
+
+	if len(policies.ServiceControl) > 0 {
+		for i := range policies.ServiceControl {
+			if !slices.ContainsFunc(scp.allows, func(v MatchedStatement) bool { return v.PolicyIndex == i }) {
+				return result, nil
+			}
+		}
+	}
+
+	if len(resource.allows) > 0 {
+		result.Decision = DecisionAllowed
+		result.MatchedStatements = slices.Concat(scp.allows, resource.allows)
+		return result, nil
+	}
+
+	if len(policies.PermissionsBoundary) > 0 && len(boundary.allows) == 0 {
+		return result, nil
+	}
+
+	if len(identity.allows) > 0 {
+		result.Decision = DecisionAllowed
+		result.MatchedStatements = slices.Concat(scp.allows, boundary.allows, identity.allows)
+	}
+
+	return result, nil
+}
+
+type policySetResult struct {
+	allows []MatchedStatement
+	denies []MatchedStatement
+}
+
+type evaluator struct {
+	request Request
+	context map[string][]string
+	missing map[string]struct{}
+}
+
+func newEvaluator(request Request) *evaluator {
+	e := &evaluator{
+		request: request,
+		context: make(map[string][]string, len(request.Context)),
+		missing: make(map[string]struct{}),
+	}
+
+	for k, v := range request.Context {
+		e.context[strings.ToLower(k)] = v
+	}
+
+	if _, ok := e.context[strings.ToLower(ContextKeyPrincipalAccount)]; !ok {
+		if v := e.context[strings.ToLower(ContextKeyPrincipalARN)]; len(v) == 1 {
+			if principal, err := arn.Parse(v[0]); err == nil && principal.AccountID != "" {
+				e.context[strings.ToLower(ContextKeyPrincipalAccount)] = []string{principal.AccountID}
+			}
+		}
+	}
+
+	return e
+}
+
+// contextValues returns the request context values for the specified key, recording the key as missing if absent.
+func (e *evaluator) contextValues(key string) ([]string, bool) {
+	v, ok := e.context[strings.ToLower(key)]
+	if !ok {
+		e.missing[key] = struct{}{}
+	}
+
+	return v, ok
+}
+
+func (e *evaluator) missingContextKeys() []string {
+	var keys []string
+
+	for k := range e.missing {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	return keys
+}
+
+func (e *evaluator) evaluatePolicySet(policyType PolicyType, docs []*Document) (policySetResult, error) {
+	var result policySetResult
+
+	for i, doc := range docs {
+		for _, statement := range doc.Statement {
+			matched, err := e.statementMatches(policyType, statement)
+			if err != nil {
+				if statement.Sid != "" {
+					return result, fmt.Errorf("%s policy %d, statement %q: %w", policyType, i, statement.Sid, err)
+				}
+				return result, fmt.Errorf("%s policy %d: %w", policyType, i, err)
+			}
+
+			if !matched {
+				continue
+			}
+
+			v := MatchedStatement{
+				PolicyType:  policyType,
+				PolicyIndex: i,
+				Sid:         statement.Sid,
+				Effect:      statement.Effect,
+			}
+			if statement.Effect == EffectDeny {
+				result.denies = append(result.denies, v)
+			} else {
+				result.allows = append(result.allows, v)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+func (e *evaluator) statementMatches(policyType PolicyType, statement *Statement) (bool, error) {
+	if !e.actionMatches(statement) {
+		return false, nil
+	}
+
+	if matched, err := e.resourceMatches(statement); err != nil || !matched {
+		return false, err
+	}
+
+	if policyType == PolicyTypeResource && !e.principalMatches(statement) {
+		return false, nil
+	}
+
+	return e.conditionsMatch(statement.Condition)
+}
+
+func (e *evaluator) actionMatches(statement *Statement) bool {
+	match := func(patterns StringSet) bool {
+		return slices.ContainsFunc(patterns, func(pattern string) bool {
+			return wildcardMatch(pattern, e.request.Action, true)
+		})
+	}
+
+	if len(statement.NotAction) > 0 {
+		return !match(statement.NotAction)
+	}
+
+	return match(statement.Action)
+}
+
+func (e *evaluator) resourceMatches(statement *Statement) (bool, error) {
+	match := func(patterns StringSet) (bool, error) {
+		for _, pattern := range patterns {
+			re, ok, err := e.compilePattern(pattern, false)
+			if err != nil {
+				return false, err
+			}
+			if ok && re.MatchString(e.request.Resource) {
+				return true, nil
+			}
+		}
+
+		return false, nil
+	}
+
+	switch {
+	case len(statement.NotResource) > 0:
+		matched, err := match(statement.NotResource)
+		return !matched && err == nil, err
+	case len(statement.Resource) > 0:
+		return match(statement.Resource)
+	default:
+		return true, nil
+	}
+}
+
+func (e *evaluator) principalMatches(statement *Statement) bool {
+	principalARN, _ := e.contextValues(ContextKeyPrincipalARN)
+	principalAccount, _ := e.contextValues(ContextKeyPrincipalAccount)
+
+	match := func(p *Principal) bool {
+		if p.anonymous {
+			return true
+		}
+
+		for principalType, identifiers := range p.identifiers {
+			for _, identifier := range identifiers {
+				if identifier == wildcard || slices.Contains(principalARN, identifier) {
+					return true
+				}
+
+				if principalType == "AWS" {
+					if account := accountPrincipal(identifier); account != "" && slices.Contains(principalAccount, account) {
+						return true
+					}
+				}
+			}
+		}
+
+		return false
+	}
+
+	switch {
+	case statement.NotPrincipal != nil:
+		return !match(statement.NotPrincipal)
+	case statement.Principal != nil:
+		return match(statement.Principal)
+	default:
+		return false
+	}
+}
+
+// accountPrincipal returns the account ID of an account principal, either the bare account ID or the account root user ARN.
+func accountPrincipal(identifier string) string {
+	if isAccountID(identifier) {
+		return identifier
+	}
+
+	if v, err := arn.Parse(identifier); err == nil && v.Service == "iam" && v.Resource == "root" {
+		return v.AccountID
+	}
+
+	return ""
+}
+
+func isAccountID(s string) bool {
+	if len(s) != 12 {
+		return false
+	}
+
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
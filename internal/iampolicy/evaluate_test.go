@@ -0,0 +1,343 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iampolicy
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestEvaluate(t *testing.T) {
+	t.Parallel()
+
+	const (
+		principalARN = "arn:aws:iam::123456789012:role/example"
+		bucketObject = "arn:aws:s3:::example-bucket/home/example/file.txt"
+	)
+
+	testCases := map[string]struct {
+		identity       []string
+		resource       string
+		boundary       []string
+		serviceControl []string
+		action         string
+		resourceARN    string
+		context        map[string][]string
+		expected       Decision
+		expectedSids   []string
+		expectedKeys   []string
+		wantErr        bool
+	}{
+		"no policies": {
+			action:      "s3:GetObject",
+			resourceARN: bucketObject,
+			expected:    DecisionImplicitDeny,
+		},
+		"identity allow": {
+			identity:     []string{`{"Statement":[{"Sid":"Read","Effect":"Allow","Action":"s3:Get*","Resource":"arn:aws:s3:::example-bucket/*"}]}`},
+			action:       "s3:GetObject",
+			resourceARN:  bucketObject,
+			expected:     DecisionAllowed,
+			expectedSids: []string{"Read"},
+		},
+		"action is case insensitive": {
+			identity:    []string{`{"Statement":[{"Effect":"Allow","Action":"S3:getobject","Resource":"*"}]}`},
+			action:      "s3:GetObject",
+			resourceARN: bucketObject,
+			expected:    DecisionAllowed,
+		},
+		"resource is case sensitive": {
+			identity:    []string{`{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::EXAMPLE-BUCKET/*"}]}`},
+			action:      "s3:GetObject",
+			resourceARN: bucketObject,
+			expected:    DecisionImplicitDeny,
+		},
+		"explicit deny wins": {
+			identity: []string{
+				`{"Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*"}]}`,
+				`{"Statement":[{"Sid":"NoDelete","Effect":"Deny","Action":"s3:Delete*","Resource":"*"}]}`,
+			},
+			action:       "s3:DeleteObject",
+			resourceARN:  bucketObject,
+			expected:     DecisionExplicitDeny,
+			expectedSids: []string{"NoDelete"},
+		},
+		"not action": {
+			identity:    []string{`{"Statement":[{"Effect":"Allow","NotAction":"iam:*","Resource":"*"}]}`},
+			action:      "iam:CreateUser",
+			resourceARN: "*",
+			expected:    DecisionImplicitDeny,
+		},
+		"not resource": {
+			identity:    []string{`{"Statement":[{"Effect":"Deny","Action":"s3:*","NotResource":"arn:aws:s3:::example-bucket/*"},{"Effect":"Allow","Action":"s3:*","Resource":"*"}]}`},
+			action:      "s3:GetObject",
+			resourceARN: "arn:aws:s3:::other-bucket/file.txt",
+			expected:    DecisionExplicitDeny,
+		},
+		"policy variable": {
+			identity:    []string{`{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::example-bucket/home/${aws:username}/*"}]}`},
+			action:      "s3:GetObject",
+			resourceARN: bucketObject,
+			context:     map[string][]string{"aws:username": {"example"}},
+			expected:    DecisionAllowed,
+		},
+		"policy variable is literal": {
+			identity:    []string{`{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::example-bucket/home/${aws:username}/file.txt"}]}`},
+			action:      "s3:GetObject",
+			resourceARN: bucketObject,
+			context:     map[string][]string{"aws:username": {"*"}},
+			expected:    DecisionImplicitDeny,
+		},
+		"missing policy variable": {
+			identity:     []string{`{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::example-bucket/home/${aws:username}/*"}]}`},
+			action:       "s3:GetObject",
+			resourceARN:  bucketObject,
+			expected:     DecisionImplicitDeny,
+			expectedKeys: []string{"aws:username"},
+		},
+		"condition matches": {
+			identity:    []string{`{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"Bool":{"aws:SecureTransport":"true"},"IpAddress":{"aws:SourceIp":"10.0.0.0/8"}}}]}`},
+			action:      "s3:GetObject",
+			resourceARN: bucketObject,
+			context:     map[string][]string{"aws:securetransport": {"true"}, "aws:SourceIp": {"10.1.2.3"}},
+			expected:    DecisionAllowed,
+		},
+		"condition does not match": {
+			identity:    []string{`{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"Bool":{"aws:SecureTransport":"true"},"IpAddress":{"aws:SourceIp":"10.0.0.0/8"}}}]}`},
+			action:      "s3:GetObject",
+			resourceARN: bucketObject,
+			context:     map[string][]string{"aws:SecureTransport": {"true"}, "aws:SourceIp": {"192.168.0.1"}},
+			expected:    DecisionImplicitDeny,
+		},
+		"missing condition key": {
+			identity:     []string{`{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*","Condition":{"StringEquals":{"aws:PrincipalTag/team":"data"}}}]}`},
+			action:       "s3:GetObject",
+			resourceARN:  bucketObject,
+			expected:     DecisionImplicitDeny,
+			expectedKeys: []string{"aws:PrincipalTag/team"},
+		},
+		"negated condition with missing key": {
+			identity:     []string{`{"Statement":[{"Effect":"Deny","Action":"s3:*","Resource":"*","Condition":{"StringNotEquals":{"aws:RequestedRegion":"us-west-2"}}}]}`},
+			action:       "s3:GetObject",
+			resourceARN:  bucketObject,
+			expected:     DecisionExplicitDeny,
+			expectedKeys: []string{"aws:RequestedRegion"},
+		},
+		"if exists with missing key": {
+			identity:     []string{`{"Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*","Condition":{"StringEqualsIfExists":{"aws:RequestedRegion":"us-west-2"}}}]}`},
+			action:       "s3:GetObject",
+			resourceARN:  bucketObject,
+			expected:     DecisionAllowed,
+			expectedKeys: []string{"aws:RequestedRegion"},
+		},
+		"unsupported condition operator": {
+			identity:    []string{`{"Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*","Condition":{"StringSortOf":{"aws:RequestedRegion":"us-west-2"}}}]}`},
+			action:      "s3:GetObject",
+			resourceARN: bucketObject,
+			wantErr:     true,
+		},
+		"resource policy allows principal": {
+			resource:     `{"Statement":[{"Sid":"Bucket","Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:role/example"},"Action":"s3:GetObject","Resource":"arn:aws:s3:::example-bucket/*"}]}`,
+			action:       "s3:GetObject",
+			resourceARN:  bucketObject,
+			context:      map[string][]string{"aws:PrincipalArn": {principalARN}},
+			expected:     DecisionAllowed,
+			expectedSids: []string{"Bucket"},
+		},
+		"resource policy allows account": {
+			resource:    `{"Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"s3:GetObject","Resource":"arn:aws:s3:::example-bucket/*"}]}`,
+			action:      "s3:GetObject",
+			resourceARN: bucketObject,
+			context:     map[string][]string{"aws:PrincipalArn": {principalARN}},
+			expected:    DecisionAllowed,
+		},
+		"resource policy other principal": {
+			resource:     `{"Statement":[{"Effect":"Allow","Principal":{"AWS":"111122223333"},"Action":"s3:GetObject","Resource":"arn:aws:s3:::example-bucket/*"}]}`,
+			action:       "s3:GetObject",
+			resourceARN:  bucketObject,
+			context:      map[string][]string{"aws:PrincipalArn": {principalARN}},
+			expected:     DecisionImplicitDeny,
+			expectedKeys: nil,
+		},
+		"permissions boundary limits identity": {
+			identity:    []string{`{"Statement":[{"Effect":"Allow","Action":"*","Resource":"*"}]}`},
+			boundary:    []string{`{"Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*"}]}`},
+			action:      "iam:CreateUser",
+			resourceARN: "*",
+			expected:    DecisionImplicitDeny,
+		},
+		"permissions boundary allows": {
+			identity:     []string{`{"Statement":[{"Sid":"All","Effect":"Allow","Action":"*","Resource":"*"}]}`},
+			boundary:     []string{`{"Statement":[{"Sid":"S3","Effect":"Allow","Action":"s3:*","Resource":"*"}]}`},
+			action:       "s3:GetObject",
+			resourceARN:  bucketObject,
+			expected:     DecisionAllowed,
+			expectedSids: []string{"S3", "All"},
+		},
+		"permissions boundary does not limit resource policy": {
+			resource:    `{"Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:role/example"},"Action":"s3:GetObject","Resource":"*"}]}`,
+			boundary:    []string{`{"Statement":[{"Effect":"Allow","Action":"ec2:*","Resource":"*"}]}`},
+			action:      "s3:GetObject",
+			resourceARN: bucketObject,
+			context:     map[string][]string{"aws:PrincipalArn": {principalARN}},
+			expected:    DecisionAllowed,
+		},
+		"service control policy every level must allow": {
+			identity: []string{`{"Statement":[{"Effect":"Allow","Action":"*","Resource":"*"}]}`},
+			serviceControl: []string{
+				`{"Statement":[{"Effect":"Allow","Action":"*","Resource":"*"}]}`,
+				`{"Statement":[{"Effect":"Allow","Action":"ec2:*","Resource":"*"}]}`,
+			},
+			action:      "s3:GetObject",
+			resourceARN: bucketObject,
+			expected:    DecisionImplicitDeny,
+		},
+		"service control policy deny": {
+			identity:       []string{`{"Statement":[{"Effect":"Allow","Action":"*","Resource":"*"}]}`},
+			serviceControl: []string{`{"Statement":[{"Effect":"Allow","Action":"*","Resource":"*"},{"Sid":"Region","Effect":"Deny","Action":"*","Resource":"*","Condition":{"StringNotEquals":{"aws:RequestedRegion":["us-east-1","us-west-2"]}}}]}`},
+			action:         "s3:GetObject",
+			resourceARN:    bucketObject,
+			context:        map[string][]string{"aws:RequestedRegion": {"eu-west-1"}},
+			expected:       DecisionExplicitDeny,
+			expectedSids:   []string{"Region"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			parse := func(policies []string) []*Document {
+				var docs []*Document
+				for _, policy := range policies {
+					doc, err := Parse(policy)
+					if err != nil {
+						t.Fatalf("Parse() err %v", err)
+					}
+					docs = append(docs, doc)
+				}
+				return docs
+			}
+
+			policies := Policies{
+				Identity:            parse(testCase.identity),
+				PermissionsBoundary: parse(testCase.boundary),
+				ServiceControl:      parse(testCase.serviceControl),
+			}
+			if testCase.resource != "" {
+				policies.Resource = parse([]string{testCase.resource})[0]
+			}
+
+			got, err := Evaluate(policies, Request{
+				Action:   testCase.action,
+				Resource: testCase.resourceARN,
+				Context:  testCase.context,
+			})
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("Evaluate() err %t, want %t (%v)", got, want, err)
+			}
+			if err != nil {
+				return
+			}
+
+			if got.Decision != testCase.expected {
+				t.Errorf("Evaluate() decision = %s, want %s", got.Decision, testCase.expected)
+			}
+			if testCase.expectedSids != nil {
+				var sids []string
+				for _, v := range got.MatchedStatements {
+					sids = append(sids, v.Sid)
+				}
+				if diff := cmp.Diff(sids, testCase.expectedSids); diff != "" {
+					t.Errorf("unexpected matched statements diff (+wanted, -got): %s", diff)
+				}
+			}
+			if diff := cmp.Diff(got.MissingContextKeys, testCase.expectedKeys); diff != "" {
+				t.Errorf("unexpected missing context keys diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestConditionMatches(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		operator string
+		values   StringSet
+		context  map[string][]string
+		expected bool
+	}{
+		"StringLike":                         {"StringLike", StringSet{"home/*"}, map[string][]string{"s3:prefix": {"home/example"}}, true},
+		"StringNotLike":                      {"StringNotLike", StringSet{"home/*"}, map[string][]string{"s3:prefix": {"home/example"}}, false},
+		"StringEqualsIgnoreCase":             {"StringEqualsIgnoreCase", StringSet{"DATA"}, map[string][]string{"s3:prefix": {"data"}}, true},
+		"NumericLessThan":                    {"NumericLessThan", StringSet{"10"}, map[string][]string{"s3:prefix": {"9"}}, true},
+		"NumericGreaterThanEquals":           {"NumericGreaterThanEquals", StringSet{"10"}, map[string][]string{"s3:prefix": {"9"}}, false},
+		"DateLessThan":                       {"DateLessThan", StringSet{"2024-01-01T00:00:00Z"}, map[string][]string{"s3:prefix": {"2023-06-01T12:00:00Z"}}, true},
+		"DateGreaterThan epoch":              {"DateGreaterThan", StringSet{"1704067200"}, map[string][]string{"s3:prefix": {"2023-06-01T12:00:00Z"}}, false},
+		"BinaryEquals":                       {"BinaryEquals", StringSet{"QmluYXJ5VmFsdWU="}, map[string][]string{"s3:prefix": {"QmluYXJ5VmFsdWU="}}, true},
+		"NotIpAddress":                       {"NotIpAddress", StringSet{"203.0.113.0/24"}, map[string][]string{"s3:prefix": {"203.0.113.7"}}, false},
+		"ArnLike":                            {"ArnLike", StringSet{"arn:aws:iam::*:role/admin-*"}, map[string][]string{"s3:prefix": {"arn:aws:iam::123456789012:role/admin-ops"}}, true},
+		"ArnLike does not span components":   {"ArnLike", StringSet{"arn:aws:iam::*"}, map[string][]string{"s3:prefix": {"arn:aws:iam::123456789012:role/admin"}}, false},
+		"ArnNotEquals":                       {"ArnNotEquals", StringSet{"arn:aws:iam::123456789012:role/admin"}, map[string][]string{"s3:prefix": {"arn:aws:iam::123456789012:role/other"}}, true},
+		"Null absent":                        {"Null", StringSet{"true"}, nil, true},
+		"Null present":                       {"Null", StringSet{"true"}, map[string][]string{"s3:prefix": {"x"}}, false},
+		"ForAllValues all match":             {"ForAllValues:StringEquals", StringSet{"a", "b", "c"}, map[string][]string{"s3:prefix": {"a", "b"}}, true},
+		"ForAllValues one does not match":    {"ForAllValues:StringEquals", StringSet{"a", "b"}, map[string][]string{"s3:prefix": {"a", "d"}}, false},
+		"ForAllValues missing key":           {"ForAllValues:StringEquals", StringSet{"a"}, nil, true},
+		"ForAnyValue one matches":            {"ForAnyValue:StringEquals", StringSet{"a"}, map[string][]string{"s3:prefix": {"d", "a"}}, true},
+		"ForAnyValue missing key":            {"ForAnyValue:StringEquals", StringSet{"a"}, nil, false},
+		"negated multivalued all must match": {"StringNotEquals", StringSet{"a"}, map[string][]string{"s3:prefix": {"b", "a"}}, false},
+		"IfExists present":                   {"StringEqualsIfExists", StringSet{"a"}, map[string][]string{"s3:prefix": {"b"}}, false},
+		"policy variable in condition":       {"StringEquals", StringSet{"${aws:username}"}, map[string][]string{"s3:prefix": {"example"}, "aws:username": {"example"}}, true},
+		"policy variable default":            {"StringEquals", StringSet{"${aws:username, 'nobody'}"}, map[string][]string{"s3:prefix": {"nobody"}}, true},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			e := newEvaluator(Request{Context: testCase.context})
+			got, err := e.conditionMatches(testCase.operator, "s3:prefix", testCase.values)
+			if err != nil {
+				t.Fatalf("conditionMatches() err %v", err)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("conditionMatches() = %t, want %t", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestWildcardMatch(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		pattern    string
+		value      string
+		ignoreCase bool
+		expected   bool
+	}{
+		{"*", "", false, true},
+		{"s3:*", "s3:GetObject", false, true},
+		{"s3:Get*Acl", "s3:GetObjectAcl", false, true},
+		{"s3:Get?bject", "s3:GetObject", false, true},
+		{"s3:Get?bject", "s3:GetObjects", false, false},
+		{"S3:GETOBJECT", "s3:GetObject", true, true},
+		{"S3:GETOBJECT", "s3:GetObject", false, false},
+		{"a*b*c", "aXXbYYc", false, true},
+		{"a*b*c", "aXXbYY", false, false},
+	}
+
+	for _, testCase := range testCases {
+		if got := wildcardMatch(testCase.pattern, testCase.value, testCase.ignoreCase); got != testCase.expected {
+			t.Errorf("wildcardMatch(%q, %q, %t) = %t, want %t", testCase.pattern, testCase.value, testCase.ignoreCase, got, testCase.expected)
+		}
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iampolicy
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Policy variable reference:
+// https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_policies_variables.html
+
+// specialVariables are policy variables that stand for a literal character.
+var specialVariables = map[string]string{
+	"*": "*",
+	"?": "?",
+	"$": "$",
+}
+
+// wildcardMatch reports whether value matches pattern, in which '*' matches any sequence of characters
+// and '?' matches any single character.
+func wildcardMatch(pattern, value string, ignoreCase bool) bool {
+	if ignoreCase {
+		pattern, value = strings.ToLower(pattern), strings.ToLower(value)
+	}
+
+	p, v := []rune(pattern), []rune(value)
+	pi, vi := 0, 0
+	star, match := -1, 0
+
+	for vi < len(v) {
+		switch {
+		case pi < len(p) && (p[pi] == '?' || p[pi] == v[vi]):
+			pi++
+			vi++
+		case pi < len(p) && p[pi] == '*':
+			star, match = pi, vi
+			pi++
+		case star != -1:
+			pi = star + 1
+			match++
+			vi = match
+		default:
+			return false
+		}
+	}
+
+	for pi < len(p) && p[pi] == '*' {
+		pi++
+	}
+
+	return pi == len(p)
+}
+
+// compilePattern compiles a policy string containing wildcards and policy variables into an anchored regular expression.
+// Substituted variable values are matched literally.
+// ok is false if a policy variable cannot be resolved from the request context.
+func (e *evaluator) compilePattern(pattern string, ignoreCase bool) (*regexp.Regexp, bool, error) {
+	var sb strings.Builder
+
+	if ignoreCase {
+		sb.WriteString("(?i)")
+	}
+	sb.WriteString("(?s)^")
+
+	ok, err := e.expandVariables(pattern,
+		func(s string) {
+			for _, r := range s {
+				switch r {
+				case '*':
+					sb.WriteString(".*")
+				case '?':
+					sb.WriteString(".")
+				default:
+					sb.WriteString(regexp.QuoteMeta(string(r)))
+				}
+			}
+		},
+		func(s string) {
+			sb.WriteString(regexp.QuoteMeta(s))
+		},
+	)
+	if err != nil || !ok {
+		return nil, ok, err
+	}
+
+	sb.WriteString("$")
+
+	re, err := regexp.Compile(sb.String())
+	if err != nil {
+		return nil, false, fmt.Errorf("compiling pattern %q: %w", pattern, err)
+	}
+
+	return re, true, nil
+}
+
+// substituteVariables replaces the policy variables in s with their values from the request context.
+// ok is false if a policy variable cannot be resolved from the request context.
+func (e *evaluator) substituteVariables(s string) (string, bool, error) {
+	var sb strings.Builder
+
+	ok, err := e.expandVariables(s, func(s string) { sb.WriteString(s) }, func(s string) { sb.WriteString(s) })
+	if err != nil || !ok {
+		return "", ok, err
+	}
+
+	return sb.String(), true, nil
+}
+
+// expandVariables splits s into literal text and policy variables, passing literal text to text and
+// resolved variable values to value.
+func (e *evaluator) expandVariables(s string, text, value func(string)) (bool, error) {
+	for {
+		start := strings.Index(s, "${")
+		if start == -1 {
+			text(s)
+			return true, nil
+		}
+
+		end := strings.Index(s[start:], "}")
+		if end == -1 {
+			return false, fmt.Errorf("unterminated policy variable in %q", s)
+		}
+		end += start
+
+		text(s[:start])
+
+		v, ok := e.resolveVariable(s[start+2 : end])
+		if !ok {
+			return false, nil
+		}
+		value(v)
+
+		s = s[end+1:]
+	}
+}
+
+// resolveVariable resolves a policy variable, which may specify a default value, e.g. ${aws:username, 'anonymous'}.
+func (e *evaluator) resolveVariable(variable string) (string, bool) {
+	key, defaultValue, hasDefault := strings.Cut(variable, ",")
+	key = strings.TrimSpace(key)
+
+	if v, ok := specialVariables[key]; ok {
+		return v, true
+	}
+
+	if values, ok := e.contextValues(key); ok && len(values) == 1 {
+		return values[0], true
+	}
+
+	if hasDefault {
+		defaultValue = strings.TrimSpace(defaultValue)
+		if len(defaultValue) >= 2 && strings.HasPrefix(defaultValue, "'") && strings.HasSuffix(defaultValue, "'") {
+			return defaultValue[1 : len(defaultValue)-1], true
+		}
+	}
+
+	return "", false
+}
@@ -6,6 +6,7 @@ package fwprovider
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -338,9 +339,23 @@ func (w *wrappedResource) ImportState(ctx context.Context, request resource.Impo
 }
 
 func (w *wrappedResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+
 	if v, ok := w.inner.(resource.ResourceWithModifyPlan); ok {
-		ctx = w.bootstrapContext(ctx, w.meta)
 		v.ModifyPlan(ctx, request, response)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Interceptors that also validate the plan.
+	for _, v := range w.interceptors {
+		if v, ok := v.(interface {
+			modifyPlan(context.Context, resource.ModifyPlanRequest, *resource.ModifyPlanResponse, *conns.AWSClient) diag.Diagnostics
+		}); ok {
+			response.Diagnostics.Append(v.modifyPlan(ctx, request, response, w.meta)...)
+		}
 	}
 }
 
@@ -395,6 +410,16 @@ func (r tagsResourceInterceptor) create(ctx context.Context, request resource.Cr
 		return ctx, diags
 	}
 
+	serviceName, err := names.HumanFriendly(inContext.ServicePackageName)
+	if err != nil {
+		serviceName = "<service>"
+	}
+
+	resourceName := inContext.ResourceName
+	if resourceName == "" {
+		resourceName = "<thing>"
+	}
+
 	tagsInContext, ok := tftags.FromContext(ctx)
 	if !ok {
 		return ctx, diags
@@ -409,8 +434,17 @@ func (r tagsResourceInterceptor) create(ctx context.Context, request resource.Cr
 			return ctx, diags
 		}
 
+		configTags := tftags.New(ctx, planTags)
+
+		// Optionally warn about conflicts between the resource's configured tags and any provider configured default_tags.
+		if defaultConfig := tagsInContext.DefaultConfig; defaultConfig != nil && defaultConfig.ReportConflicts {
+			if report := defaultConfig.Report(configTags, tftags.LimitsFor(inContext.ServicePackageName, inContext.TypeName)); report.HasConflicts() {
+				diags.AddWarning(fmt.Sprintf("Tags for %s %s conflict with provider default_tags", serviceName, resourceName), report.String())
+			}
+		}
+
 		// Merge the resource's configured tags with any provider configured default_tags.
-		tags := tagsInContext.DefaultConfig.MergeTags(tftags.New(ctx, planTags))
+		tags := tagsInContext.DefaultConfig.MergeTags(configTags)
 		// Remove system tags.
 		tags = tags.IgnoreSystem(inContext.ServicePackageName)
 
@@ -579,8 +613,17 @@ func (r tagsResourceInterceptor) update(ctx context.Context, request resource.Up
 			return ctx, diags
 		}
 
+		configTags := tftags.New(ctx, planTags)
+
+		// Optionally warn about conflicts between the resource's configured tags and any provider configured default_tags.
+		if defaultConfig := tagsInContext.DefaultConfig; defaultConfig != nil && defaultConfig.ReportConflicts {
+			if report := defaultConfig.Report(configTags, tftags.LimitsFor(inContext.ServicePackageName, inContext.TypeName)); report.HasConflicts() {
+				diags.AddWarning(fmt.Sprintf("Tags for %s %s conflict with provider default_tags", serviceName, resourceName), report.String())
+			}
+		}
+
 		// Merge the resource's configured tags with any provider configured default_tags.
-		tags := tagsInContext.DefaultConfig.MergeTags(tftags.New(ctx, planTags))
+		tags := tagsInContext.DefaultConfig.MergeTags(configTags)
 		// Remove system tags.
 		tags = tags.IgnoreSystem(inContext.ServicePackageName)
 
@@ -650,6 +693,56 @@ func (r tagsResourceInterceptor) update(ctx context.Context, request resource.Up
 	return ctx, diags
 }
 
+// modifyPlan validates the resource's planned tags, merged with any provider configured default_tags,
+// against the tag limits of the resource's service so that invalid tags fail at plan time.
+func (r tagsResourceInterceptor) modifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse, meta *conns.AWSClient) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if r.tags == nil {
+		return diags
+	}
+
+	// Nothing to validate on destroy.
+	if request.Plan.Raw.IsNull() {
+		return diags
+	}
+
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return diags
+	}
+
+	tagsInContext, ok := tftags.FromContext(ctx)
+	if !ok {
+		return diags
+	}
+
+	var planTags fwtypes.Map
+	diags.Append(request.Plan.GetAttribute(ctx, path.Root(names.AttrTags), &planTags)...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	// Tags can't be validated until they are wholly known.
+	if planTags.IsUnknown() {
+		return diags
+	}
+	for _, v := range planTags.Elements() {
+		if v.IsUnknown() {
+			return diags
+		}
+	}
+
+	tags := tagsInContext.DefaultConfig.MergeTags(tftags.New(ctx, planTags))
+
+	if violations := tags.LimitViolations(tftags.LimitsFor(inContext.ServicePackageName, inContext.TypeName)); len(violations) > 0 {
+		diags.AddAttributeError(path.Root(names.AttrTags), fmt.Sprintf("Invalid %s", names.AttrTags), strings.Join(violations, "\n"))
+	}
+
+	return diags
+}
+
 func (r tagsResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
 	return ctx, diags
 }
@@ -21,6 +21,7 @@ import (
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 	tffunction "github.com/hashicorp/terraform-provider-aws/internal/function"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -56,6 +57,10 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"api_audit_log_path": schema.StringAttribute{
+				Optional:    true,
+				Description: "File to which a JSON Lines record of every AWS API call made by the provider is appended. Can also be configured using the `TF_AWS_API_AUDIT_LOG_PATH` environment variable.",
+			},
 			"custom_ca_bundle": schema.StringAttribute{
 				Optional:    true,
 				Description: "File containing custom root and intermediate certificates. Can also be configured using the `AWS_CA_BUNDLE` environment variable. (Setting `ca_bundle` in the shared config file is not supported.)",
@@ -252,6 +257,34 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 					},
 				},
 			},
+			"circuit_breaker": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings for per-service circuit breakers that fail AWS API calls fast after sustained throttling or server errors.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"cooldown": schema.StringAttribute{
+							CustomType:  fwtypes.DurationType,
+							Optional:    true,
+							Description: "How long a service's circuit stays open before a trial API call is let through. Defaults to 30s. Valid time units are ns, us (or µs), ms, s, h, or m.",
+						},
+						"error_threshold": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The percentage of a service's API call attempts within the window that must fail with throttling or server errors to open its circuit. Defaults to 50.",
+						},
+						"minimum_requests": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The number of a service's API call attempts within the window needed before its circuit can open. Defaults to 20.",
+						},
+						"window": schema.StringAttribute{
+							CustomType:  fwtypes.DurationType,
+							Optional:    true,
+							Description: "The period over which a service's error rate is tracked. Defaults to 1m. Valid time units are ns, us (or µs), ms, s, h, or m.",
+						},
+					},
+				},
+			},
 			"default_tags": schema.ListNestedBlock{
 				Validators: []validator.List{
 					listvalidator.SizeAtMost(1),
@@ -259,6 +292,10 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Description: "Configuration block with settings to default resource tags across all resources.",
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
+						"report_conflicts": schema.BoolAttribute{
+							Optional:    true,
+							Description: "Whether to warn when a resource's tags override default tags or exceed the service's tag limits",
+						},
 						"tags": schema.MapAttribute{
 							ElementType: types.StringType,
 							Optional:    true,
@@ -267,6 +304,32 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 					},
 				},
 			},
+			"default_timeouts": schema.ListNestedBlock{
+				Description: "Configuration blocks with default operation timeouts for a resource type, overriding the resource's own defaults.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"create": schema.StringAttribute{
+							CustomType:  fwtypes.DurationType,
+							Optional:    true,
+							Description: "The default Create timeout. Valid time units are ns, us (or µs), ms, s, h, or m.",
+						},
+						"delete": schema.StringAttribute{
+							CustomType:  fwtypes.DurationType,
+							Optional:    true,
+							Description: "The default Delete timeout. Valid time units are ns, us (or µs), ms, s, h, or m.",
+						},
+						"resource_type": schema.StringAttribute{
+							Required:    true,
+							Description: "The resource type, such as `aws_db_instance`.",
+						},
+						"update": schema.StringAttribute{
+							CustomType:  fwtypes.DurationType,
+							Optional:    true,
+							Description: "The default Update timeout. Valid time units are ns, us (or µs), ms, s, h, or m.",
+						},
+					},
+				},
+			},
 			"endpoints": endpointsBlock(),
 			"ignore_tags": schema.ListNestedBlock{
 				Validators: []validator.List{
@@ -288,6 +351,54 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 					},
 				},
 			},
+			"service_rate_limits": schema.ListNestedBlock{
+				Description: "Configuration blocks with client-side rate limits for the AWS API calls made by a service.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"burst": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The maximum number of API calls that can be made at once. Defaults to 1.",
+						},
+						"operation": schema.StringAttribute{
+							Optional:    true,
+							Description: "The name of a single API operation to rate limit, such as `ChangeResourceRecordSets`. If not set, the rate limit applies to all of the service's API operations.",
+						},
+						"requests_per_second": schema.Float64Attribute{
+							Required:    true,
+							Description: "The sustained rate of API calls per second.",
+						},
+						"service": schema.StringAttribute{
+							Required:    true,
+							Description: "The service to rate limit, using the key of the service in the `endpoints` block, such as `route53`.",
+						},
+					},
+				},
+			},
+			"waiter": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings for waiting on long-running resource operations.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"min_poll_interval": schema.StringAttribute{
+							CustomType:  fwtypes.DurationType,
+							Optional:    true,
+							Description: "The smallest time to wait between checks of a resource's status. Valid time units are ns, us (or µs), ms, s, h, or m.",
+						},
+						"poll_interval": schema.StringAttribute{
+							CustomType:  fwtypes.DurationType,
+							Optional:    true,
+							Description: "The time to wait between checks of a resource's status, overriding the default backoff. Valid time units are ns, us (or µs), ms, s, h, or m.",
+						},
+						"progress_interval": schema.StringAttribute{
+							CustomType:  fwtypes.DurationType,
+							Optional:    true,
+							Description: "The time between log lines reporting the progress of a long-running operation. Defaults to 1m. Valid time units are ns, us (or µs), ms, s, h, or m.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -333,7 +444,7 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
-				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name, typeName)
 				if meta != nil {
 					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig, meta.IgnoreTagsConfig)
 					ctx = meta.RegisterLogger(ctx)
@@ -404,9 +515,13 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
-				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, typeName)
 				if meta != nil {
 					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig, meta.IgnoreTagsConfig)
+					ctx = tfresource.NewWaiterContext(ctx, meta.WaiterConfig)
+					if v, ok := meta.DefaultTimeouts[typeName]; ok {
+						ctx = conns.NewResourceTimeoutsContext(ctx, v)
+					}
 					ctx = meta.RegisterLogger(ctx)
 				}
 
@@ -466,7 +581,15 @@ func (p *fwprovider) Functions(_ context.Context) []func() function.Function {
 	return []func() function.Function{
 		tffunction.NewARNBuildFunction,
 		tffunction.NewARNParseFunction,
+		tffunction.NewCIDRContainsFunction,
+		tffunction.NewEventPatternMatchesFunction,
+		tffunction.NewIAMPolicyEquivalentFunction,
+		tffunction.NewIAMPolicyEvaluateFunction,
+		tffunction.NewIAMPolicyMergeFunction,
+		tffunction.NewIAMPolicyNormalizeFunction,
+		tffunction.NewIPv6CIDRSubnetsFunction,
 		tffunction.NewTrimIAMRolePathFunction,
+		tffunction.NewVPCSubnetPlanFunction,
 	}
 }
 
@@ -5,6 +5,7 @@ package provider
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/go-cty/cty"
@@ -238,8 +239,21 @@ func (r tagsResourceInterceptor) run(ctx context.Context, d schemaResourceData,
 	case Before:
 		switch why {
 		case Create, Update:
+			configTags := tftags.New(ctx, d.Get(names.AttrTags).(map[string]interface{}))
+
+			// Optionally warn about conflicts between the resource's configured tags and any provider configured default_tags.
+			if defaultConfig := tagsInContext.DefaultConfig; defaultConfig != nil && defaultConfig.ReportConflicts {
+				if report := defaultConfig.Report(configTags, tftags.LimitsFor(inContext.ServicePackageName, inContext.TypeName)); report.HasConflicts() {
+					diags = append(diags, diag.Diagnostic{
+						Severity: diag.Warning,
+						Summary:  fmt.Sprintf("Tags for %s %s conflict with provider default_tags", serviceName, resourceName),
+						Detail:   report.String(),
+					})
+				}
+			}
+
 			// Merge the resource's configured tags with any provider configured default_tags.
-			tags := tagsInContext.DefaultConfig.MergeTags(tftags.New(ctx, d.Get(names.AttrTags).(map[string]interface{})))
+			tags := tagsInContext.DefaultConfig.MergeTags(configTags)
 			// Remove system tags.
 			tags = tags.IgnoreSystem(inContext.ServicePackageName)
 
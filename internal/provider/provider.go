@@ -8,7 +8,9 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"slices"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/YakDriver/regexache"
@@ -16,8 +18,10 @@ import (
 	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -26,6 +30,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2/types/nullable"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -49,8 +54,48 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Optional:      true,
 				ConflictsWith: []string{"forbidden_account_ids"},
 			},
+			"api_audit_log_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "File to which a JSON Lines record of every AWS API call made by the provider is appended. " +
+					"Can also be configured using the `TF_AWS_API_AUDIT_LOG_PATH` environment variable.",
+			},
 			"assume_role":                   assumeRoleSchema(),
 			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+			"circuit_breaker": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings for per-service circuit breakers that fail AWS API calls fast after sustained throttling or server errors.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cooldown": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "How long a service's circuit stays open before a trial API call is let through. Defaults to 30s. Valid time units are ns, us (or µs), ms, s, h, or m.",
+							ValidateFunc: verify.ValidDuration,
+						},
+						"error_threshold": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Description:  "The percentage of a service's API call attempts within the window that must fail with throttling or server errors to open its circuit. Defaults to 50.",
+							ValidateFunc: validation.IntBetween(1, 100),
+						},
+						"minimum_requests": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Description:  "The number of a service's API call attempts within the window needed before its circuit can open. Defaults to 20.",
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"window": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "The period over which a service's error rate is tracked. Defaults to 1m. Valid time units are ns, us (or µs), ms, s, h, or m.",
+							ValidateFunc: verify.ValidDuration,
+						},
+					},
+				},
+			},
 			"custom_ca_bundle": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -65,6 +110,11 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Configuration block with settings to default resource tags across all resources.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"report_conflicts": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Whether to warn when a resource's tags override default tags or exceed the service's tag limits",
+						},
 						"tags": {
 							Type:        schema.TypeMap,
 							Optional:    true,
@@ -74,6 +124,38 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					},
 				},
 			},
+			"default_timeouts": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Configuration blocks with default operation timeouts for a resource type, overriding the resource's own defaults.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"create": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "The default Create timeout. Valid time units are ns, us (or µs), ms, s, h, or m.",
+							ValidateFunc: verify.ValidDuration,
+						},
+						"delete": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "The default Delete timeout. Valid time units are ns, us (or µs), ms, s, h, or m.",
+							ValidateFunc: verify.ValidDuration,
+						},
+						"resource_type": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The resource type, such as `aws_db_instance`.",
+						},
+						"update": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "The default Update timeout. Valid time units are ns, us (or µs), ms, s, h, or m.",
+							ValidateFunc: verify.ValidDuration,
+						},
+					},
+				},
+			},
 			"ec2_metadata_service_endpoint": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -185,6 +267,35 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The secret key for API operations. You can retrieve this\n" +
 					"from the 'Security & Credentials' section of the AWS console.",
 			},
+			"service_rate_limits": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Configuration blocks with client-side rate limits for the AWS API calls made by a service.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"burst": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "The maximum number of API calls that can be made at once. Defaults to 1.",
+						},
+						"operation": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The name of a single API operation to rate limit, such as `ChangeResourceRecordSets`. If not set, the rate limit applies to all of the service's API operations.",
+						},
+						"requests_per_second": {
+							Type:        schema.TypeFloat,
+							Required:    true,
+							Description: "The sustained rate of API calls per second.",
+						},
+						"service": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The service to rate limit, using the key of the service in the `endpoints` block, such as `route53`.",
+						},
+					},
+				},
+			},
 			"shared_config_files": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -249,6 +360,34 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Optional:    true,
 				Description: "Resolve an endpoint with FIPS capability",
 			},
+			"waiter": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings for waiting on long-running resource operations.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"min_poll_interval": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "The smallest time to wait between checks of a resource's status. Valid time units are ns, us (or µs), ms, s, h, or m.",
+							ValidateFunc: verify.ValidDuration,
+						},
+						"poll_interval": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "The time to wait between checks of a resource's status, overriding the default backoff. Valid time units are ns, us (or µs), ms, s, h, or m.",
+							ValidateFunc: verify.ValidDuration,
+						},
+						"progress_interval": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "The time between log lines reporting the progress of a long-running operation. Defaults to 1m. Valid time units are ns, us (or µs), ms, s, h, or m.",
+							ValidateFunc: verify.ValidDuration,
+						},
+					},
+				},
+			},
 		},
 
 		// Data sources and resources implemented using Terraform Plugin SDK
@@ -288,7 +427,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta any) context.Context {
-				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name, typeName)
 				if v, ok := meta.(*conns.AWSClient); ok {
 					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig)
 					ctx = v.RegisterLogger(ctx)
@@ -365,9 +504,13 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta any) context.Context {
-				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, typeName)
 				if v, ok := meta.(*conns.AWSClient); ok {
 					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig)
+					ctx = tfresource.NewWaiterContext(ctx, v.WaiterConfig)
+					if timeouts, ok := v.DefaultTimeouts[typeName]; ok {
+						ctx = conns.NewResourceTimeoutsContext(ctx, timeouts)
+					}
 					ctx = v.RegisterLogger(ctx)
 				}
 
@@ -408,6 +551,13 @@ func New(ctx context.Context) (*schema.Provider, error) {
 						readFunc:   tagsReadFunc,
 					},
 				})
+
+				// Validate tags against the service's tag limits at plan time.
+				if v := r.CustomizeDiff; v != nil {
+					r.CustomizeDiff = customdiff.Sequence(tagsCustomizeDiff, v)
+				} else {
+					r.CustomizeDiff = tagsCustomizeDiff
+				}
 			}
 
 			rs := &wrappedResource{
@@ -477,6 +627,7 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 
 	config := conns.Config{
 		AccessKey:                      d.Get("access_key").(string),
+		APIAuditLogPath:                d.Get("api_audit_log_path").(string),
 		CustomCABundle:                 d.Get("custom_ca_bundle").(string),
 		EC2MetadataServiceEndpoint:     d.Get("ec2_metadata_service_endpoint").(string),
 		EC2MetadataServiceEndpointMode: d.Get("ec2_metadata_service_endpoint_mode").(string),
@@ -498,6 +649,10 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		UseFIPSEndpoint:                d.Get("use_fips_endpoint").(bool),
 	}
 
+	if config.APIAuditLogPath == "" {
+		config.APIAuditLogPath = os.Getenv("TF_AWS_API_AUDIT_LOG_PATH")
+	}
+
 	if v, ok := d.Get("retry_mode").(string); ok && v != "" {
 		mode, err := aws.ParseRetryMode(v)
 		if err != nil {
@@ -536,6 +691,14 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.DefaultTagsConfig = expandDefaultTags(ctx, v.([]interface{})[0].(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("default_timeouts"); ok && len(v.([]interface{})) > 0 {
+		defaultTimeouts, err := expandDefaultTimeouts(ctx, v.([]interface{}))
+		if err != nil {
+			return nil, sdkdiag.AppendFromErr(diags, err)
+		}
+		config.DefaultTimeouts = defaultTimeouts
+	}
+
 	v := d.Get("endpoints")
 	endpoints, dx := expandEndpoints(ctx, v.(*schema.Set).List())
 	diags = append(diags, dx...)
@@ -571,6 +734,22 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.MaxRetries = v.(int)
 	}
 
+	if v, ok := d.GetOk("service_rate_limits"); ok && len(v.([]interface{})) > 0 {
+		serviceRateLimits, err := expandServiceRateLimits(ctx, v.([]interface{}))
+		if err != nil {
+			return nil, sdkdiag.AppendFromErr(diags, err)
+		}
+		config.ServiceRateLimits = serviceRateLimits
+	}
+
+	if v, ok := d.GetOk("circuit_breaker"); ok && len(v.([]interface{})) > 0 {
+		config.CircuitBreakerConfig = expandCircuitBreakerConfig(ctx, v.([]interface{})[0])
+	}
+
+	if v, ok := d.GetOk("waiter"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		config.WaiterConfig = expandWaiterConfig(ctx, v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("shared_credentials_files"); ok && len(v.([]interface{})) > 0 {
 		config.SharedCredentialsFiles = flex.ExpandStringValueList(v.([]interface{}))
 	}
@@ -600,6 +779,12 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		return nil, diags
 	}
 
+	diags = append(diags, validateDefaultTimeouts(ctx, provider, meta)...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
 	return meta, diags
 }
 
@@ -845,6 +1030,10 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]interface{}) *tftag
 
 	defaultConfig := &tftags.DefaultConfig{}
 
+	if v, ok := tfMap["report_conflicts"].(bool); ok {
+		defaultConfig.ReportConflicts = v
+	}
+
 	if v, ok := tfMap["tags"].(map[string]interface{}); ok {
 		defaultConfig.Tags = tftags.New(ctx, v)
 	}
@@ -870,6 +1059,182 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags
 	return ignoreConfig
 }
 
+func expandWaiterConfig(_ context.Context, tfMap map[string]interface{}) tfresource.WaiterConfig {
+	apiObject := tfresource.WaiterConfig{}
+
+	if v, ok := tfMap["min_poll_interval"].(string); ok && v != "" {
+		apiObject.MinPollInterval, _ = time.ParseDuration(v)
+	}
+
+	if v, ok := tfMap["poll_interval"].(string); ok && v != "" {
+		apiObject.PollInterval, _ = time.ParseDuration(v)
+	}
+
+	if v, ok := tfMap["progress_interval"].(string); ok && v != "" {
+		apiObject.ProgressInterval, _ = time.ParseDuration(v)
+	}
+
+	return apiObject
+}
+
+func expandCircuitBreakerConfig(_ context.Context, tfMapRaw interface{}) conns.CircuitBreakerConfig {
+	// An empty block enables the circuit breakers with default settings.
+	apiObject := conns.CircuitBreakerConfig{
+		Enabled: true,
+	}
+
+	tfMap, ok := tfMapRaw.(map[string]interface{})
+	if !ok {
+		return apiObject
+	}
+
+	if v, ok := tfMap["cooldown"].(string); ok && v != "" {
+		apiObject.Cooldown, _ = time.ParseDuration(v)
+	}
+
+	if v, ok := tfMap["error_threshold"].(int); ok {
+		apiObject.ErrorThreshold = v
+	}
+
+	if v, ok := tfMap["minimum_requests"].(int); ok {
+		apiObject.MinimumRequests = v
+	}
+
+	if v, ok := tfMap["window"].(string); ok && v != "" {
+		apiObject.Window, _ = time.ParseDuration(v)
+	}
+
+	return apiObject
+}
+
+func expandServiceRateLimits(_ context.Context, tfList []interface{}) ([]conns.ServiceRateLimit, error) {
+	var apiObjects []conns.ServiceRateLimit
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := conns.ServiceRateLimit{}
+
+		if v, ok := tfMap["burst"].(int); ok {
+			apiObject.Burst = v
+		}
+
+		if v, ok := tfMap["operation"].(string); ok {
+			apiObject.Operation = v
+		}
+
+		if v, ok := tfMap["requests_per_second"].(float64); ok {
+			apiObject.RequestsPerSecond = v
+		}
+
+		if v, ok := tfMap["service"].(string); ok {
+			apiObject.Service = v
+		}
+
+		if !slices.Contains(names.ProviderPackages(), apiObject.Service) {
+			return nil, fmt.Errorf("service_rate_limits: unsupported service %q", apiObject.Service)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects, nil
+}
+
+func expandDefaultTimeouts(_ context.Context, tfList []interface{}) (map[string]conns.ResourceTimeouts, error) {
+	apiObjects := make(map[string]conns.ResourceTimeouts)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		resourceType, _ := tfMap["resource_type"].(string)
+
+		if _, ok := apiObjects[resourceType]; ok {
+			return nil, fmt.Errorf("default_timeouts: duplicate resource type %q", resourceType)
+		}
+
+		apiObject := conns.ResourceTimeouts{}
+
+		if v, ok := tfMap["create"].(string); ok && v != "" {
+			apiObject.Create, _ = time.ParseDuration(v)
+		}
+
+		if v, ok := tfMap["delete"].(string); ok && v != "" {
+			apiObject.Delete, _ = time.ParseDuration(v)
+		}
+
+		if v, ok := tfMap["update"].(string); ok && v != "" {
+			apiObject.Update, _ = time.ParseDuration(v)
+		}
+
+		apiObjects[resourceType] = apiObject
+	}
+
+	return apiObjects, nil
+}
+
+// resourceWithDefaultTimeouts is implemented by Framework resources that embed framework.WithTimeouts.
+type resourceWithDefaultTimeouts interface {
+	SetDefaultCreateTimeout(time.Duration)
+	SetDefaultUpdateTimeout(time.Duration)
+	SetDefaultDeleteTimeout(time.Duration)
+}
+
+// frameworkResourceTypeNames returns the Framework resource type names and whether each resource supports timeouts.
+// The resources are only instantiated on first use.
+var frameworkResourceTypeNames = sync.OnceValue(func() map[string]bool {
+	ctx := context.Background()
+	typeNames := make(map[string]bool)
+
+	for _, sp := range servicePackages(ctx) {
+		for _, v := range sp.FrameworkResources(ctx) {
+			r, err := v.Factory(ctx)
+			if err != nil {
+				continue
+			}
+
+			response := resource.MetadataResponse{}
+			r.Metadata(ctx, resource.MetadataRequest{}, &response)
+			_, ok := r.(resourceWithDefaultTimeouts)
+			typeNames[response.TypeName] = ok
+		}
+	}
+
+	return typeNames
+})
+
+// validateDefaultTimeouts checks that the resource types of any provider-level default timeouts support timeouts.
+// The overrides are applied by the resources' CRUD handlers, which read them from Context when resolving their timeouts.
+func validateDefaultTimeouts(_ context.Context, provider *schema.Provider, meta *conns.AWSClient) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for typeName := range meta.DefaultTimeouts {
+		if r, ok := provider.ResourcesMap[typeName]; ok {
+			if r.Timeouts == nil {
+				diags = append(diags, DefaultTimeoutsNotSupportedWarningDiag(typeName))
+			}
+			continue
+		}
+
+		ok, found := frameworkResourceTypeNames()[typeName]
+		if !found {
+			return sdkdiag.AppendErrorf(diags, "default_timeouts: unsupported resource type %q", typeName)
+		}
+
+		if !ok {
+			diags = append(diags, DefaultTimeoutsNotSupportedWarningDiag(typeName))
+		}
+	}
+
+	return diags
+}
+
 func expandEndpoints(_ context.Context, tfList []interface{}) (map[string]string, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
@@ -982,6 +1347,13 @@ func DeprecatedEnvVarDiag(envvar, replacement string) diag.Diagnostic {
 	)
 }
 
+func DefaultTimeoutsNotSupportedWarningDiag(typeName string) diag.Diagnostic {
+	return errs.NewWarningDiagnostic(
+		"Unsupported Default Timeouts",
+		fmt.Sprintf(`Resource type "%s" does not support timeouts. Its "default_timeouts" are ignored.`, typeName),
+	)
+}
+
 func ConflictingEndpointsWarningDiag(elementPath cty.Path, attrs ...string) diag.Diagnostic {
 	attrPaths := make([]string, len(attrs))
 	for i, attr := range attrs {
@@ -8,10 +8,14 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -59,6 +63,156 @@ func TestExpandEndpoints(t *testing.T) { //nolint:paralleltest
 	}
 }
 
+func TestExpandServiceRateLimits(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	results, err := expandServiceRateLimits(ctx, []interface{}{
+		map[string]interface{}{
+			"burst":               0,
+			"operation":           "",
+			"requests_per_second": 5.0,
+			"service":             "route53",
+		},
+		map[string]interface{}{
+			"burst":               2,
+			"operation":           "ChangeResourceRecordSets",
+			"requests_per_second": 0.5,
+			"service":             "route53",
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []conns.ServiceRateLimit{
+		{Service: "route53", RequestsPerSecond: 5},
+		{Service: "route53", Operation: "ChangeResourceRecordSets", RequestsPerSecond: 0.5, Burst: 2},
+	}
+	if diff := cmp.Diff(results, expected); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	_, err = expandServiceRateLimits(ctx, []interface{}{
+		map[string]interface{}{
+			"requests_per_second": 5.0,
+			"service":             "not-a-service",
+		},
+	})
+	if err == nil {
+		t.Error("expected error, got none")
+	}
+}
+
+func TestExpandDefaultTimeouts(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	results, err := expandDefaultTimeouts(ctx, []interface{}{
+		map[string]interface{}{
+			"create":        "90m",
+			"delete":        "",
+			"resource_type": "aws_db_instance",
+			"update":        "2h",
+		},
+		map[string]interface{}{
+			"delete":        "30m",
+			"resource_type": "aws_eks_cluster",
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := map[string]conns.ResourceTimeouts{
+		"aws_db_instance": {Create: 90 * time.Minute, Update: 2 * time.Hour},
+		"aws_eks_cluster": {Delete: 30 * time.Minute},
+	}
+	if diff := cmp.Diff(results, expected); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	_, err = expandDefaultTimeouts(ctx, []interface{}{
+		map[string]interface{}{
+			"create":        "90m",
+			"resource_type": "aws_db_instance",
+		},
+		map[string]interface{}{
+			"delete":        "90m",
+			"resource_type": "aws_db_instance",
+		},
+	})
+	if err == nil {
+		t.Error("expected error, got none")
+	}
+}
+
+func TestValidateDefaultTimeouts(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	resourceTimeouts := &schema.ResourceTimeout{
+		Create: aws.Duration(40 * time.Minute),
+		Delete: aws.Duration(60 * time.Minute),
+	}
+	provider := &schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			"aws_test": {
+				Timeouts: resourceTimeouts,
+			},
+			"aws_test_no_timeouts": {},
+		},
+	}
+	meta := &conns.AWSClient{
+		DefaultTimeouts: map[string]conns.ResourceTimeouts{
+			"aws_test":             {Create: 80 * time.Minute, Update: 10 * time.Minute},
+			"aws_test_no_timeouts": {Create: 80 * time.Minute},
+		},
+	}
+
+	diags := validateDefaultTimeouts(ctx, provider, meta)
+	expectedDiags := diag.Diagnostics{DefaultTimeoutsNotSupportedWarningDiag("aws_test_no_timeouts")}
+	if diff := cmp.Diff(diags, expectedDiags, cmp.Comparer(sdkdiag.Comparer)); diff != "" {
+		t.Errorf("unexpected diagnostics difference: %s", diff)
+	}
+
+	if v := provider.ResourcesMap["aws_test"].Timeouts; v != resourceTimeouts || aws.ToDuration(v.Create) != 40*time.Minute {
+		t.Errorf("resource modified: %v", v)
+	}
+
+	meta.DefaultTimeouts = map[string]conns.ResourceTimeouts{
+		"aws_not_a_resource": {Create: 80 * time.Minute},
+	}
+	if diags := validateDefaultTimeouts(ctx, provider, meta); !diags.HasError() {
+		t.Error("expected error, got none")
+	}
+}
+
+func TestValidateDefaultTimeouts_framework(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	provider := &schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{},
+	}
+	meta := &conns.AWSClient{
+		DefaultTimeouts: map[string]conns.ResourceTimeouts{
+			"aws_batch_job_queue":                 {Create: 80 * time.Minute},
+			"aws_vpc_security_group_ingress_rule": {Create: 80 * time.Minute},
+		},
+	}
+
+	diags := validateDefaultTimeouts(ctx, provider, meta)
+	expectedDiags := diag.Diagnostics{DefaultTimeoutsNotSupportedWarningDiag("aws_vpc_security_group_ingress_rule")}
+	if diff := cmp.Diff(diags, expectedDiags, cmp.Comparer(sdkdiag.Comparer)); diff != "" {
+		t.Errorf("unexpected diagnostics difference: %s", diff)
+	}
+}
+
 func TestEndpointMultipleKeys(t *testing.T) { //nolint:paralleltest
 	ctx := context.Background()
 	testcases := []struct {
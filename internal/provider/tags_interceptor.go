@@ -5,10 +5,13 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
@@ -172,3 +175,30 @@ func tagsReadFunc(ctx context.Context, d schemaResourceData, sp conns.ServicePac
 
 	return ctx, diags
 }
+
+// tagsCustomizeDiff validates a resource's configured tags, merged with any provider configured default_tags,
+// against the tag limits of the resource's service so that invalid tags fail at plan time.
+func tagsCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	tagsInContext, ok := tftags.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	// Tags can't be validated until they are wholly known.
+	if plan := d.GetRawPlan(); plan.IsNull() || !plan.IsKnown() || !plan.GetAttr(names.AttrTags).IsWhollyKnown() {
+		return nil
+	}
+
+	tags := tagsInContext.DefaultConfig.MergeTags(tftags.New(ctx, d.Get(names.AttrTags).(map[string]interface{})))
+
+	if violations := tags.LimitViolations(tftags.LimitsFor(inContext.ServicePackageName, inContext.TypeName)); len(violations) > 0 {
+		return fmt.Errorf("invalid %s: %s", names.AttrTags, strings.Join(violations, "; "))
+	}
+
+	return nil
+}
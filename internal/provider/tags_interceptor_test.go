@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/types/option"
@@ -87,7 +88,7 @@ func TestTagsResourceInterceptor(t *testing.T) {
 	}
 
 	bootstrapContext := func(ctx context.Context, meta any) context.Context {
-		ctx = conns.NewResourceContext(ctx, "Test", "aws_test")
+		ctx = conns.NewResourceContext(ctx, "Test", "Test", "aws_test")
 		if v, ok := meta.(*conns.AWSClient); ok {
 			ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig)
 		}
@@ -107,6 +108,63 @@ func TestTagsResourceInterceptor(t *testing.T) {
 	}
 }
 
+func TestTagsResourceInterceptorReportConflicts(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		reportConflicts bool
+		wantWarnings    int
+	}{
+		"disabled": {
+			reportConflicts: false,
+			wantWarnings:    0,
+		},
+		"enabled": {
+			reportConflicts: true,
+			wantWarnings:    1,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			tags := tagsResourceInterceptor{
+				tags: &types.ServicePackageResourceTags{
+					IdentifierAttribute: "id",
+				},
+				updateFunc: tagsUpdateFunc,
+				readFunc:   tagsReadFunc,
+			}
+			conn := &conns.AWSClient{
+				ServicePackages: map[string]conns.ServicePackage{
+					"Test": &mockService{},
+				},
+				DefaultTagsConfig: expandDefaultTags(ctx, map[string]interface{}{
+					"report_conflicts": testCase.reportConflicts,
+					"tags": map[string]interface{}{
+						"tag1": "default",
+					},
+				}),
+			}
+
+			ctx = conns.NewResourceContext(ctx, "Test", "Test", "aws_test")
+			ctx = tftags.NewContext(ctx, conn.DefaultTagsConfig, conn.IgnoreTagsConfig)
+
+			_, diags := tags.run(ctx, &tagsResourceData{}, conn, Before, Create, nil)
+
+			if got, want := len(sdkdiag.Errors(diags)), 0; got != want {
+				t.Errorf("length of errors = %v, want %v", got, want)
+			}
+			if got, want := len(sdkdiag.Warnings(diags)), testCase.wantWarnings; got != want {
+				t.Errorf("length of warnings = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
 type resourceData struct{}
 
 func (d *resourceData) GetRawConfig() cty.Value {
@@ -148,3 +206,17 @@ func (d *resourceData) GetChange(key string) (interface{}, interface{}) {
 func (d *resourceData) HasChange(key string) bool {
 	return false
 }
+
+type tagsResourceData struct {
+	resourceData
+}
+
+func (d *tagsResourceData) Get(key string) any {
+	if key == "tags" {
+		return map[string]interface{}{
+			"tag1": "value1",
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package retry
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	tfawserr_sdkv2 "github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// ErrorPredicate reports whether an error satisfies a condition.
+// Predicates are only called with non-nil errors.
+type ErrorPredicate func(error) bool
+
+// ErrorCodeEquals matches AWS errors with any of the specified error codes.
+func ErrorCodeEquals(codes ...string) ErrorPredicate {
+	return func(err error) bool {
+		return tfawserr.ErrCodeEquals(err, codes...) || tfawserr_sdkv2.ErrCodeEquals(err, codes...)
+	}
+}
+
+// ErrorCodeContains matches AWS errors whose error code contains the specified string.
+func ErrorCodeContains(code string) ErrorPredicate {
+	return func(err error) bool {
+		return tfawserr.ErrCodeContains(err, code) || tfawserr_sdkv2.ErrCodeContains(err, code)
+	}
+}
+
+// ErrorMessageContains matches AWS errors with the specified error code whose message contains the specified string.
+func ErrorMessageContains(code, message string) ErrorPredicate {
+	return func(err error) bool {
+		return tfawserr.ErrMessageContains(err, code, message) || tfawserr_sdkv2.ErrMessageContains(err, code, message)
+	}
+}
+
+// HTTPStatusCodeEquals matches AWS SDK for Go v2 errors with any of the specified HTTP status codes.
+func HTTPStatusCodeEquals(statusCodes ...int) ErrorPredicate {
+	return func(err error) bool {
+		return tfawserr_sdkv2.ErrHTTPStatusCodeEquals(err, statusCodes...)
+	}
+}
+
+// IsA matches errors of type E.
+func IsA[E error]() ErrorPredicate {
+	return func(err error) bool {
+		return errs.IsA[E](err)
+	}
+}
+
+// IsAErrorMessageContains matches errors of type E whose message contains the specified string.
+func IsAErrorMessageContains[E errs.ErrorWithErrorMessage](needle string) ErrorPredicate {
+	return func(err error) bool {
+		return errs.IsAErrorMessageContains[E](err, needle)
+	}
+}
+
+// NotFound matches retry.NotFoundErrors.
+func NotFound() ErrorPredicate {
+	return tfresource.NotFound
+}
+
+// AnyOf matches errors that satisfy any of the specified predicates.
+func AnyOf(predicates ...ErrorPredicate) ErrorPredicate {
+	return func(err error) bool {
+		for _, predicate := range predicates {
+			if predicate(err) {
+				return true
+			}
+		}
+
+		return false
+	}
+}
+
+// AllOf matches errors that satisfy all of the specified predicates.
+func AllOf(predicates ...ErrorPredicate) ErrorPredicate {
+	return func(err error) bool {
+		for _, predicate := range predicates {
+			if !predicate(err) {
+				return false
+			}
+		}
+
+		return true
+	}
+}
+
+// Not matches errors that don't satisfy the specified predicate.
+func Not(predicate ErrorPredicate) ErrorPredicate {
+	return func(err error) bool {
+		return !predicate(err)
+	}
+}
+
+// UntilEqual returns a predicate that retries an operation until it returns a value equal to `want`.
+// Errors returned by the operation are not retried.
+func UntilEqual[T comparable](want T) PredicateFunc[T] {
+	return func(t T, err error) (bool, error) {
+		if err != nil {
+			return false, err
+		}
+
+		if t != want {
+			return true, fmt.Errorf("output = %v, want %v", t, want)
+		}
+
+		return false, nil
+	}
+}
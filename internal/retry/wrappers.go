@@ -7,8 +7,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"time"
 
+	sdkretry "github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -32,10 +35,14 @@ func (f PredicateFunc[T]) Invoke(t T, err error) (bool, error) {
 	return f(t, err)
 }
 
+// AttemptHook is called after each attempt of an operation with the attempt number, starting at 1, and the operation's results.
+type AttemptHook[T any] func(ctx context.Context, attempt int, t T, err error)
+
 type operation[T any] struct {
 	op                Op[T]
 	predicate         Predicate[T]
 	transformRunError func(error) error
+	hooks             []AttemptHook[T]
 }
 
 // Operation returns a new wrapper on top of the specified function.
@@ -52,17 +59,46 @@ func Operation[T any](op OpFunc[T]) operation[T] {
 }
 
 func (o operation[T]) withPredicate(predicate Predicate[T]) operation[T] {
-	return operation[T]{op: o.op, predicate: predicate, transformRunError: o.transformRunError}
+	return operation[T]{op: o.op, predicate: predicate, transformRunError: o.transformRunError, hooks: o.hooks}
 }
 
 func (o operation[T]) withTransformRunError(f func(error) error) operation[T] {
-	return operation[T]{op: o.op, predicate: o.predicate, transformRunError: f}
+	return operation[T]{op: o.op, predicate: o.predicate, transformRunError: f, hooks: o.hooks}
+}
+
+// OnAttempt adds a hook that is called after each attempt of the operation.
+func (o operation[T]) OnAttempt(hook AttemptHook[T]) operation[T] {
+	return operation[T]{op: o.op, predicate: o.predicate, transformRunError: o.transformRunError, hooks: append(slices.Clone(o.hooks), hook)}
 }
 
 func (o operation[T]) If(predicate PredicateFunc[T]) operation[T] {
 	return o.withPredicate(predicate)
 }
 
+// RetryWhen retries an operation if it returns an error that satisfies the specified predicate.
+// Compose predicates with AnyOf, AllOf and Not.
+func (o operation[T]) RetryWhen(predicate ErrorPredicate) operation[T] {
+	return o.If(func(_ T, err error) (bool, error) {
+		return err != nil && predicate(err), err
+	})
+}
+
+// Until retries an operation until the value it returns satisfies the specified condition.
+// Errors returned by the operation are not retried.
+func (o operation[T]) Until(condition func(T) bool) operation[T] {
+	return o.If(func(t T, err error) (bool, error) {
+		if err != nil {
+			return false, err
+		}
+
+		if !condition(t) {
+			return true, errors.New("condition not met")
+		}
+
+		return false, nil
+	})
+}
+
 // UntilFoundN retries an operation if it returns a retry.NotFoundError.
 func (o operation[T]) UntilFoundN(continuousTargetOccurence int) operation[T] {
 	if continuousTargetOccurence < 1 {
@@ -118,19 +154,66 @@ func (o operation[T]) UntilNotFound() operation[T] {
 	return o.If(predicate).withTransformRunError(transform)
 }
 
+// TimeoutError is returned by Run if the timeout elapses while an operation is being retried.
+// It wraps the context's error and the last error returned by the predicate.
+// A last error that is a retry.NotFoundError is not wrapped, only its own last error, so that tfresource.NotFound
+// is false for a resource that was still not found when the timeout elapsed.
+type TimeoutError struct {
+	Err       error
+	LastError error
+}
+
+func (e *TimeoutError) Error() string {
+	if e.LastError == nil {
+		return e.Err.Error()
+	}
+
+	return fmt.Sprintf("%s: %s", e.Err, e.LastError)
+}
+
+func (e *TimeoutError) Unwrap() []error {
+	wrapped := []error{e.Err}
+
+	if err := e.LastError; err != nil {
+		if nf, ok := errs.As[*sdkretry.NotFoundError](err); ok { // nosemgrep:ci.is-not-found-error
+			err = nf.LastError
+		}
+
+		if err != nil {
+			wrapped = append(wrapped, err)
+		}
+	}
+
+	return wrapped
+}
+
 // Run retries an operation until the timeout elapses or predicate indicates otherwise.
+// If the timeout elapses, the error returned is a *TimeoutError.
 func (o operation[T]) Run(ctx context.Context, timeout time.Duration) (T, error) {
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
+	var lastErr error
+	attempt := 0
 	for r := Begin(); r.Continue(ctx); {
 		t, err := o.op.Invoke(ctx)
 
-		if retry, err := o.predicate.Invoke(t, err); !retry {
+		attempt++
+		for _, hook := range o.hooks {
+			hook(ctx, attempt, t, err)
+		}
+
+		retry, err := o.predicate.Invoke(t, err)
+		if !retry {
 			return t, err
 		}
+
+		lastErr = err
 	}
 
 	var t T
-	return t, o.transformRunError(ctx.Err())
+	return t, o.transformRunError(&TimeoutError{
+		Err:       ctx.Err(),
+		LastError: lastErr,
+	})
 }
@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package retry_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	smithy "github.com/aws/smithy-go"
+	sdkretry "github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestOperationRetryWhen(t *testing.T) {
+	t.Parallel()
+
+	errThrottled := &smithy.GenericAPIError{Code: "Throttling"}
+	errConflict := &types.QueueDeletedRecently{}
+	errFatal := errors.New("fatal")
+
+	testCases := map[string]struct {
+		errs             []error // Error returned by each attempt; the last is repeated.
+		predicate        retry.ErrorPredicate
+		expectedAttempts int
+		expectedError    error
+	}{
+		"success": {
+			errs:             []error{nil},
+			predicate:        retry.ErrorCodeEquals("Throttling"),
+			expectedAttempts: 1,
+		},
+		"error code": {
+			errs:             []error{errThrottled, errThrottled, nil},
+			predicate:        retry.ErrorCodeEquals("Throttling"),
+			expectedAttempts: 3,
+		},
+		"not retryable": {
+			errs:             []error{errThrottled, errFatal},
+			predicate:        retry.ErrorCodeEquals("Throttling"),
+			expectedAttempts: 2,
+			expectedError:    errFatal,
+		},
+		"any of": {
+			errs:             []error{errThrottled, errConflict, nil},
+			predicate:        retry.AnyOf(retry.ErrorCodeContains("Throttl"), retry.IsA[*types.QueueDeletedRecently]()),
+			expectedAttempts: 3,
+		},
+		"all of": {
+			errs:             []error{errThrottled},
+			predicate:        retry.AllOf(retry.ErrorCodeEquals("Throttling"), retry.Not(retry.ErrorCodeEquals("Throttling"))),
+			expectedAttempts: 1,
+			expectedError:    errThrottled,
+		},
+		"not found": {
+			errs:             []error{&sdkretry.NotFoundError{}, nil},
+			predicate:        retry.NotFound(),
+			expectedAttempts: 2,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var attempts, hookAttempts int
+			_, err := retry.Operation(func(context.Context) (int, error) {
+				err := testCase.errs[min(attempts, len(testCase.errs)-1)]
+				attempts++
+				return attempts, err
+			}).RetryWhen(testCase.predicate).OnAttempt(func(_ context.Context, attempt int, _ int, _ error) {
+				hookAttempts = attempt
+			}).Run(context.Background(), 5*time.Second)
+
+			if got, want := err, testCase.expectedError; !errors.Is(got, want) {
+				t.Errorf("err = %v, want %v", got, want)
+			}
+			if got, want := attempts, testCase.expectedAttempts; got != want {
+				t.Errorf("attempts = %d, want %d", got, want)
+			}
+			if got, want := hookAttempts, testCase.expectedAttempts; got != want {
+				t.Errorf("hook attempts = %d, want %d", got, want)
+			}
+		})
+	}
+}
+
+func TestOperationUntilEqual(t *testing.T) {
+	t.Parallel()
+
+	var attempts int
+	output, err := retry.Operation(func(context.Context) (string, error) {
+		attempts++
+		if attempts < 3 {
+			return "PENDING", nil
+		}
+		return "ACTIVE", nil
+	}).If(retry.UntilEqual("ACTIVE")).Run(context.Background(), 5*time.Second)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got, want := output, "ACTIVE"; got != want {
+		t.Errorf("output = %q, want %q", got, want)
+	}
+}
+
+func TestOperationTimeout(t *testing.T) {
+	t.Parallel()
+
+	_, err := retry.Operation(func(context.Context) (string, error) {
+		return "PENDING", nil
+	}).If(retry.UntilEqual("ACTIVE")).Run(context.Background(), 100*time.Millisecond)
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("err = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if got, want := err.Error(), "output = PENDING, want ACTIVE"; !strings.Contains(got, want) {
+		t.Errorf("err = %q, want to contain %q", got, want)
+	}
+}
+
+func TestOperationUntilFoundNTimeout(t *testing.T) {
+	t.Parallel()
+
+	errNotFound := errors.New("not found")
+
+	_, err := retry.Operation(func(context.Context) (string, error) {
+		return "", &sdkretry.NotFoundError{LastError: errNotFound}
+	}).UntilFoundN(1).Run(context.Background(), 100*time.Millisecond)
+
+	if tfresource.NotFound(err) {
+		t.Errorf("err = %v, want not found to be false", err)
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("err = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if !errors.Is(err, errNotFound) {
+		t.Errorf("err = %v, want %v", err, errNotFound)
+	}
+
+	var timeoutErr *retry.TimeoutError
+	if !errors.As(err, &timeoutErr) {
+		t.Errorf("err = %v, want %T", err, timeoutErr)
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// ResourceTimeout returns the timeout for the specified resource operation.
+// A provider-level default timeout for the resource type overrides the resource's own default.
+// Timeouts configured in the resource's `timeouts` block take precedence.
+func ResourceTimeout(ctx context.Context, d *schema.ResourceData, key string) time.Duration {
+	if v, ok := conns.ResourceTimeoutsFromContext(ctx); ok && !timeoutConfigured(d, key) {
+		var timeout time.Duration
+
+		switch key {
+		case schema.TimeoutCreate:
+			timeout = v.Create
+		case schema.TimeoutUpdate:
+			timeout = v.Update
+		case schema.TimeoutDelete:
+			timeout = v.Delete
+		}
+
+		if timeout > 0 {
+			return timeout
+		}
+	}
+
+	return d.Timeout(key)
+}
+
+// timeoutConfigured returns whether the specified operation timeout is set in the resource's `timeouts` block.
+// The configuration is checked when available, otherwise (e.g. on Delete) the state is.
+func timeoutConfigured(d *schema.ResourceData, key string) bool {
+	for _, v := range []cty.Value{d.GetRawConfig(), d.GetRawState()} {
+		if !v.IsKnown() || v.IsNull() || !v.Type().IsObjectType() {
+			continue
+		}
+
+		if !v.Type().HasAttribute(schema.TimeoutsConfigKey) {
+			return false
+		}
+
+		v := v.GetAttr(schema.TimeoutsConfigKey)
+		if !v.IsKnown() || v.IsNull() || !v.Type().IsObjectType() || !v.Type().HasAttribute(key) {
+			return false
+		}
+
+		return !v.GetAttr(key).IsNull()
+	}
+
+	return false
+}
@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestResourceTimeout(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		testName string
+		timeouts *conns.ResourceTimeouts
+		key      string
+		expected time.Duration
+	}{
+		{
+			testName: "no default timeouts",
+			key:      schema.TimeoutCreate,
+			expected: 20 * time.Minute,
+		},
+		{
+			testName: "default Create timeout",
+			timeouts: &conns.ResourceTimeouts{Create: 90 * time.Minute},
+			key:      schema.TimeoutCreate,
+			expected: 90 * time.Minute,
+		},
+		{
+			testName: "default Create timeout for Update",
+			timeouts: &conns.ResourceTimeouts{Create: 90 * time.Minute},
+			key:      schema.TimeoutUpdate,
+			expected: 20 * time.Minute,
+		},
+		{
+			testName: "default Delete timeout",
+			timeouts: &conns.ResourceTimeouts{Delete: 5 * time.Minute},
+			key:      schema.TimeoutDelete,
+			expected: 5 * time.Minute,
+		},
+		{
+			testName: "default Delete timeout for Read",
+			timeouts: &conns.ResourceTimeouts{Delete: 5 * time.Minute},
+			key:      schema.TimeoutRead,
+			expected: 20 * time.Minute,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.testName, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			if testCase.timeouts != nil {
+				ctx = conns.NewResourceTimeoutsContext(ctx, *testCase.timeouts)
+			}
+
+			d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			}, map[string]interface{}{
+				"name": "test",
+			})
+
+			if got, want := ResourceTimeout(ctx, d, testCase.key), testCase.expected; got != want {
+				t.Errorf("ResourceTimeout(%q) = %s, want %s", testCase.key, got, want)
+			}
+		})
+	}
+}
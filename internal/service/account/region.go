@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -83,9 +84,9 @@ func resourceRegionUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		id = region
 	}
 
-	timeout := d.Timeout(schema.TimeoutCreate)
+	timeout := sdkv2.ResourceTimeout(ctx, d, schema.TimeoutCreate)
 	if !d.IsNewResource() {
-		timeout = d.Timeout(schema.TimeoutUpdate)
+		timeout = sdkv2.ResourceTimeout(ctx, d, schema.TimeoutUpdate)
 	}
 
 	if v := d.Get(names.AttrEnabled).(bool); v {
@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -61,7 +62,7 @@ func resourceAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta
 			LoadBalancerNames:    []string{lbName},
 		}
 
-		_, err := tfresource.RetryWhenAWSErrMessageContains(ctx, d.Timeout(schema.TimeoutCreate),
+		_, err := tfresource.RetryWhenAWSErrMessageContains(ctx, sdkv2.ResourceTimeout(ctx, d, schema.TimeoutCreate),
 			func() (interface{}, error) {
 				return conn.AttachLoadBalancers(ctx, input)
 			},
@@ -78,7 +79,7 @@ func resourceAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta
 			TargetGroupARNs:      []string{lbTargetGroupARN},
 		}
 
-		_, err := tfresource.RetryWhenAWSErrMessageContains(ctx, d.Timeout(schema.TimeoutCreate),
+		_, err := tfresource.RetryWhenAWSErrMessageContains(ctx, sdkv2.ResourceTimeout(ctx, d, schema.TimeoutCreate),
 			func() (interface{}, error) {
 				return conn.AttachLoadBalancerTargetGroups(ctx, input)
 			},
@@ -133,7 +134,7 @@ func resourceAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta
 			LoadBalancerNames:    []string{lbName},
 		}
 
-		_, err := tfresource.RetryWhenAWSErrMessageContains(ctx, d.Timeout(schema.TimeoutCreate),
+		_, err := tfresource.RetryWhenAWSErrMessageContains(ctx, sdkv2.ResourceTimeout(ctx, d, schema.TimeoutCreate),
 			func() (interface{}, error) {
 				return conn.DetachLoadBalancers(ctx, input)
 			},
@@ -153,7 +154,7 @@ func resourceAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta
 			TargetGroupARNs:      []string{lbTargetGroupARN},
 		}
 
-		_, err := tfresource.RetryWhenAWSErrMessageContains(ctx, d.Timeout(schema.TimeoutCreate),
+		_, err := tfresource.RetryWhenAWSErrMessageContains(ctx, sdkv2.ResourceTimeout(ctx, d, schema.TimeoutCreate),
 			func() (interface{}, error) {
 				return conn.DetachLoadBalancerTargetGroups(ctx, input)
 			},
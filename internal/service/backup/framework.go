@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -162,7 +163,7 @@ func resourceFrameworkCreate(ctx context.Context, d *schema.ResourceData, meta i
 	d.SetId(aws.StringValue(resp.FrameworkName))
 
 	// waiter since the status changes from CREATE_IN_PROGRESS to either COMPLETED or FAILED
-	if _, err := waitFrameworkCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+	if _, err := waitFrameworkCreated(ctx, conn, d.Id(), sdkv2.ResourceTimeout(ctx, d, schema.TimeoutCreate)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for Framework (%s) creation: %s", d.Id(), err)
 	}
 
@@ -217,7 +218,7 @@ func resourceFrameworkUpdate(ctx context.Context, d *schema.ResourceData, meta i
 
 		log.Printf("[DEBUG] Updating Backup Framework: %#v", input)
 
-		_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutUpdate), func() (interface{}, error) {
+		_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, sdkv2.ResourceTimeout(ctx, d, schema.TimeoutUpdate), func() (interface{}, error) {
 			return conn.UpdateFrameworkWithContext(ctx, input)
 		}, backup.ErrCodeConflictException)
 
@@ -225,7 +226,7 @@ func resourceFrameworkUpdate(ctx context.Context, d *schema.ResourceData, meta i
 			return sdkdiag.AppendErrorf(diags, "updating Backup Framework (%s): %s", d.Id(), err)
 		}
 
-		if _, err := waitFrameworkUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		if _, err := waitFrameworkUpdated(ctx, conn, d.Id(), sdkv2.ResourceTimeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for Framework (%s) update: %s", d.Id(), err)
 		}
 	}
@@ -241,7 +242,7 @@ func resourceFrameworkDelete(ctx context.Context, d *schema.ResourceData, meta i
 		FrameworkName: aws.String(d.Id()),
 	}
 
-	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, sdkv2.ResourceTimeout(ctx, d, schema.TimeoutDelete), func() (interface{}, error) {
 		return conn.DeleteFrameworkWithContext(ctx, input)
 	}, backup.ErrCodeConflictException)
 
@@ -249,7 +250,7 @@ func resourceFrameworkDelete(ctx context.Context, d *schema.ResourceData, meta i
 		return sdkdiag.AppendErrorf(diags, "deleting Backup Framework (%s): %s", d.Id(), err)
 	}
 
-	if _, err := waitFrameworkDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+	if _, err := waitFrameworkDeleted(ctx, conn, d.Id(), sdkv2.ResourceTimeout(ctx, d, schema.TimeoutDelete)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for Framework (%s) deletion: %s", d.Id(), err)
 	}
 
@@ -30,3 +30,94 @@ func missingData_Values() []string {
 		missingDataNotBreaching,
 	}
 }
+
+const (
+	dashboardGridWidth            = 24
+	dashboardMaxWidgets           = 500
+	dashboardWidgetMaxHeight      = 1000
+	dashboardWidgetDefaultHeight  = 6
+	dashboardWidgetDefaultWidth   = 6
+	dashboardAlarmWidgetMaxAlarms = 100
+)
+
+const (
+	dashboardWidgetTypeAlarm    = "alarm"
+	dashboardWidgetTypeExplorer = "explorer"
+	dashboardWidgetTypeLog      = "log"
+	dashboardWidgetTypeMetric   = "metric"
+	dashboardWidgetTypeText     = "text"
+)
+
+func dashboardWidgetType_Values() []string {
+	return []string{
+		dashboardWidgetTypeAlarm,
+		dashboardWidgetTypeExplorer,
+		dashboardWidgetTypeLog,
+		dashboardWidgetTypeMetric,
+		dashboardWidgetTypeText,
+	}
+}
+
+func dashboardPeriodOverride_Values() []string {
+	return []string{
+		"auto",
+		"inherit",
+	}
+}
+
+func dashboardMetricWidgetView_Values() []string {
+	return []string{
+		"bar",
+		"gauge",
+		"pie",
+		"singleValue",
+		"table",
+		"timeSeries",
+	}
+}
+
+func dashboardLogWidgetView_Values() []string {
+	return []string{
+		"bar",
+		"pie",
+		"table",
+		"timeSeries",
+	}
+}
+
+func dashboardExplorerWidgetView_Values() []string {
+	return []string{
+		"bar",
+		"pie",
+		"timeSeries",
+	}
+}
+
+func dashboardTextWidgetBackground_Values() []string {
+	return []string{
+		"solid",
+		"transparent",
+	}
+}
+
+func dashboardAlarmWidgetSortBy_Values() []string {
+	return []string{
+		"default",
+		"stateUpdatedTimestamp",
+		"timestamp",
+	}
+}
+
+func dashboardYAxis_Values() []string {
+	return []string{
+		"left",
+		"right",
+	}
+}
+
+func dashboardAnnotationFill_Values() []string {
+	return []string{
+		"above",
+		"below",
+	}
+}
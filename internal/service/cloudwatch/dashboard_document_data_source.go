@@ -0,0 +1,816 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudwatch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2/types/nullable"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_cloudwatch_dashboard_document", name="Dashboard Document")
+func dataSourceDashboardDocument() *schema.Resource {
+	yAxisRangeSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"label": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"max": {
+						Type:         nullable.TypeNullableFloat,
+						Optional:     true,
+						ValidateFunc: nullable.ValidateTypeStringNullableFloat,
+					},
+					"min": {
+						Type:         nullable.TypeNullableFloat,
+						Optional:     true,
+						ValidateFunc: nullable.ValidateTypeStringNullableFloat,
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceDashboardDocumentRead,
+
+		Schema: map[string]*schema.Schema{
+			"end": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"start"},
+			},
+			names.AttrJSON: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"period_override": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(dashboardPeriodOverride_Values(), false),
+			},
+			"start": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"widget": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: dashboardMaxWidgets,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alarm": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"alarm_arns": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										MaxItems: dashboardAlarmWidgetMaxAlarms,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: verify.ValidARN,
+										},
+									},
+									"sort_by": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(dashboardAlarmWidgetSortBy_Values(), false),
+									},
+									"states": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:             schema.TypeString,
+											ValidateDiagFunc: enum.Validate[types.StateValue](),
+										},
+									},
+									"title": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"explorer": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"aggregate_by": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"function": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												names.AttrKey: {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+									"label": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												names.AttrKey: {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												names.AttrValue: {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+											},
+										},
+									},
+									"legend_position": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"metric": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												names.AttrMetricName: {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												names.AttrResourceType: {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"stat": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+									"period": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"rows_per_page": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"split_by": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												names.AttrKey: {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"sort_function": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+											},
+										},
+									},
+									"stacked": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"title": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"view": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(dashboardExplorerWidgetView_Values(), false),
+									},
+									"widgets_per_row": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+								},
+							},
+						},
+						"height": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      dashboardWidgetDefaultHeight,
+							ValidateFunc: validation.IntBetween(1, dashboardWidgetMaxHeight),
+						},
+						"log": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"log_group_names": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"query": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									names.AttrRegion: {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"stacked": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"title": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"view": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(dashboardLogWidgetView_Values(), false),
+									},
+								},
+							},
+						},
+						"metric": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrAccountID: {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidAccountID,
+									},
+									"alarm_arns": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: verify.ValidARN,
+										},
+									},
+									"horizontal_annotation": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"color": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"fill": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringInSlice(dashboardAnnotationFill_Values(), false),
+												},
+												"label": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												names.AttrValue: {
+													Type:     schema.TypeFloat,
+													Required: true,
+												},
+												"y_axis": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringInSlice(dashboardYAxis_Values(), false),
+												},
+											},
+										},
+									},
+									"live_data": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"metric": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												names.AttrAccountID: {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: verify.ValidAccountID,
+												},
+												"color": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"dimensions": {
+													Type:     schema.TypeMap,
+													Optional: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												names.AttrExpression: {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												names.AttrID: {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"label": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												names.AttrMetricName: {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												names.AttrNamespace: {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"period": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+												names.AttrRegion: {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"stat": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"visible": {
+													Type:         nullable.TypeNullableBool,
+													Optional:     true,
+													ValidateFunc: nullable.ValidateTypeStringNullableBool,
+												},
+												"y_axis": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringInSlice(dashboardYAxis_Values(), false),
+												},
+											},
+										},
+									},
+									"period": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									names.AttrRegion: {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"set_period_to_time_range": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"stacked": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"stat": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"title": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"view": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(dashboardMetricWidgetView_Values(), false),
+									},
+									"y_axis": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"left":  yAxisRangeSchema(),
+												"right": yAxisRangeSchema(),
+											},
+										},
+									},
+								},
+							},
+						},
+						"text": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"background": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(dashboardTextWidgetBackground_Values(), false),
+									},
+									"markdown": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"width": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      dashboardWidgetDefaultWidth,
+							ValidateFunc: validation.IntBetween(1, dashboardGridWidth),
+						},
+						"x": {
+							Type:         nullable.TypeNullableInt,
+							Optional:     true,
+							ValidateFunc: nullable.ValidateTypeStringNullableIntBetween(0, dashboardGridWidth-1),
+						},
+						"y": {
+							Type:         nullable.TypeNullableInt,
+							Optional:     true,
+							ValidateFunc: nullable.ValidateTypeStringNullableIntAtLeast(0),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDashboardDocumentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	doc := &dashboardDoc{
+		End:            d.Get("end").(string),
+		PeriodOverride: d.Get("period_override").(string),
+		Start:          d.Get("start").(string),
+	}
+
+	widgets, err := expandDashboardWidgets(d.Get("widget").([]interface{}), meta.(*conns.AWSClient).Region)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+	doc.Widgets = widgets
+
+	jsonDoc, err := json.Marshal(doc)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+	jsonString := string(jsonDoc)
+
+	if err := validateDashboardBody(jsonString); err != nil {
+		return sdkdiag.AppendErrorf(diags, "dashboard body is not valid: %s", err)
+	}
+
+	d.Set(names.AttrJSON, jsonString)
+
+	d.SetId(strconv.Itoa(schema.HashString(jsonString)))
+
+	return diags
+}
+
+func expandDashboardWidgets(tfList []interface{}, region string) ([]*dashboardWidget, error) {
+	var apiObjects []*dashboardWidget
+	var positioned []bool
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject, err := expandDashboardWidget(tfMap, region)
+		if err != nil {
+			return nil, fmt.Errorf("widget %d: %w", i, err)
+		}
+
+		x, xNull, _ := nullable.Int(tfMap["x"].(string)).ValueInt64()
+		y, yNull, _ := nullable.Int(tfMap["y"].(string)).ValueInt64()
+		if xNull != yNull {
+			return nil, fmt.Errorf("widget %d: x and y must be specified together", i)
+		}
+		apiObject.X, apiObject.Y = int(x), int(y)
+		if !xNull && apiObject.X+apiObject.Width > dashboardGridWidth {
+			return nil, fmt.Errorf("widget %d: x (%d) plus width (%d) must not exceed %d", i, apiObject.X, apiObject.Width, dashboardGridWidth)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+		positioned = append(positioned, !xNull)
+	}
+
+	if err := layoutDashboardWidgets(apiObjects, positioned); err != nil {
+		return nil, err
+	}
+
+	return apiObjects, nil
+}
+
+func expandDashboardWidget(tfMap map[string]interface{}, region string) (*dashboardWidget, error) {
+	apiObject := &dashboardWidget{
+		Height: tfMap["height"].(int),
+		Width:  tfMap["width"].(int),
+	}
+
+	// Widgets that don't fit on the dashboard grid can't be laid out.
+	if apiObject.Width < 1 || apiObject.Width > dashboardGridWidth {
+		return nil, fmt.Errorf("width (%d) must be between 1 and %d", apiObject.Width, dashboardGridWidth)
+	}
+	if apiObject.Height < 1 || apiObject.Height > dashboardWidgetMaxHeight {
+		return nil, fmt.Errorf("height (%d) must be between 1 and %d", apiObject.Height, dashboardWidgetMaxHeight)
+	}
+
+	var widgetTypes []string
+	for _, widgetType := range dashboardWidgetType_Values() {
+		v, ok := tfMap[widgetType].([]interface{})
+		if !ok || len(v) == 0 || v[0] == nil {
+			continue
+		}
+		widgetTypes = append(widgetTypes, widgetType)
+
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Type = widgetType
+
+		switch widgetType {
+		case dashboardWidgetTypeAlarm:
+			apiObject.Properties = expandDashboardAlarmWidgetProperties(tfMap)
+		case dashboardWidgetTypeExplorer:
+			apiObject.Properties = expandDashboardExplorerWidgetProperties(tfMap)
+		case dashboardWidgetTypeLog:
+			apiObject.Properties = expandDashboardLogWidgetProperties(tfMap, region)
+		case dashboardWidgetTypeMetric:
+			properties, err := expandDashboardMetricWidgetProperties(tfMap, region)
+			if err != nil {
+				return nil, err
+			}
+			apiObject.Properties = properties
+		case dashboardWidgetTypeText:
+			apiObject.Properties = expandDashboardTextWidgetProperties(tfMap)
+		}
+	}
+
+	if len(widgetTypes) != 1 {
+		return nil, fmt.Errorf("exactly one of %s must be specified", strings.Join(dashboardWidgetType_Values(), ", "))
+	}
+
+	return apiObject, nil
+}
+
+func expandDashboardAlarmWidgetProperties(tfMap map[string]interface{}) *dashboardAlarmWidgetProperties {
+	return &dashboardAlarmWidgetProperties{
+		Alarms: flex.ExpandStringValueList(tfMap["alarm_arns"].([]interface{})),
+		SortBy: tfMap["sort_by"].(string),
+		States: flex.ExpandStringValueList(tfMap["states"].([]interface{})),
+		Title:  tfMap["title"].(string),
+	}
+}
+
+func expandDashboardExplorerWidgetProperties(tfMap map[string]interface{}) *dashboardExplorerWidgetProperties {
+	apiObject := &dashboardExplorerWidgetProperties{
+		Period: tfMap["period"].(int),
+		Title:  tfMap["title"].(string),
+	}
+
+	if v, ok := tfMap["aggregate_by"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.AggregateBy = &dashboardExplorerAggregateBy{
+			Func: tfMap["function"].(string),
+			Key:  tfMap[names.AttrKey].(string),
+		}
+	}
+
+	for _, v := range tfMap["label"].([]interface{}) {
+		if tfMap, ok := v.(map[string]interface{}); ok {
+			apiObject.Labels = append(apiObject.Labels, &dashboardExplorerLabel{
+				Key:   tfMap[names.AttrKey].(string),
+				Value: tfMap[names.AttrValue].(string),
+			})
+		}
+	}
+
+	for _, v := range tfMap["metric"].([]interface{}) {
+		if tfMap, ok := v.(map[string]interface{}); ok {
+			apiObject.Metrics = append(apiObject.Metrics, &dashboardExplorerMetric{
+				MetricName:   tfMap[names.AttrMetricName].(string),
+				ResourceType: tfMap[names.AttrResourceType].(string),
+				Stat:         tfMap["stat"].(string),
+			})
+		}
+	}
+
+	if v, ok := tfMap["split_by"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.SplitBy = &dashboardExplorerSplitBy{
+			Key:          tfMap[names.AttrKey].(string),
+			SortFunction: tfMap["sort_function"].(string),
+		}
+	}
+
+	widgetOptions := &dashboardExplorerWidgetOptions{
+		RowsPerPage:   tfMap["rows_per_page"].(int),
+		Stacked:       tfMap["stacked"].(bool),
+		View:          tfMap["view"].(string),
+		WidgetsPerRow: tfMap["widgets_per_row"].(int),
+	}
+	if v := tfMap["legend_position"].(string); v != "" {
+		widgetOptions.Legend = &dashboardExplorerLegend{Position: v}
+	}
+	if *widgetOptions != (dashboardExplorerWidgetOptions{}) {
+		apiObject.WidgetOptions = widgetOptions
+	}
+
+	return apiObject
+}
+
+func expandDashboardLogWidgetProperties(tfMap map[string]interface{}, region string) *dashboardLogWidgetProperties {
+	apiObject := &dashboardLogWidgetProperties{
+		Region:  tfMap[names.AttrRegion].(string),
+		Stacked: tfMap["stacked"].(bool),
+		Title:   tfMap["title"].(string),
+		View:    tfMap["view"].(string),
+	}
+
+	if apiObject.Region == "" {
+		apiObject.Region = region
+	}
+
+	// The query is prefixed with one SOURCE command per log group.
+	var query []string
+	for _, v := range flex.ExpandStringValueList(tfMap["log_group_names"].([]interface{})) {
+		query = append(query, fmt.Sprintf("SOURCE '%s'", v))
+	}
+	query = append(query, tfMap["query"].(string))
+	apiObject.Query = strings.Join(query, " | ")
+
+	return apiObject
+}
+
+func expandDashboardMetricWidgetProperties(tfMap map[string]interface{}, region string) (*dashboardMetricWidgetProperties, error) {
+	apiObject := &dashboardMetricWidgetProperties{
+		AccountID:            tfMap[names.AttrAccountID].(string),
+		LiveData:             tfMap["live_data"].(bool),
+		Period:               tfMap["period"].(int),
+		Region:               tfMap[names.AttrRegion].(string),
+		SetPeriodToTimeRange: tfMap["set_period_to_time_range"].(bool),
+		Stacked:              tfMap["stacked"].(bool),
+		Stat:                 tfMap["stat"].(string),
+		Title:                tfMap["title"].(string),
+		View:                 tfMap["view"].(string),
+	}
+
+	if apiObject.Region == "" {
+		apiObject.Region = region
+	}
+
+	annotations := &dashboardAnnotations{
+		Alarms: flex.ExpandStringValueList(tfMap["alarm_arns"].([]interface{})),
+	}
+	for _, v := range tfMap["horizontal_annotation"].([]interface{}) {
+		if tfMap, ok := v.(map[string]interface{}); ok {
+			annotations.Horizontal = append(annotations.Horizontal, &dashboardHorizontalAnnotation{
+				Color: tfMap["color"].(string),
+				Fill:  tfMap["fill"].(string),
+				Label: tfMap["label"].(string),
+				Value: tfMap[names.AttrValue].(float64),
+				YAxis: tfMap["y_axis"].(string),
+			})
+		}
+	}
+	if len(annotations.Alarms) > 0 || len(annotations.Horizontal) > 0 {
+		apiObject.Annotations = annotations
+	}
+
+	for i, v := range tfMap["metric"].([]interface{}) {
+		if tfMap, ok := v.(map[string]interface{}); ok {
+			metric, err := expandDashboardMetric(tfMap)
+			if err != nil {
+				return nil, fmt.Errorf("metric %d: %w", i, err)
+			}
+			apiObject.Metrics = append(apiObject.Metrics, metric)
+		}
+	}
+
+	if v, ok := tfMap["y_axis"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.YAxis = &dashboardYAxis{
+			Left:  expandDashboardYAxisRange(tfMap["left"].([]interface{})),
+			Right: expandDashboardYAxisRange(tfMap["right"].([]interface{})),
+		}
+	}
+
+	return apiObject, nil
+}
+
+// expandDashboardMetric returns a metric widget metrics array entry.
+// Metrics are [Namespace, MetricName, DimensionName, DimensionValue, ..., {rendering options}],
+// and metric math expressions are a single {rendering options} object.
+func expandDashboardMetric(tfMap map[string]interface{}) (any, error) {
+	options := &dashboardMetricOptions{
+		AccountID:  tfMap[names.AttrAccountID].(string),
+		Color:      tfMap["color"].(string),
+		Expression: tfMap[names.AttrExpression].(string),
+		ID:         tfMap[names.AttrID].(string),
+		Label:      tfMap["label"].(string),
+		Period:     tfMap["period"].(int),
+		Region:     tfMap[names.AttrRegion].(string),
+		Stat:       tfMap["stat"].(string),
+		YAxis:      tfMap["y_axis"].(string),
+	}
+
+	if v, null, _ := nullable.Bool(tfMap["visible"].(string)).ValueBool(); !null {
+		options.Visible = &v
+	}
+
+	namespace, metricName := tfMap[names.AttrNamespace].(string), tfMap[names.AttrMetricName].(string)
+	dimensions := flex.ExpandStringValueMap(tfMap["dimensions"].(map[string]interface{}))
+
+	if options.Expression != "" {
+		if namespace != "" || metricName != "" || len(dimensions) > 0 {
+			return nil, fmt.Errorf("%s cannot be combined with %s, %s or dimensions", names.AttrExpression, names.AttrNamespace, names.AttrMetricName)
+		}
+
+		return options, nil
+	}
+
+	if namespace == "" || metricName == "" {
+		return nil, fmt.Errorf("either %s or both %s and %s must be specified", names.AttrExpression, names.AttrNamespace, names.AttrMetricName)
+	}
+
+	// Dimensions are sorted by name so that the document is stable.
+	keys := tfmaps.Keys(dimensions)
+	slices.Sort(keys)
+
+	metric := []any{namespace, metricName}
+	for _, k := range keys {
+		metric = append(metric, k, dimensions[k])
+	}
+	if *options != (dashboardMetricOptions{}) {
+		metric = append(metric, options)
+	}
+
+	return metric, nil
+}
+
+func expandDashboardYAxisRange(tfList []interface{}) *dashboardYAxisRange {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &dashboardYAxisRange{
+		Label: tfMap["label"].(string),
+	}
+
+	if v, null, _ := nullable.Float(tfMap["max"].(string)).ValueFloat64(); !null {
+		apiObject.Max = &v
+	}
+	if v, null, _ := nullable.Float(tfMap["min"].(string)).ValueFloat64(); !null {
+		apiObject.Min = &v
+	}
+
+	return apiObject
+}
+
+func expandDashboardTextWidgetProperties(tfMap map[string]interface{}) *dashboardTextWidgetProperties {
+	return &dashboardTextWidgetProperties{
+		Background: tfMap["background"].(string),
+		Markdown:   tfMap["markdown"].(string),
+	}
+}
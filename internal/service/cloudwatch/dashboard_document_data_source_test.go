@@ -0,0 +1,213 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudwatch_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudWatchDashboardDocumentDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_cloudwatch_dashboard_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardDocumentDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, names.AttrJSON, fmt.Sprintf(testAccDashboardDocumentDataSourceExpectedJSON_basic, acctest.Region())),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchDashboardDocumentDataSource_overlap(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDashboardDocumentDataSourceConfig_overlap,
+				ExpectError: regexache.MustCompile(`widget 1 overlaps widget 0`),
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchDashboardDocumentDataSource_dashboard(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v cloudwatch.GetDashboardOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_dashboard.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDashboardDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardDocumentDataSourceConfig_dashboard(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDashboardExists(ctx, resourceName, &v),
+				),
+			},
+		},
+	})
+}
+
+const testAccDashboardDocumentDataSourceConfig_basic = `
+data "aws_cloudwatch_dashboard_document" "test" {
+  start = "-PT6H"
+
+  widget {
+    width  = 24
+    height = 2
+
+    text {
+      markdown = "# Test"
+    }
+  }
+
+  widget {
+    width = 12
+
+    metric {
+      title = "CPU"
+      stat  = "Average"
+
+      metric {
+        namespace   = "AWS/EC2"
+        metric_name = "CPUUtilization"
+        dimensions = {
+          InstanceId = "i-012345"
+        }
+        id = "m1"
+      }
+
+      metric {
+        expression = "m1 * 2"
+        label      = "double"
+      }
+    }
+  }
+
+  widget {
+    width = 12
+
+    log {
+      log_group_names = ["test"]
+      query           = "fields @timestamp, @message | limit 20"
+    }
+  }
+}
+`
+
+const testAccDashboardDocumentDataSourceExpectedJSON_basic = `{
+  "start": "-PT6H",
+  "widgets": [
+    {
+      "type": "text",
+      "x": 0,
+      "y": 0,
+      "width": 24,
+      "height": 2,
+      "properties": {
+        "markdown": "# Test"
+      }
+    },
+    {
+      "type": "metric",
+      "x": 0,
+      "y": 2,
+      "width": 12,
+      "height": 6,
+      "properties": {
+        "metrics": [
+          ["AWS/EC2", "CPUUtilization", "InstanceId", "i-012345", {"id": "m1"}],
+          {"expression": "m1 * 2", "label": "double"}
+        ],
+        "region": %[1]q,
+        "stat": "Average",
+        "title": "CPU"
+      }
+    },
+    {
+      "type": "log",
+      "x": 12,
+      "y": 2,
+      "width": 12,
+      "height": 6,
+      "properties": {
+        "query": "SOURCE 'test' | fields @timestamp, @message | limit 20",
+        "region": %[1]q
+      }
+    }
+  ]
+}`
+
+const testAccDashboardDocumentDataSourceConfig_overlap = `
+data "aws_cloudwatch_dashboard_document" "test" {
+  widget {
+    x     = 0
+    y     = 0
+    width = 12
+
+    text {
+      markdown = "first"
+    }
+  }
+
+  widget {
+    x     = 6
+    y     = 3
+    width = 12
+
+    text {
+      markdown = "second"
+    }
+  }
+}
+`
+
+func testAccDashboardDocumentDataSourceConfig_dashboard(rName string) string {
+	return fmt.Sprintf(`
+data "aws_cloudwatch_dashboard_document" "test" {
+  widget {
+    text {
+      markdown = "# Test"
+    }
+  }
+
+  widget {
+    metric {
+      metric {
+        namespace   = "AWS/EC2"
+        metric_name = "CPUUtilization"
+      }
+    }
+  }
+}
+
+resource "aws_cloudwatch_dashboard" "test" {
+  dashboard_name = %[1]q
+  dashboard_body = data.aws_cloudwatch_dashboard_document.test.json
+}
+`, rName)
+}
@@ -0,0 +1,206 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudwatch
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Dashboard body structure reference:
+// https://docs.aws.amazon.com/AmazonCloudWatch/latest/APIReference/CloudWatch-Dashboard-Body-Structure.html
+
+type dashboardDoc struct {
+	Start          string             `json:"start,omitempty"`
+	End            string             `json:"end,omitempty"`
+	PeriodOverride string             `json:"periodOverride,omitempty"`
+	Widgets        []*dashboardWidget `json:"widgets"`
+}
+
+type dashboardWidget struct {
+	Type       string `json:"type"`
+	X          int    `json:"x"`
+	Y          int    `json:"y"`
+	Width      int    `json:"width"`
+	Height     int    `json:"height"`
+	Properties any    `json:"properties"`
+}
+
+type dashboardMetricWidgetProperties struct {
+	AccountID            string                `json:"accountId,omitempty"`
+	Annotations          *dashboardAnnotations `json:"annotations,omitempty"`
+	LiveData             bool                  `json:"liveData,omitempty"`
+	Metrics              []any                 `json:"metrics,omitempty"`
+	Period               int                   `json:"period,omitempty"`
+	Region               string                `json:"region"`
+	SetPeriodToTimeRange bool                  `json:"setPeriodToTimeRange,omitempty"`
+	Stacked              bool                  `json:"stacked,omitempty"`
+	Stat                 string                `json:"stat,omitempty"`
+	Title                string                `json:"title,omitempty"`
+	View                 string                `json:"view,omitempty"`
+	YAxis                *dashboardYAxis       `json:"yAxis,omitempty"`
+}
+
+type dashboardAnnotations struct {
+	Alarms     []string                         `json:"alarms,omitempty"`
+	Horizontal []*dashboardHorizontalAnnotation `json:"horizontal,omitempty"`
+}
+
+type dashboardHorizontalAnnotation struct {
+	Color string  `json:"color,omitempty"`
+	Fill  string  `json:"fill,omitempty"`
+	Label string  `json:"label,omitempty"`
+	Value float64 `json:"value"`
+	YAxis string  `json:"yAxis,omitempty"`
+}
+
+type dashboardMetricOptions struct {
+	AccountID  string `json:"accountId,omitempty"`
+	Color      string `json:"color,omitempty"`
+	Expression string `json:"expression,omitempty"`
+	ID         string `json:"id,omitempty"`
+	Label      string `json:"label,omitempty"`
+	Period     int    `json:"period,omitempty"`
+	Region     string `json:"region,omitempty"`
+	Stat       string `json:"stat,omitempty"`
+	Visible    *bool  `json:"visible,omitempty"`
+	YAxis      string `json:"yAxis,omitempty"`
+}
+
+type dashboardYAxis struct {
+	Left  *dashboardYAxisRange `json:"left,omitempty"`
+	Right *dashboardYAxisRange `json:"right,omitempty"`
+}
+
+type dashboardYAxisRange struct {
+	Label string   `json:"label,omitempty"`
+	Max   *float64 `json:"max,omitempty"`
+	Min   *float64 `json:"min,omitempty"`
+}
+
+type dashboardTextWidgetProperties struct {
+	Background string `json:"background,omitempty"`
+	Markdown   string `json:"markdown"`
+}
+
+type dashboardLogWidgetProperties struct {
+	Query   string `json:"query"`
+	Region  string `json:"region"`
+	Stacked bool   `json:"stacked,omitempty"`
+	Title   string `json:"title,omitempty"`
+	View    string `json:"view,omitempty"`
+}
+
+type dashboardAlarmWidgetProperties struct {
+	Alarms []string `json:"alarms"`
+	SortBy string   `json:"sortBy,omitempty"`
+	States []string `json:"states,omitempty"`
+	Title  string   `json:"title,omitempty"`
+}
+
+type dashboardExplorerWidgetProperties struct {
+	AggregateBy   *dashboardExplorerAggregateBy   `json:"aggregateBy,omitempty"`
+	Labels        []*dashboardExplorerLabel       `json:"labels,omitempty"`
+	Metrics       []*dashboardExplorerMetric      `json:"metrics"`
+	Period        int                             `json:"period,omitempty"`
+	SplitBy       *dashboardExplorerSplitBy       `json:"splitBy,omitempty"`
+	Title         string                          `json:"title,omitempty"`
+	WidgetOptions *dashboardExplorerWidgetOptions `json:"widgetOptions,omitempty"`
+}
+
+type dashboardExplorerAggregateBy struct {
+	Func string `json:"func"`
+	Key  string `json:"key"`
+}
+
+type dashboardExplorerLabel struct {
+	Key   string `json:"key"`
+	Value string `json:"value,omitempty"`
+}
+
+type dashboardExplorerMetric struct {
+	MetricName   string `json:"metricName"`
+	ResourceType string `json:"resourceType"`
+	Stat         string `json:"stat"`
+}
+
+type dashboardExplorerSplitBy struct {
+	Key          string `json:"key"`
+	SortFunction string `json:"sortFunction,omitempty"`
+}
+
+type dashboardExplorerWidgetOptions struct {
+	Legend        *dashboardExplorerLegend `json:"legend,omitempty"`
+	RowsPerPage   int                      `json:"rowsPerPage,omitempty"`
+	Stacked       bool                     `json:"stacked,omitempty"`
+	View          string                   `json:"view,omitempty"`
+	WidgetsPerRow int                      `json:"widgetsPerRow,omitempty"`
+}
+
+type dashboardExplorerLegend struct {
+	Position string `json:"position"`
+}
+
+type dashboardRect struct {
+	x, y, width, height int
+}
+
+func (r dashboardRect) overlaps(o dashboardRect) bool {
+	return r.x < o.x+o.width && o.x < r.x+r.width && r.y < o.y+o.height && o.y < r.y+r.height
+}
+
+// layoutDashboardWidgets places widgets without explicit coordinates on the dashboard grid.
+// Explicitly positioned widgets must not overlap. The remaining widgets are placed in order,
+// left to right and then top to bottom, at the first free position after the previously placed widget.
+func layoutDashboardWidgets(widgets []*dashboardWidget, positioned []bool) error {
+	var rects []dashboardRect
+	var owners []int
+
+	for i, widget := range widgets {
+		if !positioned[i] {
+			continue
+		}
+
+		rect := dashboardRect{x: widget.X, y: widget.Y, width: widget.Width, height: widget.Height}
+		if j := slices.IndexFunc(rects, rect.overlaps); j >= 0 {
+			return fmt.Errorf("widget %d overlaps widget %d", i, owners[j])
+		}
+
+		rects = append(rects, rect)
+		owners = append(owners, i)
+	}
+
+	var x, y int
+	for i, widget := range widgets {
+		if positioned[i] {
+			continue
+		}
+
+		// A widget wider than the grid would never fit.
+		if widget.Width < 1 || widget.Width > dashboardGridWidth {
+			return fmt.Errorf("widget %d: width (%d) must be between 1 and %d", i, widget.Width, dashboardGridWidth)
+		}
+
+		var rect dashboardRect
+		for {
+			if x+widget.Width > dashboardGridWidth {
+				x, y = 0, y+1
+				continue
+			}
+
+			rect = dashboardRect{x: x, y: y, width: widget.Width, height: widget.Height}
+			if !slices.ContainsFunc(rects, rect.overlaps) {
+				break
+			}
+			x++
+		}
+
+		widget.X, widget.Y = x, y
+		rects = append(rects, rect)
+		owners = append(owners, i)
+		x += widget.Width
+	}
+
+	return nil
+}
@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudwatch
+
+import (
+	"testing"
+)
+
+func TestLayoutDashboardWidgets(t *testing.T) {
+	t.Parallel()
+
+	type position struct {
+		x, y int
+	}
+
+	testCases := map[string]struct {
+		widgets       []dashboardRect
+		positioned    []bool
+		expected      []position
+		expectedError string
+	}{
+		"row": {
+			widgets: []dashboardRect{
+				{width: 6, height: 6},
+				{width: 6, height: 6},
+				{width: 6, height: 6},
+				{width: 6, height: 6},
+				{width: 6, height: 6},
+			},
+			positioned: []bool{false, false, false, false, false},
+			expected:   []position{{0, 0}, {6, 0}, {12, 0}, {18, 0}, {0, 6}},
+		},
+		"wrap": {
+			widgets: []dashboardRect{
+				{width: 12, height: 3},
+				{width: 18, height: 6},
+				{width: 6, height: 2},
+			},
+			positioned: []bool{false, false, false},
+			expected:   []position{{0, 0}, {0, 3}, {18, 3}},
+		},
+		"around positioned": {
+			widgets: []dashboardRect{
+				{width: 24, height: 2},
+				{x: 6, y: 0, width: 6, height: 6},
+				{width: 8, height: 6},
+				{width: 12, height: 6},
+			},
+			positioned: []bool{false, true, false, false},
+			expected:   []position{{0, 6}, {6, 0}, {0, 8}, {8, 8}},
+		},
+		"overlap": {
+			widgets: []dashboardRect{
+				{x: 0, y: 0, width: 12, height: 6},
+				{x: 6, y: 3, width: 12, height: 6},
+			},
+			positioned:    []bool{true, true},
+			expectedError: "widget 1 overlaps widget 0",
+		},
+		"too wide": {
+			widgets: []dashboardRect{
+				{width: 6, height: 6},
+				{width: 25, height: 6},
+			},
+			positioned:    []bool{false, false},
+			expectedError: "widget 1: width (25) must be between 1 and 24",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var widgets []*dashboardWidget
+			for _, v := range testCase.widgets {
+				widgets = append(widgets, &dashboardWidget{X: v.x, Y: v.y, Width: v.width, Height: v.height})
+			}
+
+			err := layoutDashboardWidgets(widgets, testCase.positioned)
+
+			if testCase.expectedError != "" {
+				if err == nil || err.Error() != testCase.expectedError {
+					t.Fatalf("err = %v, want %q", err, testCase.expectedError)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			for i, widget := range widgets {
+				if got, want := (position{widget.X, widget.Y}), testCase.expected[i]; got != want {
+					t.Errorf("widget %d position = %v, want %v", i, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestExpandDashboardWidgetSize(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		width, height int
+		expectedError string
+	}{
+		"valid": {
+			width:  24,
+			height: 1000,
+		},
+		"zero width": {
+			width:         0,
+			height:        6,
+			expectedError: "width (0) must be between 1 and 24",
+		},
+		"too wide": {
+			width:         25,
+			height:        6,
+			expectedError: "width (25) must be between 1 and 24",
+		},
+		"too high": {
+			width:         6,
+			height:        1001,
+			expectedError: "height (1001) must be between 1 and 1000",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			tfMap := map[string]interface{}{
+				"height": testCase.height,
+				"width":  testCase.width,
+				dashboardWidgetTypeText: []interface{}{
+					map[string]interface{}{
+						"markdown":   "# Title",
+						"background": "",
+					},
+				},
+			}
+
+			_, err := expandDashboardWidget(tfMap, "us-west-2")
+
+			if testCase.expectedError != "" {
+				if err == nil || err.Error() != testCase.expectedError {
+					t.Fatalf("err = %v, want %q", err, testCase.expectedError)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
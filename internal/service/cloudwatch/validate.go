@@ -4,9 +4,16 @@
 package cloudwatch
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 )
 
 func validDashboardName(v interface{}, k string) (ws []string, errors []error) {
@@ -40,3 +47,256 @@ func validEC2AutomateARN(v interface{}, k string) (ws []string, errors []error)
 
 	return
 }
+
+// validateDashboardBody validates a dashboard body, in JSON format, against the dashboard body structure.
+// All problems found are returned, joined.
+// https://docs.aws.amazon.com/AmazonCloudWatch/latest/APIReference/CloudWatch-Dashboard-Body-Structure.html
+func validateDashboardBody(body string) error {
+	decoder := json.NewDecoder(strings.NewReader(body))
+	decoder.UseNumber()
+
+	var v any
+	if err := decoder.Decode(&v); err != nil {
+		return fmt.Errorf("invalid JSON: %w", err)
+	}
+
+	doc, ok := v.(map[string]any)
+	if !ok {
+		return errors.New("dashboard body must be a JSON object")
+	}
+
+	var errs []error
+	errorf := func(path, format string, a ...any) {
+		errs = append(errs, fmt.Errorf("%s: %s", path, fmt.Sprintf(format, a...)))
+	}
+
+	for _, key := range []string{"end", "start"} {
+		if v, ok := doc[key]; ok {
+			if _, ok := v.(string); !ok {
+				errorf(key, "must be a string")
+			}
+		}
+	}
+	if _, ok := doc["end"]; ok {
+		if _, ok := doc["start"]; !ok {
+			errorf("end", "start must also be specified")
+		}
+	}
+
+	if v, ok := doc["periodOverride"]; ok {
+		validateDashboardEnum("periodOverride", v, dashboardPeriodOverride_Values(), errorf)
+	}
+
+	widgets, ok := doc["widgets"].([]any)
+	if !ok {
+		errorf("widgets", "must be an array")
+		return errors.Join(errs...)
+	}
+	if len(widgets) > dashboardMaxWidgets {
+		errorf("widgets", "must contain at most %d widgets, found %d", dashboardMaxWidgets, len(widgets))
+	}
+
+	for i, v := range widgets {
+		path := fmt.Sprintf("widgets[%d]", i)
+
+		widget, ok := v.(map[string]any)
+		if !ok {
+			errorf(path, "must be an object")
+			continue
+		}
+
+		validateDashboardWidget(path, widget, errorf)
+	}
+
+	return errors.Join(errs...)
+}
+
+type dashboardErrorfFunc func(path, format string, a ...any)
+
+func validateDashboardWidget(path string, widget map[string]any, errorf dashboardErrorfFunc) {
+	integer := func(key string, min, max, defaultValue int64) (int64, bool) {
+		v, ok := widget[key]
+		if !ok {
+			return defaultValue, false
+		}
+
+		n, ok := v.(json.Number)
+		if !ok {
+			errorf(path, "%s must be an integer", key)
+			return defaultValue, true
+		}
+		i, err := n.Int64()
+		if err != nil || i < min || (max > 0 && i > max) {
+			if max > 0 {
+				errorf(path, "%s must be an integer between %d and %d, got %s", key, min, max, n)
+			} else {
+				errorf(path, "%s must be an integer of at least %d, got %s", key, min, n)
+			}
+			return defaultValue, true
+		}
+
+		return i, true
+	}
+
+	x, xOK := integer("x", 0, dashboardGridWidth-1, 0)
+	_, yOK := integer("y", 0, 0, 0)
+	width, _ := integer("width", 1, dashboardGridWidth, dashboardWidgetDefaultWidth)
+	integer("height", 1, dashboardWidgetMaxHeight, dashboardWidgetDefaultHeight)
+
+	if yOK && !xOK {
+		errorf(path, "x must be specified when y is specified")
+	}
+	if xOK && x+width > dashboardGridWidth {
+		errorf(path, "x (%d) plus width (%d) must not exceed %d", x, width, dashboardGridWidth)
+	}
+
+	widgetType, ok := widget["type"].(string)
+	if !ok || !slices.Contains(dashboardWidgetType_Values(), widgetType) {
+		errorf(path, "type must be one of %s", strings.Join(dashboardWidgetType_Values(), ", "))
+		return
+	}
+
+	properties, ok := widget["properties"].(map[string]any)
+	if !ok {
+		errorf(path, "properties must be an object")
+		return
+	}
+
+	path += ".properties"
+	requiredString := func(key string) {
+		if s, _ := properties[key].(string); s == "" {
+			errorf(path, "%s must be a non-empty string", key)
+		}
+	}
+
+	switch widgetType {
+	case dashboardWidgetTypeAlarm:
+		alarms, ok := properties["alarms"].([]any)
+		if !ok || len(alarms) == 0 || len(alarms) > dashboardAlarmWidgetMaxAlarms {
+			errorf(path, "alarms must be an array of between 1 and %d alarm ARNs", dashboardAlarmWidgetMaxAlarms)
+		}
+		for i, v := range alarms {
+			if s, _ := v.(string); !arn.IsARN(s) {
+				errorf(fmt.Sprintf("%s.alarms[%d]", path, i), "must be an alarm ARN")
+			}
+		}
+		if v, ok := properties["sortBy"]; ok {
+			validateDashboardEnum(path+".sortBy", v, dashboardAlarmWidgetSortBy_Values(), errorf)
+		}
+		if v, ok := properties["states"]; ok {
+			states, ok := v.([]any)
+			if !ok {
+				errorf(path, "states must be an array")
+			}
+			for i, v := range states {
+				validateDashboardEnum(fmt.Sprintf("%s.states[%d]", path, i), v, enum.Values[types.StateValue](), errorf)
+			}
+		}
+
+	case dashboardWidgetTypeExplorer:
+		metrics, ok := properties["metrics"].([]any)
+		if !ok || len(metrics) == 0 {
+			errorf(path, "metrics must be a non-empty array")
+		}
+		for i, v := range metrics {
+			metric, ok := v.(map[string]any)
+			if !ok {
+				errorf(fmt.Sprintf("%s.metrics[%d]", path, i), "must be an object")
+				continue
+			}
+			for _, key := range []string{"metricName", "resourceType", "stat"} {
+				if s, _ := metric[key].(string); s == "" {
+					errorf(fmt.Sprintf("%s.metrics[%d]", path, i), "%s must be a non-empty string", key)
+				}
+			}
+		}
+		if widgetOptions, ok := properties["widgetOptions"].(map[string]any); ok {
+			if v, ok := widgetOptions["view"]; ok {
+				validateDashboardEnum(path+".widgetOptions.view", v, dashboardExplorerWidgetView_Values(), errorf)
+			}
+		}
+
+	case dashboardWidgetTypeLog:
+		requiredString("query")
+		requiredString("region")
+		if v, ok := properties["view"]; ok {
+			validateDashboardEnum(path+".view", v, dashboardLogWidgetView_Values(), errorf)
+		}
+
+	case dashboardWidgetTypeMetric:
+		requiredString("region")
+
+		metrics, _ := properties["metrics"].([]any)
+		var alarms []any
+		if annotations, ok := properties["annotations"].(map[string]any); ok {
+			alarms, _ = annotations["alarms"].([]any)
+		}
+		if len(metrics) == 0 && len(alarms) == 0 {
+			errorf(path, "metrics or annotations.alarms must be specified")
+		}
+		for i, v := range metrics {
+			if err := validateDashboardMetric(v); err != nil {
+				errorf(fmt.Sprintf("%s.metrics[%d]", path, i), "%s", err)
+			}
+		}
+
+		view := "timeSeries"
+		if v, ok := properties["view"]; ok {
+			validateDashboardEnum(path+".view", v, dashboardMetricWidgetView_Values(), errorf)
+			view, _ = v.(string)
+		}
+		// Gauges need a fixed range.
+		if view == "gauge" {
+			var left map[string]any
+			if yAxis, ok := properties["yAxis"].(map[string]any); ok {
+				left, _ = yAxis["left"].(map[string]any)
+			}
+			_, minOK := left["min"].(json.Number)
+			_, maxOK := left["max"].(json.Number)
+			if !minOK || !maxOK {
+				errorf(path, "yAxis.left.min and yAxis.left.max must be specified for the gauge view")
+			}
+		}
+
+	case dashboardWidgetTypeText:
+		requiredString("markdown")
+		if v, ok := properties["background"]; ok {
+			validateDashboardEnum(path+".background", v, dashboardTextWidgetBackground_Values(), errorf)
+		}
+	}
+}
+
+// validateDashboardMetric validates a metric widget metrics array entry.
+func validateDashboardMetric(v any) error {
+	switch v := v.(type) {
+	case map[string]any:
+		if s, _ := v["expression"].(string); s == "" {
+			return errors.New("metric math object must have a non-empty expression")
+		}
+		return nil
+
+	case []any:
+		// [Namespace, MetricName, DimensionName, DimensionValue, ..., {rendering options}].
+		// "..." and "." repeat values from the previous entry.
+		for i, element := range v {
+			switch element.(type) {
+			case string:
+			case map[string]any:
+				if i != len(v)-1 {
+					return errors.New("rendering options must be the last element")
+				}
+			default:
+				return errors.New("elements must be strings followed by an optional rendering options object")
+			}
+		}
+		return nil
+	}
+
+	return errors.New("must be an array or a metric math object")
+}
+
+func validateDashboardEnum(path string, v any, values []string, errorf dashboardErrorfFunc) {
+	if s, ok := v.(string); !ok || !slices.Contains(values, s) {
+		errorf(path, "must be one of %s", strings.Join(values, ", "))
+	}
+}
@@ -74,3 +74,98 @@ func TestValidEC2AutomateARN(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateDashboardBody(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		body          string
+		expectedError string
+	}{
+		"valid": {
+			body: `{
+  "start": "-PT6H",
+  "periodOverride": "inherit",
+  "widgets": [
+    {"type": "metric", "x": 0, "y": 0, "width": 12, "height": 6, "properties": {"region": "us-west-2", "metrics": [["AWS/EC2", "CPUUtilization", "InstanceId", "i-012345"], [".", ".", ".", "i-abcdef", {"stat": "Maximum"}], [{"expression": "SUM(METRICS())", "label": "total"}]]}},
+    {"type": "text", "x": 12, "y": 0, "width": 12, "height": 6, "properties": {"markdown": "# Hello"}},
+    {"type": "log", "properties": {"region": "us-west-2", "query": "SOURCE 'test' | fields @message", "view": "table"}},
+    {"type": "alarm", "properties": {"alarms": ["arn:aws:cloudwatch:us-west-2:123456789012:alarm:test"], "states": ["ALARM"]}},
+    {"type": "explorer", "properties": {"metrics": [{"metricName": "CPUUtilization", "resourceType": "AWS::EC2::Instance", "stat": "Average"}]}}
+  ]
+}`, //lintignore:AWSAT003,AWSAT005
+		},
+		"invalid JSON": {
+			body:          `{"widgets": }`,
+			expectedError: "invalid JSON",
+		},
+		"no widgets": {
+			body:          `{"start": "-PT6H"}`,
+			expectedError: "widgets: must be an array",
+		},
+		"end without start": {
+			body:          `{"end": "-PT1H", "widgets": []}`,
+			expectedError: "end: start must also be specified",
+		},
+		"invalid type": {
+			body:          `{"widgets": [{"type": "graph", "properties": {}}]}`,
+			expectedError: "widgets[0]: type must be one of alarm, explorer, log, metric, text",
+		},
+		"outside grid": {
+			body:          `{"widgets": [{"type": "text", "x": 20, "y": 0, "width": 6, "properties": {"markdown": "x"}}]}`,
+			expectedError: "widgets[0]: x (20) plus width (6) must not exceed 24",
+		},
+		"invalid height": {
+			body:          `{"widgets": [{"type": "text", "height": 0, "properties": {"markdown": "x"}}]}`,
+			expectedError: "widgets[0]: height must be an integer between 1 and 1000, got 0",
+		},
+		"y without x": {
+			body:          `{"widgets": [{"type": "text", "y": 3, "properties": {"markdown": "x"}}]}`,
+			expectedError: "widgets[0]: x must be specified when y is specified",
+		},
+		"metric without region": {
+			body:          `{"widgets": [{"type": "metric", "properties": {"metrics": [["AWS/EC2", "CPUUtilization"]]}}]}`,
+			expectedError: "widgets[0].properties: region must be a non-empty string",
+		},
+		"metric rendering options not last": {
+			body:          `{"widgets": [{"type": "metric", "properties": {"region": "us-west-2", "metrics": [["AWS/EC2", {"stat": "Sum"}, "CPUUtilization"]]}}]}`, //lintignore:AWSAT003
+			expectedError: "widgets[0].properties.metrics[0]: rendering options must be the last element",
+		},
+		"gauge without range": {
+			body:          `{"widgets": [{"type": "metric", "properties": {"region": "us-west-2", "view": "gauge", "metrics": [["AWS/EC2", "CPUUtilization"]]}}]}`, //lintignore:AWSAT003
+			expectedError: "widgets[0].properties: yAxis.left.min and yAxis.left.max must be specified for the gauge view",
+		},
+		"invalid alarm state": {
+			body:          `{"widgets": [{"type": "alarm", "properties": {"alarms": ["arn:aws:cloudwatch:us-west-2:123456789012:alarm:test"], "states": ["FIRING"]}}]}`, //lintignore:AWSAT003,AWSAT005
+			expectedError: "widgets[0].properties.states[0]: must be one of",
+		},
+		"text without markdown": {
+			body:          `{"widgets": [{"type": "text", "properties": {"background": "solid"}}]}`,
+			expectedError: "widgets[0].properties: markdown must be a non-empty string",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateDashboardBody(testCase.body)
+
+			if testCase.expectedError == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error")
+			}
+			if got, want := err.Error(), testCase.expectedError; !strings.HasPrefix(got, want) {
+				t.Errorf("err = %q, want prefix %q", got, want)
+			}
+		})
+	}
+}
@@ -32,6 +32,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
 	tfkms "github.com/hashicorp/terraform-provider-aws/internal/service/kms"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -284,7 +285,7 @@ func resourceDocumentClassifierCreate(ctx context.Context, d *schema.ResourceDat
 		versionName = aws.String(v)
 	}
 
-	diags := documentClassifierPublishVersion(ctx, conn, d, versionName, create.ErrActionCreating, d.Timeout(schema.TimeoutCreate), awsClient)
+	diags := documentClassifierPublishVersion(ctx, conn, d, versionName, create.ErrActionCreating, sdkv2.ResourceTimeout(ctx, d, schema.TimeoutCreate), awsClient)
 	if diags.HasError() {
 		return diags
 	}
@@ -354,7 +355,7 @@ func resourceDocumentClassifierUpdate(ctx context.Context, d *schema.ResourceDat
 			versionName = aws.String(create.Name("", d.Get("version_name_prefix").(string)))
 		}
 
-		diags := documentClassifierPublishVersion(ctx, conn, d, versionName, create.ErrActionUpdating, d.Timeout(schema.TimeoutUpdate), awsClient)
+		diags := documentClassifierPublishVersion(ctx, conn, d, versionName, create.ErrActionUpdating, sdkv2.ResourceTimeout(ctx, d, schema.TimeoutUpdate), awsClient)
 		if diags.HasError() {
 			return diags
 		}
@@ -382,7 +383,7 @@ func resourceDocumentClassifierDelete(ctx context.Context, d *schema.ResourceDat
 		return sdkdiag.AppendErrorf(diags, "stopping Comprehend Document Classifier (%s): %s", d.Id(), err)
 	}
 
-	if _, err := waitDocumentClassifierStopped(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+	if _, err := waitDocumentClassifierStopped(ctx, conn, d.Id(), sdkv2.ResourceTimeout(ctx, d, schema.TimeoutDelete)); err != nil {
 		var nfe *types.ResourceNotFoundException
 		if errors.As(err, &nfe) {
 			return diags
@@ -417,7 +418,7 @@ func resourceDocumentClassifierDelete(ctx context.Context, d *schema.ResourceDat
 				}
 			}
 
-			if _, err := waitDocumentClassifierDeleted(ctx, conn, aws.ToString(v.DocumentClassifierArn), d.Timeout(schema.TimeoutDelete)); err != nil {
+			if _, err := waitDocumentClassifierDeleted(ctx, conn, aws.ToString(v.DocumentClassifierArn), sdkv2.ResourceTimeout(ctx, d, schema.TimeoutDelete)); err != nil {
 				return fmt.Errorf("waiting for version (%s) to be deleted: %s", aws.ToString(v.VersionName), err)
 			}
 
@@ -437,7 +438,7 @@ func resourceDocumentClassifierDelete(ctx context.Context, d *schema.ResourceDat
 					networkInterfaceID := aws.ToString(v.NetworkInterfaceId)
 
 					if v.Attachment != nil {
-						err = tfec2.DetachNetworkInterface(ctx, ec2Conn, networkInterfaceID, aws.ToString(v.Attachment.AttachmentId), d.Timeout(schema.TimeoutDelete))
+						err = tfec2.DetachNetworkInterface(ctx, ec2Conn, networkInterfaceID, aws.ToString(v.Attachment.AttachmentId), sdkv2.ResourceTimeout(ctx, d, schema.TimeoutDelete))
 
 						if err != nil {
 							return fmt.Errorf("detaching ENI (%s): %w", networkInterfaceID, err)
@@ -560,7 +561,7 @@ func documentClassifierPublishVersion(ctx context.Context, conn *comprehend.Clie
 				initialENIIds[aws.ToString(v.NetworkInterfaceId)] = true
 			}
 
-			newENI, err := waitNetworkInterfaceCreated(waitCtx, ec2Conn, initialENIIds, in.VpcConfig.SecurityGroupIds, in.VpcConfig.Subnets, d.Timeout(schema.TimeoutCreate))
+			newENI, err := waitNetworkInterfaceCreated(waitCtx, ec2Conn, initialENIIds, in.VpcConfig.SecurityGroupIds, in.VpcConfig.Subnets, sdkv2.ResourceTimeout(ctx, d, schema.TimeoutCreate))
 			if errors.Is(err, context.Canceled) {
 				diags = sdkdiag.AppendWarningf(diags, "waiting for Amazon Comprehend Document Classifier (%s) %s: %s", d.Id(), tobe, "ENI not found")
 				return nil
@@ -30,6 +30,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
 	tfkms "github.com/hashicorp/terraform-provider-aws/internal/service/kms"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -319,7 +320,7 @@ func resourceEntityRecognizerCreate(ctx context.Context, d *schema.ResourceData,
 		versionName = aws.String(v)
 	}
 
-	diags := entityRecognizerPublishVersion(ctx, conn, d, versionName, create.ErrActionCreating, d.Timeout(schema.TimeoutCreate), awsClient)
+	diags := entityRecognizerPublishVersion(ctx, conn, d, versionName, create.ErrActionCreating, sdkv2.ResourceTimeout(ctx, d, schema.TimeoutCreate), awsClient)
 	if diags.HasError() {
 		return diags
 	}
@@ -384,7 +385,7 @@ func resourceEntityRecognizerUpdate(ctx context.Context, d *schema.ResourceData,
 			versionName = aws.String(create.Name("", d.Get("version_name_prefix").(string)))
 		}
 
-		diags := entityRecognizerPublishVersion(ctx, conn, d, versionName, create.ErrActionUpdating, d.Timeout(schema.TimeoutUpdate), awsClient)
+		diags := entityRecognizerPublishVersion(ctx, conn, d, versionName, create.ErrActionUpdating, sdkv2.ResourceTimeout(ctx, d, schema.TimeoutUpdate), awsClient)
 		if diags.HasError() {
 			return diags
 		}
@@ -412,7 +413,7 @@ func resourceEntityRecognizerDelete(ctx context.Context, d *schema.ResourceData,
 		return sdkdiag.AppendErrorf(diags, "stopping Comprehend Entity Recognizer (%s): %s", d.Id(), err)
 	}
 
-	if _, err := waitEntityRecognizerStopped(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+	if _, err := waitEntityRecognizerStopped(ctx, conn, d.Id(), sdkv2.ResourceTimeout(ctx, d, schema.TimeoutDelete)); err != nil {
 		var nfe *types.ResourceNotFoundException
 		if errors.As(err, &nfe) {
 			return diags
@@ -447,7 +448,7 @@ func resourceEntityRecognizerDelete(ctx context.Context, d *schema.ResourceData,
 				}
 			}
 
-			if _, err := waitEntityRecognizerDeleted(ctx, conn, aws.ToString(v.EntityRecognizerArn), d.Timeout(schema.TimeoutDelete)); err != nil {
+			if _, err := waitEntityRecognizerDeleted(ctx, conn, aws.ToString(v.EntityRecognizerArn), sdkv2.ResourceTimeout(ctx, d, schema.TimeoutDelete)); err != nil {
 				return fmt.Errorf("waiting for version (%s) to be deleted: %s", aws.ToString(v.VersionName), err)
 			}
 
@@ -467,7 +468,7 @@ func resourceEntityRecognizerDelete(ctx context.Context, d *schema.ResourceData,
 					networkInterfaceID := aws.ToString(v.NetworkInterfaceId)
 
 					if v.Attachment != nil {
-						err = tfec2.DetachNetworkInterface(ctx, ec2Conn, networkInterfaceID, aws.ToString(v.Attachment.AttachmentId), d.Timeout(schema.TimeoutDelete))
+						err = tfec2.DetachNetworkInterface(ctx, ec2Conn, networkInterfaceID, aws.ToString(v.Attachment.AttachmentId), sdkv2.ResourceTimeout(ctx, d, schema.TimeoutDelete))
 
 						if err != nil {
 							return fmt.Errorf("detaching ENI (%s): %w", networkInterfaceID, err)
@@ -588,7 +589,7 @@ func entityRecognizerPublishVersion(ctx context.Context, conn *comprehend.Client
 				initialENIIds[aws.ToString(v.NetworkInterfaceId)] = true
 			}
 
-			newENI, err := waitNetworkInterfaceCreated(waitCtx, ec2Conn, initialENIIds, in.VpcConfig.SecurityGroupIds, in.VpcConfig.Subnets, d.Timeout(schema.TimeoutCreate))
+			newENI, err := waitNetworkInterfaceCreated(waitCtx, ec2Conn, initialENIIds, in.VpcConfig.SecurityGroupIds, in.VpcConfig.Subnets, sdkv2.ResourceTimeout(ctx, d, schema.TimeoutCreate))
 			if errors.Is(err, context.Canceled) {
 				diags = sdkdiag.AppendWarningf(diags, "waiting for Amazon Comprehend Entity Recognizer (%s) %s: %s", d.Id(), tobe, "ENI not found")
 				return nil
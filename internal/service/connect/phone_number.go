@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -159,7 +160,7 @@ func resourcePhoneNumberCreate(ctx context.Context, d *schema.ResourceData, meta
 	phoneNumberId := output2.PhoneNumberId
 	d.SetId(aws.StringValue(phoneNumberId))
 
-	if _, err := waitPhoneNumberCreated(ctx, conn, d.Timeout(schema.TimeoutCreate), d.Id()); err != nil {
+	if _, err := waitPhoneNumberCreated(ctx, conn, sdkv2.ResourceTimeout(ctx, d, schema.TimeoutCreate), d.Id()); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for Phone Number (%s) creation: %s", d.Id(), err)
 	}
 
@@ -233,7 +234,7 @@ func resourcePhoneNumberUpdate(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 
-	if _, err := waitPhoneNumberUpdated(ctx, conn, d.Timeout(schema.TimeoutCreate), d.Id()); err != nil {
+	if _, err := waitPhoneNumberUpdated(ctx, conn, sdkv2.ResourceTimeout(ctx, d, schema.TimeoutCreate), d.Id()); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for Phone Number (%s) update: %s", d.Id(), err)
 	}
 
@@ -261,7 +262,7 @@ func resourcePhoneNumberDelete(ctx context.Context, d *schema.ResourceData, meta
 		return sdkdiag.AppendErrorf(diags, "deleting PhoneNumber (%s): %s", d.Id(), err)
 	}
 
-	if _, err := waitPhoneNumberDeleted(ctx, conn, d.Timeout(schema.TimeoutCreate), phoneNumberId); err != nil {
+	if _, err := waitPhoneNumberDeleted(ctx, conn, sdkv2.ResourceTimeout(ctx, d, schema.TimeoutCreate), phoneNumberId); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for Phone Number (%s) deletion: %s", phoneNumberId, err)
 	}
 
@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 )
 
 // @SDKResource("aws_dx_bgp_peer")
@@ -125,7 +126,7 @@ func resourceBGPPeerCreate(ctx context.Context, d *schema.ResourceData, meta int
 			directconnect.BGPPeerStateVerifying,
 		},
 		Refresh:    bgpPeerStateRefresh(ctx, conn, vifId, addrFamily, asn),
-		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Timeout:    sdkv2.ResourceTimeout(ctx, d, schema.TimeoutDelete),
 		Delay:      10 * time.Second,
 		MinTimeout: 5 * time.Second,
 	}
@@ -199,7 +200,7 @@ func resourceBGPPeerDelete(ctx context.Context, d *schema.ResourceData, meta int
 			directconnect.BGPPeerStateDeleted,
 		},
 		Refresh:    bgpPeerStateRefresh(ctx, conn, vifId, addrFamily, asn),
-		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Timeout:    sdkv2.ResourceTimeout(ctx, d, schema.TimeoutDelete),
 		Delay:      10 * time.Second,
 		MinTimeout: 5 * time.Second,
 	}
@@ -22,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
@@ -184,7 +185,7 @@ func resourceRouteTableCreate(ctx context.Context, d *schema.ResourceData, meta
 
 	d.SetId(aws.ToString(output.RouteTable.RouteTableId))
 
-	if _, err := waitRouteTableReady(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+	if _, err := waitRouteTableReady(ctx, conn, d.Id(), sdkv2.ResourceTimeout(ctx, d, schema.TimeoutCreate)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for Route Table (%s) create: %s", d.Id(), err)
 	}
 
@@ -192,7 +193,7 @@ func resourceRouteTableCreate(ctx context.Context, d *schema.ResourceData, meta
 		for _, v := range v.(*schema.Set).List() {
 			v := v.(string)
 
-			if err := routeTableEnableVGWRoutePropagation(ctx, conn, d.Id(), v, d.Timeout(schema.TimeoutCreate)); err != nil {
+			if err := routeTableEnableVGWRoutePropagation(ctx, conn, d.Id(), v, sdkv2.ResourceTimeout(ctx, d, schema.TimeoutCreate)); err != nil {
 				return sdkdiag.AppendFromErr(diags, err)
 			}
 		}
@@ -202,7 +203,7 @@ func resourceRouteTableCreate(ctx context.Context, d *schema.ResourceData, meta
 		for _, v := range v.(*schema.Set).List() {
 			v := v.(map[string]interface{})
 
-			if err := routeTableAddRoute(ctx, conn, d.Id(), v, d.Timeout(schema.TimeoutCreate)); err != nil {
+			if err := routeTableAddRoute(ctx, conn, d.Id(), v, sdkv2.ResourceTimeout(ctx, d, schema.TimeoutCreate)); err != nil {
 				return sdkdiag.AppendFromErr(diags, err)
 			}
 		}
@@ -280,7 +281,7 @@ func resourceRouteTableUpdate(ctx context.Context, d *schema.ResourceData, meta
 		for _, v := range add {
 			v := v.(string)
 
-			if err := routeTableEnableVGWRoutePropagation(ctx, conn, d.Id(), v, d.Timeout(schema.TimeoutCreate)); err != nil {
+			if err := routeTableEnableVGWRoutePropagation(ctx, conn, d.Id(), v, sdkv2.ResourceTimeout(ctx, d, schema.TimeoutCreate)); err != nil {
 				return sdkdiag.AppendFromErr(diags, err)
 			}
 		}
@@ -307,7 +308,7 @@ func resourceRouteTableUpdate(ctx context.Context, d *schema.ResourceData, meta
 					addRoute = false
 
 					if oldTarget != newTarget {
-						if err := routeTableUpdateRoute(ctx, conn, d.Id(), vNew, d.Timeout(schema.TimeoutUpdate)); err != nil {
+						if err := routeTableUpdateRoute(ctx, conn, d.Id(), vNew, sdkv2.ResourceTimeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 							return sdkdiag.AppendFromErr(diags, err)
 						}
 					}
@@ -315,7 +316,7 @@ func resourceRouteTableUpdate(ctx context.Context, d *schema.ResourceData, meta
 			}
 
 			if addRoute {
-				if err := routeTableAddRoute(ctx, conn, d.Id(), vNew, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				if err := routeTableAddRoute(ctx, conn, d.Id(), vNew, sdkv2.ResourceTimeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 					return sdkdiag.AppendFromErr(diags, err)
 				}
 			}
@@ -339,7 +340,7 @@ func resourceRouteTableUpdate(ctx context.Context, d *schema.ResourceData, meta
 			}
 
 			if delRoute {
-				if err := routeTableDeleteRoute(ctx, conn, d.Id(), vOld, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				if err := routeTableDeleteRoute(ctx, conn, d.Id(), vOld, sdkv2.ResourceTimeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 					return sdkdiag.AppendFromErr(diags, err)
 				}
 			}
@@ -367,7 +368,7 @@ func resourceRouteTableDelete(ctx context.Context, d *schema.ResourceData, meta
 	for _, v := range routeTable.Associations {
 		v := aws.ToString(v.RouteTableAssociationId)
 
-		if err := routeTableAssociationDelete(ctx, conn, v, d.Timeout(schema.TimeoutDelete)); err != nil {
+		if err := routeTableAssociationDelete(ctx, conn, v, sdkv2.ResourceTimeout(ctx, d, schema.TimeoutDelete)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "deleting Route Table (%s): %s", d.Id(), err)
 		}
 	}
@@ -385,7 +386,7 @@ func resourceRouteTableDelete(ctx context.Context, d *schema.ResourceData, meta
 		return sdkdiag.AppendErrorf(diags, "deleting Route Table (%s): %s", d.Id(), err)
 	}
 
-	if _, err := waitRouteTableDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+	if _, err := waitRouteTableDeleted(ctx, conn, d.Id(), sdkv2.ResourceTimeout(ctx, d, schema.TimeoutDelete)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for Route Table (%s) delete: %s", d.Id(), err)
 	}
 
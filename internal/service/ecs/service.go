@@ -28,6 +28,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -738,7 +739,7 @@ func resourceServiceCreate(ctx context.Context, d *schema.ResourceData, meta int
 	if d.Get("wait_for_steady_state").(bool) {
 		fn = waitServiceStable
 	}
-	if _, err := fn(ctx, conn, d.Id(), d.Get("cluster").(string), d.Timeout(schema.TimeoutCreate)); err != nil {
+	if _, err := fn(ctx, conn, d.Id(), d.Get("cluster").(string), sdkv2.ResourceTimeout(ctx, d, schema.TimeoutCreate)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for ECS Service (%s) create: %s", d.Id(), err)
 	}
 
@@ -1065,7 +1066,7 @@ func resourceServiceUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		if d.Get("wait_for_steady_state").(bool) {
 			fn = waitServiceStable
 		}
-		if _, err := fn(ctx, conn, d.Id(), d.Get("cluster").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		if _, err := fn(ctx, conn, d.Id(), d.Get("cluster").(string), sdkv2.ResourceTimeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for ECS Service (%s) update: %s", d.Id(), err)
 		}
 	}
@@ -1109,7 +1110,7 @@ func resourceServiceDelete(ctx context.Context, d *schema.ResourceData, meta int
 		Cluster: aws.String(d.Get("cluster").(string)),
 	}
 	log.Printf("[DEBUG] Deleting ECS Service: %s", d.Id())
-	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *retry.RetryError {
+	err = retry.RetryContext(ctx, sdkv2.ResourceTimeout(ctx, d, schema.TimeoutDelete), func() *retry.RetryError {
 		_, err := conn.DeleteServiceWithContext(ctx, &input)
 
 		if err != nil {
@@ -1135,7 +1136,7 @@ func resourceServiceDelete(ctx context.Context, d *schema.ResourceData, meta int
 		return sdkdiag.AppendErrorf(diags, "deleting ECS Service (%s): %s", d.Id(), err)
 	}
 
-	if err := waitServiceInactive(ctx, conn, d.Id(), d.Get("cluster").(string), d.Timeout(schema.TimeoutDelete)); err != nil {
+	if err := waitServiceInactive(ctx, conn, d.Id(), d.Get("cluster").(string), sdkv2.ResourceTimeout(ctx, d, schema.TimeoutDelete)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for ECS Service (%s) delete: %s", d.Id(), err)
 	}
 
@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -384,7 +385,7 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 
 	d.SetId(aws.ToString(outputRaw.(*eks.CreateClusterOutput).Cluster.Name))
 
-	if _, err := waitClusterCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+	if _, err := waitClusterCreated(ctx, conn, d.Id(), sdkv2.ResourceTimeout(ctx, d, schema.TimeoutCreate)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for EKS Cluster (%s) create: %s", d.Id(), err)
 	}
 
@@ -476,7 +477,7 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 
 		updateID := aws.ToString(output.Update.Id)
 
-		if _, err := waitClusterUpdateSuccessful(ctx, conn, d.Id(), updateID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		if _, err := waitClusterUpdateSuccessful(ctx, conn, d.Id(), updateID, sdkv2.ResourceTimeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for EKS Cluster (%s) version update (%s): %s", d.Id(), updateID, err)
 		}
 	}
@@ -496,7 +497,7 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 
 			updateID := aws.ToString(output.Update.Id)
 
-			_, err = waitClusterUpdateSuccessful(ctx, conn, d.Id(), updateID, d.Timeout(schema.TimeoutUpdate))
+			_, err = waitClusterUpdateSuccessful(ctx, conn, d.Id(), updateID, sdkv2.ResourceTimeout(ctx, d, schema.TimeoutUpdate))
 
 			if err != nil {
 				return sdkdiag.AppendErrorf(diags, "waiting for EKS Cluster (%s) access configuration update (%s): %s", d.Id(), updateID, err)
@@ -521,7 +522,7 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 
 			updateID := aws.ToString(output.Update.Id)
 
-			if _, err := waitClusterUpdateSuccessful(ctx, conn, d.Id(), updateID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			if _, err := waitClusterUpdateSuccessful(ctx, conn, d.Id(), updateID, sdkv2.ResourceTimeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 				return sdkdiag.AppendErrorf(diags, "waiting for EKS Cluster (%s) encryption config association (%s): %s", d.Id(), updateID, err)
 			}
 		}
@@ -541,7 +542,7 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 
 		updateID := aws.ToString(output.Update.Id)
 
-		if _, err := waitClusterUpdateSuccessful(ctx, conn, d.Id(), updateID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		if _, err := waitClusterUpdateSuccessful(ctx, conn, d.Id(), updateID, sdkv2.ResourceTimeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for EKS Cluster (%s) logging update (%s): %s", d.Id(), updateID, err)
 		}
 	}
@@ -556,7 +557,7 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			config.PublicAccessCidrs = flex.ExpandStringValueSet(v.(*schema.Set))
 		}
 
-		if err := updateVPCConfig(ctx, conn, d.Id(), config, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		if err := updateVPCConfig(ctx, conn, d.Id(), config, sdkv2.ResourceTimeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
 		}
 	}
@@ -567,7 +568,7 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			SubnetIds: flex.ExpandStringValueSet(d.Get("vpc_config.0.subnet_ids").(*schema.Set)),
 		}
 
-		if err := updateVPCConfig(ctx, conn, d.Id(), config, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		if err := updateVPCConfig(ctx, conn, d.Id(), config, sdkv2.ResourceTimeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
 		}
 	}
@@ -577,7 +578,7 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			SecurityGroupIds: flex.ExpandStringValueSet(d.Get("vpc_config.0.security_group_ids").(*schema.Set)),
 		}
 
-		if err := updateVPCConfig(ctx, conn, d.Id(), config, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		if err := updateVPCConfig(ctx, conn, d.Id(), config, sdkv2.ResourceTimeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
 		}
 	}
@@ -634,7 +635,7 @@ func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, meta int
 		return sdkdiag.AppendErrorf(diags, "deleting EKS Cluster (%s): %s", d.Id(), err)
 	}
 
-	if _, err := waitClusterDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+	if _, err := waitClusterDeleted(ctx, conn, d.Id(), sdkv2.ResourceTimeout(ctx, d, schema.TimeoutDelete)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for EKS Cluster (%s) delete: %s", d.Id(), err)
 	}
 
@@ -715,91 +716,49 @@ func findClusterUpdateByTwoPartKey(ctx context.Context, conn *eks.Client, name,
 	return output.Update, nil
 }
 
-func statusCluster(ctx context.Context, conn *eks.Client, name string) retry.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		output, err := findClusterByName(ctx, conn, name)
-
-		if tfresource.NotFound(err) {
-			return nil, "", nil
-		}
-
-		if err != nil {
-			return nil, "", err
-		}
-
-		return output, string(output.Status), nil
-	}
-}
-
-func statusClusterUpdate(ctx context.Context, conn *eks.Client, name, id string) retry.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		output, err := findClusterUpdateByTwoPartKey(ctx, conn, name, id)
-
-		if tfresource.NotFound(err) {
-			return nil, "", nil
-		}
-
-		if err != nil {
-			return nil, "", err
-		}
-
-		return output, string(output.Status), nil
+func newClusterWaiter(conn *eks.Client, name string, timeout time.Duration) tfresource.StateWaiter[*types.Cluster] {
+	return tfresource.StateWaiter[*types.Cluster]{
+		Find: func(ctx context.Context) (*types.Cluster, error) {
+			return findClusterByName(ctx, conn, name)
+		},
+		Status: func(v *types.Cluster) string {
+			return string(v.Status)
+		},
+		Timeout: timeout,
 	}
 }
 
 func waitClusterCreated(ctx context.Context, conn *eks.Client, name string, timeout time.Duration) (*types.Cluster, error) {
-	stateConf := &retry.StateChangeConf{
-		Pending: enum.Slice(types.ClusterStatusPending, types.ClusterStatusCreating),
-		Target:  enum.Slice(types.ClusterStatusActive),
-		Refresh: statusCluster(ctx, conn, name),
-		Timeout: timeout,
-	}
-
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
-
-	if output, ok := outputRaw.(*types.Cluster); ok {
-		return output, err
-	}
+	waiter := newClusterWaiter(conn, name, timeout)
+	waiter.Pending = enum.Slice(types.ClusterStatusPending, types.ClusterStatusCreating)
+	waiter.Target = enum.Slice(types.ClusterStatusActive)
 
-	return nil, err
+	return waiter.Wait(ctx)
 }
 
 func waitClusterDeleted(ctx context.Context, conn *eks.Client, name string, timeout time.Duration) (*types.Cluster, error) {
-	stateConf := &retry.StateChangeConf{
-		Pending: enum.Slice(types.ClusterStatusActive, types.ClusterStatusDeleting),
-		Target:  []string{},
-		Refresh: statusCluster(ctx, conn, name),
-		Timeout: timeout,
-	}
+	waiter := newClusterWaiter(conn, name, timeout)
+	waiter.Pending = enum.Slice(types.ClusterStatusActive, types.ClusterStatusDeleting)
+	waiter.Target = []string{}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
-
-	if output, ok := outputRaw.(*types.Cluster); ok {
-		return output, err
-	}
-
-	return nil, err
+	return waiter.Wait(ctx)
 }
 
 func waitClusterUpdateSuccessful(ctx context.Context, conn *eks.Client, name, id string, timeout time.Duration) (*types.Update, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
+	waiter := tfresource.StateWaiter[*types.Update]{
 		Pending: enum.Slice(types.UpdateStatusInProgress),
 		Target:  enum.Slice(types.UpdateStatusSuccessful),
-		Refresh: statusClusterUpdate(ctx, conn, name, id),
+		Find: func(ctx context.Context) (*types.Update, error) {
+			return findClusterUpdateByTwoPartKey(ctx, conn, name, id)
+		},
+		Status: func(v *types.Update) string {
+			return string(v.Status)
+		},
+		Error:   updateError,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
-
-	if output, ok := outputRaw.(*types.Update); ok {
-		if status := output.Status; status == types.UpdateStatusCancelled || status == types.UpdateStatusFailed {
-			tfresource.SetLastError(err, errorDetailsError(output.Errors))
-		}
-
-		return output, err
-	}
-
-	return nil, err
+	return waiter.Wait(ctx)
 }
 
 func expandCreateAccessConfigRequest(tfList []interface{}) *types.CreateAccessConfigRequest {
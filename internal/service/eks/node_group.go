@@ -26,6 +26,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -373,7 +374,7 @@ func resourceNodeGroupCreate(ctx context.Context, d *schema.ResourceData, meta i
 
 	d.SetId(groupID)
 
-	if _, err := waitNodegroupCreated(ctx, conn, clusterName, nodeGroupName, d.Timeout(schema.TimeoutCreate)); err != nil {
+	if _, err := waitNodegroupCreated(ctx, conn, clusterName, nodeGroupName, sdkv2.ResourceTimeout(ctx, d, schema.TimeoutCreate)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for EKS Node Group (%s) create: %s", d.Id(), err)
 	}
 
@@ -503,7 +504,7 @@ func resourceNodeGroupUpdate(ctx context.Context, d *schema.ResourceData, meta i
 
 		updateID := aws.ToString(output.Update.Id)
 
-		if _, err := waitNodegroupUpdateSuccessful(ctx, conn, clusterName, nodeGroupName, updateID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		if _, err := waitNodegroupUpdateSuccessful(ctx, conn, clusterName, nodeGroupName, updateID, sdkv2.ResourceTimeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for EKS Node Group (%s) version update (%s): %s", d.Id(), updateID, err)
 		}
 	}
@@ -540,7 +541,7 @@ func resourceNodeGroupUpdate(ctx context.Context, d *schema.ResourceData, meta i
 
 		updateID := aws.ToString(output.Update.Id)
 
-		if _, err := waitNodegroupUpdateSuccessful(ctx, conn, clusterName, nodeGroupName, updateID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		if _, err := waitNodegroupUpdateSuccessful(ctx, conn, clusterName, nodeGroupName, updateID, sdkv2.ResourceTimeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for EKS Node Group (%s) config update (%s): %s", d.Id(), updateID, err)
 		}
 	}
@@ -572,7 +573,7 @@ func resourceNodeGroupDelete(ctx context.Context, d *schema.ResourceData, meta i
 		return sdkdiag.AppendErrorf(diags, "deleting EKS Node Group (%s): %s", d.Id(), err)
 	}
 
-	if _, err := waitNodegroupDeleted(ctx, conn, clusterName, nodeGroupName, d.Timeout(schema.TimeoutDelete)); err != nil {
+	if _, err := waitNodegroupDeleted(ctx, conn, clusterName, nodeGroupName, sdkv2.ResourceTimeout(ctx, d, schema.TimeoutDelete)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for EKS Node Group (%s) delete: %s", d.Id(), err)
 	}
 
@@ -632,99 +633,63 @@ func findNodegroupUpdateByThreePartKey(ctx context.Context, conn *eks.Client, cl
 	return output.Update, nil
 }
 
-func statusNodegroup(ctx context.Context, conn *eks.Client, clusterName, nodeGroupName string) retry.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		output, err := findNodegroupByTwoPartKey(ctx, conn, clusterName, nodeGroupName)
-
-		if tfresource.NotFound(err) {
-			return nil, "", nil
-		}
-
-		if err != nil {
-			return nil, "", err
-		}
-
-		return output, string(output.Status), nil
-	}
-}
-
-func statusNodegroupUpdate(ctx context.Context, conn *eks.Client, clusterName, nodeGroupName, id string) retry.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		output, err := findNodegroupUpdateByThreePartKey(ctx, conn, clusterName, nodeGroupName, id)
-
-		if tfresource.NotFound(err) {
-			return nil, "", nil
-		}
-
-		if err != nil {
-			return nil, "", err
-		}
-
-		return output, string(output.Status), nil
+func newNodegroupWaiter(conn *eks.Client, clusterName, nodeGroupName string, timeout time.Duration) tfresource.StateWaiter[*types.Nodegroup] {
+	return tfresource.StateWaiter[*types.Nodegroup]{
+		Find: func(ctx context.Context) (*types.Nodegroup, error) {
+			return findNodegroupByTwoPartKey(ctx, conn, clusterName, nodeGroupName)
+		},
+		Status: func(v *types.Nodegroup) string {
+			return string(v.Status)
+		},
+		Timeout: timeout,
 	}
 }
 
 func waitNodegroupCreated(ctx context.Context, conn *eks.Client, clusterName, nodeGroupName string, timeout time.Duration) (*types.Nodegroup, error) {
-	stateConf := &retry.StateChangeConf{
-		Pending: enum.Slice(types.NodegroupStatusCreating),
-		Target:  enum.Slice(types.NodegroupStatusActive),
-		Refresh: statusNodegroup(ctx, conn, clusterName, nodeGroupName),
-		Timeout: timeout,
-	}
-
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
-
-	if output, ok := outputRaw.(*types.Nodegroup); ok {
-		if status, health := output.Status, output.Health; status == types.NodegroupStatusCreateFailed && health != nil {
-			tfresource.SetLastError(err, issuesError(health.Issues))
+	waiter := newNodegroupWaiter(conn, clusterName, nodeGroupName, timeout)
+	waiter.Pending = enum.Slice(types.NodegroupStatusCreating)
+	waiter.Target = enum.Slice(types.NodegroupStatusActive)
+	waiter.Error = func(v *types.Nodegroup) error {
+		if status, health := v.Status, v.Health; status == types.NodegroupStatusCreateFailed && health != nil {
+			return issuesError(health.Issues)
 		}
 
-		return output, err
+		return nil
 	}
 
-	return nil, err
+	return waiter.Wait(ctx)
 }
 
 func waitNodegroupDeleted(ctx context.Context, conn *eks.Client, clusterName, nodeGroupName string, timeout time.Duration) (*types.Nodegroup, error) {
-	stateConf := &retry.StateChangeConf{
-		Pending: enum.Slice(types.NodegroupStatusActive, types.NodegroupStatusDeleting),
-		Target:  []string{},
-		Refresh: statusNodegroup(ctx, conn, clusterName, nodeGroupName),
-		Timeout: timeout,
-	}
-
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
-
-	if output, ok := outputRaw.(*types.Nodegroup); ok {
-		if status, health := output.Status, output.Health; status == types.NodegroupStatusDeleteFailed && health != nil {
-			tfresource.SetLastError(err, issuesError(health.Issues))
+	waiter := newNodegroupWaiter(conn, clusterName, nodeGroupName, timeout)
+	waiter.Pending = enum.Slice(types.NodegroupStatusActive, types.NodegroupStatusDeleting)
+	waiter.Target = []string{}
+	waiter.Error = func(v *types.Nodegroup) error {
+		if status, health := v.Status, v.Health; status == types.NodegroupStatusDeleteFailed && health != nil {
+			return issuesError(health.Issues)
 		}
 
-		return output, err
+		return nil
 	}
 
-	return nil, err
+	return waiter.Wait(ctx)
 }
 
 func waitNodegroupUpdateSuccessful(ctx context.Context, conn *eks.Client, clusterName, nodeGroupName, id string, timeout time.Duration) (*types.Update, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
+	waiter := tfresource.StateWaiter[*types.Update]{
 		Pending: enum.Slice(types.UpdateStatusInProgress),
 		Target:  enum.Slice(types.UpdateStatusSuccessful),
-		Refresh: statusNodegroupUpdate(ctx, conn, clusterName, nodeGroupName, id),
+		Find: func(ctx context.Context) (*types.Update, error) {
+			return findNodegroupUpdateByThreePartKey(ctx, conn, clusterName, nodeGroupName, id)
+		},
+		Status: func(v *types.Update) string {
+			return string(v.Status)
+		},
+		Error:   updateError,
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
-
-	if output, ok := outputRaw.(*types.Update); ok {
-		if status := output.Status; status == types.UpdateStatusCancelled || status == types.UpdateStatusFailed {
-			tfresource.SetLastError(err, errorDetailsError(output.Errors))
-		}
-
-		return output, err
-	}
-
-	return nil, err
+	return waiter.Wait(ctx)
 }
 
 func issueError(apiObject types.Issue) error {
@@ -0,0 +1,321 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/eventpattern"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2/types/nullable"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_cloudwatch_event_pattern_document", name="Event Pattern Document")
+func dataSourceEventPatternDocument() *schema.Resource {
+	fieldSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"anything_but": {
+						Type:     schema.TypeList,
+						Optional: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+					"anything_but_prefix": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"anything_but_suffix": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"cidr": {
+						Type:     schema.TypeList,
+						Optional: true,
+						Elem: &schema.Schema{
+							Type:         schema.TypeString,
+							ValidateFunc: verify.ValidCIDRNetworkAddress,
+						},
+					},
+					"equals_ignore_case": {
+						Type:     schema.TypeList,
+						Optional: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+					"exists": {
+						Type:         nullable.TypeNullableBool,
+						Optional:     true,
+						ValidateFunc: nullable.ValidateTypeStringNullableBool,
+					},
+					"numeric": {
+						Type:     schema.TypeList,
+						Optional: true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"equals": {
+									Type:         nullable.TypeNullableFloat,
+									Optional:     true,
+									ValidateFunc: nullable.ValidateTypeStringNullableFloat,
+								},
+								"greater_than": {
+									Type:         nullable.TypeNullableFloat,
+									Optional:     true,
+									ValidateFunc: nullable.ValidateTypeStringNullableFloat,
+								},
+								"greater_than_or_equal": {
+									Type:         nullable.TypeNullableFloat,
+									Optional:     true,
+									ValidateFunc: nullable.ValidateTypeStringNullableFloat,
+								},
+								"less_than": {
+									Type:         nullable.TypeNullableFloat,
+									Optional:     true,
+									ValidateFunc: nullable.ValidateTypeStringNullableFloat,
+								},
+								"less_than_or_equal": {
+									Type:         nullable.TypeNullableFloat,
+									Optional:     true,
+									ValidateFunc: nullable.ValidateTypeStringNullableFloat,
+								},
+							},
+						},
+					},
+					names.AttrPath: {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					names.AttrPrefix: {
+						Type:     schema.TypeList,
+						Optional: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+					"suffix": {
+						Type:     schema.TypeList,
+						Optional: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+					names.AttrValues: {
+						Type:     schema.TypeList,
+						Optional: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+					"wildcard": {
+						Type:     schema.TypeList,
+						Optional: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceEventPatternDocumentRead,
+
+		Schema: map[string]*schema.Schema{
+			"field": fieldSchema(),
+			names.AttrJSON: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"or": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"field": func() *schema.Schema {
+							s := fieldSchema()
+							s.Optional = false
+							s.Required = true
+							s.MinItems = 1
+							return s
+						}(),
+					},
+				},
+			},
+			"source_json": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+		},
+	}
+}
+
+func dataSourceEventPatternDocumentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	pattern := make(map[string]any)
+
+	if v, ok := d.GetOk("source_json"); ok {
+		decoder := json.NewDecoder(strings.NewReader(v.(string)))
+		decoder.UseNumber()
+		if err := decoder.Decode(&pattern); err != nil {
+			return sdkdiag.AppendErrorf(diags, "decoding source_json: %s", err)
+		}
+	}
+
+	if err := expandEventPatternFields(pattern, d.Get("field").([]interface{})); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if v := d.Get("or").([]interface{}); len(v) > 0 {
+		alternatives, _ := pattern[eventpattern.KeyOr].([]any)
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			alternative := make(map[string]any)
+			if err := expandEventPatternFields(alternative, tfMap["field"].([]interface{})); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+			alternatives = append(alternatives, alternative)
+		}
+		pattern[eventpattern.KeyOr] = alternatives
+	}
+
+	jsonDoc, err := json.Marshal(pattern)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+	jsonString := string(jsonDoc)
+
+	if _, err := eventpattern.Parse(jsonString); err != nil {
+		return sdkdiag.AppendErrorf(diags, "event pattern is not valid: %s", err)
+	}
+
+	d.Set(names.AttrJSON, jsonString)
+
+	d.SetId(strconv.Itoa(schema.HashString(jsonString)))
+
+	return diags
+}
+
+// expandEventPatternFields adds the matchers of each field to the pattern.
+// Fields are identified by dot-separated paths and matchers for the same field are combined.
+func expandEventPatternFields(pattern map[string]any, tfList []interface{}) error {
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		path := tfMap[names.AttrPath].(string)
+		matchers, err := expandEventPatternMatchers(tfMap)
+		if err != nil {
+			return fmt.Errorf("field %q: %w", path, err)
+		}
+		if len(matchers) == 0 {
+			return fmt.Errorf("field %q: at least one matcher must be specified", path)
+		}
+
+		keys := strings.Split(path, ".")
+		m := pattern
+		for _, key := range keys[:len(keys)-1] {
+			switch v := m[key].(type) {
+			case nil:
+				child := make(map[string]any)
+				m[key] = child
+				m = child
+			case map[string]any:
+				m = v
+			default:
+				return fmt.Errorf("field %q: %q is already a matched field", path, key)
+			}
+		}
+
+		key := keys[len(keys)-1]
+		switch v := m[key].(type) {
+		case nil:
+			m[key] = matchers
+		case []any:
+			m[key] = append(v, matchers...)
+		default:
+			return fmt.Errorf("field %q: already contains nested fields", path)
+		}
+	}
+
+	return nil
+}
+
+func expandEventPatternMatchers(tfMap map[string]interface{}) ([]any, error) {
+	var matchers []any
+
+	for _, v := range flex.ExpandStringValueList(tfMap[names.AttrValues].([]interface{})) {
+		matchers = append(matchers, v)
+	}
+
+	for _, v := range []struct {
+		key     string
+		matcher string
+	}{
+		{"cidr", eventpattern.MatcherCIDR},
+		{"equals_ignore_case", eventpattern.MatcherEqualsIgnoreCase},
+		{names.AttrPrefix, eventpattern.MatcherPrefix},
+		{"suffix", eventpattern.MatcherSuffix},
+		{"wildcard", eventpattern.MatcherWildcard},
+	} {
+		for _, s := range flex.ExpandStringValueList(tfMap[v.key].([]interface{})) {
+			matchers = append(matchers, map[string]any{v.matcher: s})
+		}
+	}
+
+	if v := flex.ExpandStringValueList(tfMap["anything_but"].([]interface{})); len(v) > 0 {
+		matchers = append(matchers, map[string]any{eventpattern.MatcherAnythingBut: v})
+	}
+	if v := tfMap["anything_but_prefix"].(string); v != "" {
+		matchers = append(matchers, map[string]any{eventpattern.MatcherAnythingBut: map[string]any{eventpattern.MatcherPrefix: v}})
+	}
+	if v := tfMap["anything_but_suffix"].(string); v != "" {
+		matchers = append(matchers, map[string]any{eventpattern.MatcherAnythingBut: map[string]any{eventpattern.MatcherSuffix: v}})
+	}
+
+	if v, null, _ := nullable.Bool(tfMap["exists"].(string)).ValueBool(); !null {
+		matchers = append(matchers, map[string]any{eventpattern.MatcherExists: v})
+	}
+
+	for _, tfMapRaw := range tfMap["numeric"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		var conditions []any
+		for _, v := range []struct {
+			key      string
+			operator string
+		}{
+			{"equals", eventpattern.NumericOperatorEquals},
+			{"greater_than", eventpattern.NumericOperatorGreaterThan},
+			{"greater_than_or_equal", eventpattern.NumericOperatorGreaterThanOrEqual},
+			{"less_than", eventpattern.NumericOperatorLessThan},
+			{"less_than_or_equal", eventpattern.NumericOperatorLessThanOrEqual},
+		} {
+			if s := tfMap[v.key].(string); s != "" {
+				conditions = append(conditions, v.operator, json.Number(s))
+			}
+		}
+		if len(conditions) == 0 {
+			return nil, fmt.Errorf("numeric: at least one comparison must be specified")
+		}
+
+		matchers = append(matchers, map[string]any{eventpattern.MatcherNumeric: conditions})
+	}
+
+	return matchers, nil
+}
@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package events_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEventsEventPatternDocumentDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_cloudwatch_event_pattern_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EventsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEventPatternDocumentDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, names.AttrJSON, `{
+  "source": ["aws.ec2"],
+  "detail-type": [{"prefix": "EC2 Instance"}],
+  "detail": {
+    "state": [{"anything-but": ["pending", "running"]}],
+    "cpu-options": {"core-count": [{"numeric": [">", 0, "<=", 8]}]}
+  },
+  "$or": [
+    {"region": ["us-east-1"]},
+    {"account": [{"exists": true}]}
+  ]
+}`), //lintignore:AWSAT003
+				),
+			},
+		},
+	})
+}
+
+func TestAccEventsEventPatternDocumentDataSource_conflictingPaths(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EventsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccEventPatternDocumentDataSourceConfig_conflictingPaths,
+				ExpectError: regexache.MustCompile(`field "detail.state": "detail" is already a matched field`),
+			},
+		},
+	})
+}
+
+const testAccEventPatternDocumentDataSourceConfig_basic = `
+data "aws_cloudwatch_event_pattern_document" "test" {
+  field {
+    path   = "source"
+    values = ["aws.ec2"]
+  }
+
+  field {
+    path   = "detail-type"
+    prefix = ["EC2 Instance"]
+  }
+
+  field {
+    path         = "detail.state"
+    anything_but = ["pending", "running"]
+  }
+
+  field {
+    path = "detail.cpu-options.core-count"
+
+    numeric {
+      greater_than       = 0
+      less_than_or_equal = 8
+    }
+  }
+
+  or {
+    field {
+      path   = "region"
+      values = ["us-east-1"]
+    }
+  }
+
+  or {
+    field {
+      path   = "account"
+      exists = true
+    }
+  }
+}
+`
+
+const testAccEventPatternDocumentDataSourceConfig_conflictingPaths = `
+data "aws_cloudwatch_event_pattern_document" "test" {
+  field {
+    path   = "detail"
+    exists = true
+  }
+
+  field {
+    path   = "detail.state"
+    values = ["running"]
+  }
+}
+`
@@ -0,0 +1,290 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/iampolicy"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_iam_policy_evaluation", name="Policy Evaluation")
+func dataSourcePolicyEvaluation() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourcePolicyEvaluationRead,
+
+		Schema: map[string]*schema.Schema{
+			// Arguments
+			"action_names": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: `One or more names of actions, like "s3:GetObject", that should be evaluated.`,
+			},
+			"context": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrKey: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: `The key name of the context entry, such as "aws:SourceIp".`,
+						},
+						names.AttrValues: {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Description: `One or more values to assign to the context key.`,
+						},
+					},
+				},
+				Description: `Each block specifies one request context entry. These are the properties used in the 'Condition' element of an IAM policy, and in policy variables.`,
+			},
+			"identity_policies_json": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsJSON,
+				},
+				Description: `Identity-based policies attached to the calling principal.`,
+			},
+			"permissions_boundary_policies_json": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsJSON,
+				},
+				Description: `Permissions boundary policies of the calling principal.`,
+			},
+			"principal_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+				Description:  `ARN of the calling principal, matched against the Principal element of the resource policy. Sets the "aws:PrincipalArn" and "aws:PrincipalAccount" context keys unless they are specified in a context block.`,
+			},
+			"resource_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: `ARNs of specific resources to use as the targets of the specified actions. If not specified, "*" is used.`,
+			},
+			"resource_policy_json": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsJSON,
+				Description:  `A resource policy to associate with all of the target resources.`,
+			},
+			"service_control_policies_json": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsJSON,
+				},
+				Description: `Service control policies, one per level of the organization hierarchy. Every level must allow a request.`,
+			},
+
+			// Result Attributes
+			"all_allowed": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: `A summary of the results attribute which is true if all of the results have decision "allowed", and false otherwise.`,
+			},
+			"results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The name of the action whose evaluation this result is describing.`,
+						},
+						"allowed": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: `A summary of attribute "decision" which is true only if the decision is "allowed".`,
+						},
+						"decision": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The decision keyword: "allowed", "explicitDeny", or "implicitDeny".`,
+						},
+						"matched_statements": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"effect": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `Effect of the statement.`,
+									},
+									"sid": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `Statement ID of the statement, if any.`,
+									},
+									"source_policy_index": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: `Index of the policy within the input policies of its type.`,
+									},
+									"source_policy_type": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: `The type of the policy: "identity", "resource", "permissions_boundary" or "service_control".`,
+									},
+								},
+							},
+							Description: `Detail about which specific statements determined this result.`,
+						},
+						"missing_context_keys": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Description: `Context keys that were referenced by the policies but not included in the request.`,
+						},
+						names.AttrResourceARN: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `ARN of the resource that the action was evaluated against.`,
+						},
+					},
+				},
+			},
+			names.AttrID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Do not use`,
+			},
+		},
+	}
+}
+
+func dataSourcePolicyEvaluationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	parsePolicies := func(key string) ([]*iampolicy.Document, error) {
+		var docs []*iampolicy.Document
+
+		for _, v := range flex.ExpandStringValueList(d.Get(key).([]interface{})) {
+			doc, err := iampolicy.Parse(v)
+			if err != nil {
+				return nil, err
+			}
+			docs = append(docs, doc)
+		}
+
+		return docs, nil
+	}
+
+	var policies iampolicy.Policies
+	var err error
+
+	if policies.Identity, err = parsePolicies("identity_policies_json"); err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading identity_policies_json: %s", err)
+	}
+	if policies.PermissionsBoundary, err = parsePolicies("permissions_boundary_policies_json"); err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading permissions_boundary_policies_json: %s", err)
+	}
+	if policies.ServiceControl, err = parsePolicies("service_control_policies_json"); err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading service_control_policies_json: %s", err)
+	}
+	if v := d.Get("resource_policy_json").(string); v != "" {
+		if policies.Resource, err = iampolicy.Parse(v); err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading resource_policy_json: %s", err)
+		}
+	}
+
+	requestContext := make(map[string][]string)
+	for _, entryRaw := range d.Get("context").(*schema.Set).List() {
+		entryRaw := entryRaw.(map[string]interface{})
+		requestContext[strings.ToLower(entryRaw[names.AttrKey].(string))] = flex.ExpandStringValueSet(entryRaw[names.AttrValues].(*schema.Set))
+	}
+	if v := d.Get("principal_arn").(string); v != "" {
+		if key := strings.ToLower(iampolicy.ContextKeyPrincipalARN); requestContext[key] == nil {
+			requestContext[key] = []string{v}
+		}
+	}
+
+	actionNames := flex.ExpandStringValueSet(d.Get("action_names").(*schema.Set))
+	resourceARNs := flex.ExpandStringValueSet(d.Get("resource_arns").(*schema.Set))
+	if len(resourceARNs) == 0 {
+		resourceARNs = []string{"*"}
+	}
+	// Results are ordered by action name, then resource ARN.
+	slices.Sort(actionNames)
+	slices.Sort(resourceARNs)
+
+	// As for aws_iam_principal_policy_simulation, "all" are allowed only if
+	// there is at least one result and no results were denied.
+	allowedCount := 0
+	deniedCount := 0
+
+	rawResults := make([]interface{}, 0, len(actionNames)*len(resourceARNs))
+	for _, actionName := range actionNames {
+		for _, resourceARN := range resourceARNs {
+			result, err := iampolicy.Evaluate(policies, iampolicy.Request{
+				Action:   actionName,
+				Resource: resourceARN,
+				Context:  requestContext,
+			})
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "evaluating IAM policies for %s on %s: %s", actionName, resourceARN, err)
+			}
+
+			if result.Allowed() {
+				allowedCount++
+			} else {
+				deniedCount++
+			}
+
+			rawMatchedStmts := make([]interface{}, len(result.MatchedStatements))
+			for i, stmt := range result.MatchedStatements {
+				rawMatchedStmts[i] = map[string]interface{}{
+					"effect":              stmt.Effect,
+					"sid":                 stmt.Sid,
+					"source_policy_index": stmt.PolicyIndex,
+					"source_policy_type":  string(stmt.PolicyType),
+				}
+			}
+
+			rawResults = append(rawResults, map[string]interface{}{
+				"action_name":          actionName,
+				"allowed":              result.Allowed(),
+				"decision":             string(result.Decision),
+				"matched_statements":   rawMatchedStmts,
+				"missing_context_keys": result.MissingContextKeys,
+				names.AttrResourceARN:  resourceARN,
+			})
+		}
+	}
+	d.Set("results", rawResults)
+	d.Set("all_allowed", allowedCount > 0 && deniedCount == 0)
+
+	d.SetId("-")
+
+	return diags
+}
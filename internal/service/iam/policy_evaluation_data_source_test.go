@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIAMPolicyEvaluationDataSource_basic(t *testing.T) {
+	// The evaluation is offline, but instantiating the AWS provider
+	// requires valid AWS credentials.
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_iam_policy_evaluation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyEvaluationDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "all_allowed", acctest.CtFalse),
+					resource.TestCheckResourceAttr(dataSourceName, "results.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.action_name", "s3:GetObject"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.resource_arn", "arn:aws:s3:::test/key"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.allowed", acctest.CtTrue),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.decision", "allowed"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.matched_statements.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.matched_statements.0.source_policy_type", "service_control"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.matched_statements.1.source_policy_type", "identity"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.matched_statements.1.sid", "AllowRead"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.1.resource_arn", "arn:aws:s3:::test/secret/key"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.1.allowed", acctest.CtFalse),
+					resource.TestCheckResourceAttr(dataSourceName, "results.1.decision", "explicitDeny"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.1.matched_statements.0.sid", "DenySecret"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMPolicyEvaluationDataSource_resourcePolicy(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_iam_policy_evaluation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyEvaluationDataSourceConfig_resourcePolicy,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "all_allowed", acctest.CtTrue),
+					resource.TestCheckResourceAttr(dataSourceName, "results.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.decision", "allowed"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.matched_statements.0.source_policy_type", "resource"),
+					resource.TestCheckResourceAttr(dataSourceName, "results.0.missing_context_keys.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
+const testAccPolicyEvaluationDataSourceConfig_basic = `
+data "aws_iam_policy_evaluation" "test" {
+  action_names  = ["s3:GetObject"]
+  resource_arns = ["arn:aws:s3:::test/key", "arn:aws:s3:::test/secret/key"]
+
+  identity_policies_json = [jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Sid      = "AllowRead"
+        Effect   = "Allow"
+        Action   = "s3:Get*"
+        Resource = "arn:aws:s3:::test/*"
+      },
+    ]
+  })]
+
+  service_control_policies_json = [jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Effect   = "Allow"
+        Action   = "*"
+        Resource = "*"
+      },
+      {
+        Sid      = "DenySecret"
+        Effect   = "Deny"
+        Action   = "s3:*"
+        Resource = "arn:aws:s3:::*/secret/*"
+      },
+    ]
+  })]
+}
+`
+
+const testAccPolicyEvaluationDataSourceConfig_resourcePolicy = `
+data "aws_iam_policy_evaluation" "test" {
+  action_names  = ["s3:GetObject"]
+  resource_arns = ["arn:aws:s3:::test/key"]
+  principal_arn = "arn:aws:iam::123456789012:role/test"
+
+  resource_policy_json = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Effect    = "Allow"
+        Principal = { AWS = "arn:aws:iam::123456789012:root" }
+        Action    = "s3:GetObject"
+        Resource  = "arn:aws:s3:::test/*"
+        Condition = {
+          StringEquals = { "aws:PrincipalAccount" = "123456789012" }
+        }
+      },
+    ]
+  })
+}
+`
@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -172,7 +173,7 @@ func resourceLoggingConfigurationCreate(ctx context.Context, d *schema.ResourceD
 
 	d.SetId(aws.ToString(out.Arn))
 
-	if _, err := waitLoggingConfigurationCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+	if _, err := waitLoggingConfigurationCreated(ctx, conn, d.Id(), sdkv2.ResourceTimeout(ctx, d, schema.TimeoutCreate)); err != nil {
 		return create.AppendDiagError(diags, names.IVSChat, create.ErrActionWaitingForCreation, ResNameLoggingConfiguration, d.Id(), err)
 	}
 
@@ -239,7 +240,7 @@ func resourceLoggingConfigurationUpdate(ctx context.Context, d *schema.ResourceD
 		return create.AppendDiagError(diags, names.IVSChat, create.ErrActionUpdating, ResNameLoggingConfiguration, d.Id(), err)
 	}
 
-	if _, err := waitLoggingConfigurationUpdated(ctx, conn, aws.ToString(out.Arn), d.Timeout(schema.TimeoutUpdate)); err != nil {
+	if _, err := waitLoggingConfigurationUpdated(ctx, conn, aws.ToString(out.Arn), sdkv2.ResourceTimeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 		return create.AppendDiagError(diags, names.IVSChat, create.ErrActionWaitingForUpdate, ResNameLoggingConfiguration, d.Id(), err)
 	}
 
@@ -266,7 +267,7 @@ func resourceLoggingConfigurationDelete(ctx context.Context, d *schema.ResourceD
 		return create.AppendDiagError(diags, names.IVSChat, create.ErrActionDeleting, ResNameLoggingConfiguration, d.Id(), err)
 	}
 
-	if _, err := waitLoggingConfigurationDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+	if _, err := waitLoggingConfigurationDeleted(ctx, conn, d.Id(), sdkv2.ResourceTimeout(ctx, d, schema.TimeoutDelete)); err != nil {
 		return create.AppendDiagError(diags, names.IVSChat, create.ErrActionWaitingForDeletion, ResNameLoggingConfiguration, d.Id(), err)
 	}
 
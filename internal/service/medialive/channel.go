@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -788,12 +789,12 @@ func resourceChannelCreate(ctx context.Context, d *schema.ResourceData, meta int
 
 	d.SetId(aws.ToString(out.Channel.Id))
 
-	if _, err := waitChannelCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+	if _, err := waitChannelCreated(ctx, conn, d.Id(), sdkv2.ResourceTimeout(ctx, d, schema.TimeoutCreate)); err != nil {
 		return create.AppendDiagError(diags, names.MediaLive, create.ErrActionWaitingForCreation, ResNameChannel, d.Id(), err)
 	}
 
 	if d.Get("start_channel").(bool) {
-		if err := startChannel(ctx, conn, d.Timeout(schema.TimeoutCreate), d.Id()); err != nil {
+		if err := startChannel(ctx, conn, sdkv2.ResourceTimeout(ctx, d, schema.TimeoutCreate), d.Id()); err != nil {
 			return create.AppendDiagError(diags, names.MediaLive, create.ErrActionCreating, ResNameChannel, d.Get(names.AttrName).(string), err)
 		}
 	}
@@ -903,7 +904,7 @@ func resourceChannelUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 
 		if channel.State == types.ChannelStateRunning {
-			if err := stopChannel(ctx, conn, d.Timeout(schema.TimeoutUpdate), d.Id()); err != nil {
+			if err := stopChannel(ctx, conn, sdkv2.ResourceTimeout(ctx, d, schema.TimeoutUpdate), d.Id()); err != nil {
 				return create.AppendDiagError(diags, names.MediaLive, create.ErrActionUpdating, ResNameChannel, d.Id(), err)
 			}
 		}
@@ -913,13 +914,13 @@ func resourceChannelUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			return create.AppendDiagError(diags, names.MediaLive, create.ErrActionUpdating, ResNameChannel, d.Id(), err)
 		}
 
-		if _, err := waitChannelUpdated(ctx, conn, aws.ToString(out.Channel.Id), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		if _, err := waitChannelUpdated(ctx, conn, aws.ToString(out.Channel.Id), sdkv2.ResourceTimeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 			return create.AppendDiagError(diags, names.MediaLive, create.ErrActionWaitingForUpdate, ResNameChannel, d.Id(), err)
 		}
 	}
 
 	if d.Get("start_channel").(bool) {
-		if err := startChannel(ctx, conn, d.Timeout(schema.TimeoutUpdate), d.Id()); err != nil {
+		if err := startChannel(ctx, conn, sdkv2.ResourceTimeout(ctx, d, schema.TimeoutUpdate), d.Id()); err != nil {
 			return create.AppendDiagError(diags, names.MediaLive, create.ErrActionUpdating, ResNameChannel, d.Get(names.AttrName).(string), err)
 		}
 	}
@@ -934,13 +935,13 @@ func resourceChannelUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		switch d.Get("start_channel").(bool) {
 		case true:
 			if channel.State == types.ChannelStateIdle {
-				if err := startChannel(ctx, conn, d.Timeout(schema.TimeoutUpdate), d.Id()); err != nil {
+				if err := startChannel(ctx, conn, sdkv2.ResourceTimeout(ctx, d, schema.TimeoutUpdate), d.Id()); err != nil {
 					return create.AppendDiagError(diags, names.MediaLive, create.ErrActionUpdating, ResNameChannel, d.Id(), err)
 				}
 			}
 		default:
 			if channel.State == types.ChannelStateRunning {
-				if err := stopChannel(ctx, conn, d.Timeout(schema.TimeoutUpdate), d.Id()); err != nil {
+				if err := stopChannel(ctx, conn, sdkv2.ResourceTimeout(ctx, d, schema.TimeoutUpdate), d.Id()); err != nil {
 					return create.AppendDiagError(diags, names.MediaLive, create.ErrActionUpdating, ResNameChannel, d.Id(), err)
 				}
 			}
@@ -968,7 +969,7 @@ func resourceChannelDelete(ctx context.Context, d *schema.ResourceData, meta int
 	}
 
 	if channel.State == types.ChannelStateRunning {
-		if err := stopChannel(ctx, conn, d.Timeout(schema.TimeoutDelete), d.Id()); err != nil {
+		if err := stopChannel(ctx, conn, sdkv2.ResourceTimeout(ctx, d, schema.TimeoutDelete), d.Id()); err != nil {
 			return create.AppendDiagError(diags, names.MediaLive, create.ErrActionDeleting, ResNameChannel, d.Id(), err)
 		}
 	}
@@ -985,7 +986,7 @@ func resourceChannelDelete(ctx context.Context, d *schema.ResourceData, meta int
 		return create.AppendDiagError(diags, names.MediaLive, create.ErrActionDeleting, ResNameChannel, d.Id(), err)
 	}
 
-	if _, err := waitChannelDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+	if _, err := waitChannelDeleted(ctx, conn, d.Id(), sdkv2.ResourceTimeout(ctx, d, schema.TimeoutDelete)); err != nil {
 		return create.AppendDiagError(diags, names.MediaLive, create.ErrActionWaitingForDeletion, ResNameChannel, d.Id(), err)
 	}
 
@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package meta
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Default Tags Report")
+func newDataSourceDefaultTagsReport(context.Context) (datasource.DataSourceWithConfigure, error) {
+	d := &dataSourceDefaultTagsReport{}
+
+	return d, nil
+}
+
+type dataSourceDefaultTagsReport struct {
+	framework.DataSourceWithConfigure
+}
+
+// Metadata should return the full name of the data source, such as
+// examplecloud_thing.
+func (d *dataSourceDefaultTagsReport) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_default_tags_report"
+}
+
+// Schema returns the schema for this data source.
+func (d *dataSourceDefaultTagsReport) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"default_tags": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			names.AttrID: schema.StringAttribute{
+				Computed: true,
+			},
+			"limit_violations": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"overridden_tags": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			names.AttrResourceType: schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrTags: schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			names.AttrTagsAll: schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Read is called when the provider must read data source values in order to update state.
+// Config values should be read from the ReadRequest and new state values set on the ReadResponse.
+func (d *dataSourceDefaultTagsReport) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data dataSourceDefaultTagsReportData
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	limits := tftags.DefaultLimits
+
+	if typeName := data.ResourceType.ValueString(); typeName != "" {
+		servicePackageName, ok := servicePackageNameForResourceType(ctx, d.Meta().ServicePackages, typeName)
+		if !ok {
+			response.Diagnostics.AddAttributeError(
+				path.Root(names.AttrResourceType),
+				"Unsupported Resource Type",
+				fmt.Sprintf("The provider does not support resource type %q.", typeName),
+			)
+
+			return
+		}
+
+		limits = tftags.LimitsFor(servicePackageName, typeName)
+	}
+
+	report := d.Meta().DefaultTagsConfig.Report(tftags.New(ctx, data.Tags), limits)
+
+	data.DefaultTags = flex.FlattenFrameworkStringValueMapLegacy(ctx, report.DefaultTags.Map())
+	data.ID = types.StringValue(d.Meta().Partition)
+	data.LimitViolations = flex.FlattenFrameworkStringValueListLegacy(ctx, report.LimitViolations)
+	data.OverriddenTags = flex.FlattenFrameworkStringValueMapLegacy(ctx, report.OverriddenTags.Map())
+	data.TagsAll = flex.FlattenFrameworkStringValueMapLegacy(ctx, report.Tags.Map())
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type dataSourceDefaultTagsReportData struct {
+	DefaultTags     types.Map    `tfsdk:"default_tags"`
+	ID              types.String `tfsdk:"id"`
+	LimitViolations types.List   `tfsdk:"limit_violations"`
+	OverriddenTags  types.Map    `tfsdk:"overridden_tags"`
+	ResourceType    types.String `tfsdk:"resource_type"`
+	Tags            types.Map    `tfsdk:"tags"`
+	TagsAll         types.Map    `tfsdk:"tags_all"`
+}
+
+var (
+	resourceTypeServicePackageNamesOnce sync.Once
+	resourceTypeServicePackageNames     map[string]string // Service package name keyed by resource type name.
+)
+
+// servicePackageNameForResourceType returns the name of the service package that implements the specified resource type.
+// The service packages' resource types are only looked up on first use.
+func servicePackageNameForResourceType(ctx context.Context, servicePackages map[string]conns.ServicePackage, typeName string) (string, bool) {
+	resourceTypeServicePackageNamesOnce.Do(func() {
+		resourceTypeServicePackageNames = make(map[string]string)
+
+		for _, sp := range servicePackages {
+			for _, v := range sp.SDKResources(ctx) {
+				resourceTypeServicePackageNames[v.TypeName] = sp.ServicePackageName()
+			}
+
+			for _, v := range sp.FrameworkResources(ctx) {
+				r, err := v.Factory(ctx)
+				if err != nil {
+					continue
+				}
+
+				response := resource.MetadataResponse{}
+				r.Metadata(ctx, resource.MetadataRequest{}, &response)
+
+				resourceTypeServicePackageNames[response.TypeName] = sp.ServicePackageName()
+			}
+		}
+	})
+
+	v, ok := resourceTypeServicePackageNames[typeName]
+
+	return v, ok
+}
@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package meta_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfmeta "github.com/hashicorp/terraform-provider-aws/internal/service/meta"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMetaDefaultTagsReportDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_default_tags_report.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags2("first", "default1", "second", "default2"),
+					testAccDefaultTagsReportDataSourceConfig_tags2("second", "resource2", "third", "resource3"),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "default_tags.%", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "default_tags.first", "default1"),
+					resource.TestCheckResourceAttr(dataSourceName, "limit_violations.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(dataSourceName, "overridden_tags.%", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "overridden_tags.second", "default2"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags_all.%", acctest.Ct3),
+					resource.TestCheckResourceAttr(dataSourceName, "tags_all.first", "default1"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags_all.second", "resource2"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags_all.third", "resource3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMetaDefaultTagsReportDataSource_limits(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_default_tags_report.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags1("first", "default1"),
+					testAccDefaultTagsReportDataSourceConfig_longValue(),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "limit_violations.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "limit_violations.0", `value of key "second" is 257 characters, maximum 256`),
+					resource.TestCheckResourceAttr(dataSourceName, "overridden_tags.%", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMetaDefaultTagsReportDataSource_resourceType(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_default_tags_report.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags1("first", "default1"),
+					testAccDefaultTagsReportDataSourceConfig_resourceType("aws_s3_object"),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "limit_violations.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "limit_violations.0", "11 tags, maximum 10"),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrResourceType, "aws_s3_object"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags_all.%", "11"),
+				),
+			},
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags1("first", "default1"),
+					testAccDefaultTagsReportDataSourceConfig_resourceType("aws_s3_bucket"),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "limit_violations.#", acctest.Ct0),
+				),
+			},
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags1("first", "default1"),
+					testAccDefaultTagsReportDataSourceConfig_resourceType("aws_not_a_resource"),
+				),
+				ExpectError: regexache.MustCompile(`Unsupported Resource Type`),
+			},
+		},
+	})
+}
+
+func testAccDefaultTagsReportDataSourceConfig_tags2(tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+data "aws_default_tags_report" "test" {
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2)
+}
+
+func testAccDefaultTagsReportDataSourceConfig_longValue() string {
+	return `
+data "aws_default_tags_report" "test" {
+  tags = {
+    second = join("", [for i in range(257) : "a"])
+  }
+}
+`
+}
+
+func testAccDefaultTagsReportDataSourceConfig_resourceType(resourceType string) string {
+	return fmt.Sprintf(`
+data "aws_default_tags_report" "test" {
+  resource_type = %[1]q
+
+  tags = { for i in range(10) : "key${i}" => "value${i}" }
+}
+`, resourceType)
+}
@@ -22,6 +22,10 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 		{
 			Factory: newDataSourceDefaultTags,
 		},
+		{
+			Factory: newDataSourceDefaultTagsReport,
+			Name:    "Default Tags Report",
+		},
 		{
 			Factory: newDataSourceIPRanges,
 		},
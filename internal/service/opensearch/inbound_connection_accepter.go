@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -70,7 +71,7 @@ func resourceInboundConnectionAccepterCreate(ctx context.Context, d *schema.Reso
 
 	d.SetId(connectionID)
 
-	if _, err := waitInboundConnectionAccepted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+	if _, err := waitInboundConnectionAccepted(ctx, conn, d.Id(), sdkv2.ResourceTimeout(ctx, d, schema.TimeoutCreate)); err != nil {
 		return diag.Errorf("waiting for OpenSearch Inbound Connection (%s) accept: %s", d.Id(), err)
 	}
 
@@ -110,7 +111,7 @@ func resourceInboundConnectionDelete(ctx context.Context, d *schema.ResourceData
 			return diag.Errorf("rejecting OpenSearch Inbound Connection (%s): %s", d.Id(), err)
 		}
 
-		if _, err := waitInboundConnectionRejected(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		if _, err := waitInboundConnectionRejected(ctx, conn, d.Id(), sdkv2.ResourceTimeout(ctx, d, schema.TimeoutCreate)); err != nil {
 			return diag.Errorf("waiting for OpenSearch Inbound Connection (%s) reject: %s", d.Id(), err)
 		}
 
@@ -130,7 +131,7 @@ func resourceInboundConnectionDelete(ctx context.Context, d *schema.ResourceData
 		return diag.Errorf("deleting OpenSearch Inbound Connection (%s): %s", d.Id(), err)
 	}
 
-	if _, err := waitInboundConnectionDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+	if _, err := waitInboundConnectionDeleted(ctx, conn, d.Id(), sdkv2.ResourceTimeout(ctx, d, schema.TimeoutCreate)); err != nil {
 		return diag.Errorf("waiting for OpenSearch Inbound Connection (%s) delete: %s", d.Id(), err)
 	}
 
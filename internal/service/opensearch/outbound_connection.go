@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -142,7 +143,7 @@ func resourceOutboundConnectionCreate(ctx context.Context, d *schema.ResourceDat
 
 	d.SetId(aws.StringValue(output.ConnectionId))
 
-	if _, err := waitOutboundConnectionCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+	if _, err := waitOutboundConnectionCreated(ctx, conn, d.Id(), sdkv2.ResourceTimeout(ctx, d, schema.TimeoutCreate)); err != nil {
 		return diag.Errorf("waiting for OpenSearch Outbound Connection (%s) create: %s", d.Id(), err)
 	}
 
@@ -157,7 +158,7 @@ func resourceOutboundConnectionCreate(ctx context.Context, d *schema.ResourceDat
 			return diag.Errorf("accepting OpenSearch Inbound Connection (%s): %s", d.Id(), err)
 		}
 
-		if _, err := waitInboundConnectionAccepted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		if _, err := waitInboundConnectionAccepted(ctx, conn, d.Id(), sdkv2.ResourceTimeout(ctx, d, schema.TimeoutCreate)); err != nil {
 			return diag.Errorf("waiting for OpenSearch Inbound Connection (%s) accept: %s", d.Id(), err)
 		}
 	}
@@ -206,7 +207,7 @@ func resourceOutboundConnectionDelete(ctx context.Context, d *schema.ResourceDat
 		return diag.Errorf("deleting OpenSearch Outbound Connection (%s): %s", d.Id(), err)
 	}
 
-	if _, err := waitOutboundConnectionDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+	if _, err := waitOutboundConnectionDeleted(ctx, conn, d.Id(), sdkv2.ResourceTimeout(ctx, d, schema.TimeoutCreate)); err != nil {
 		return diag.Errorf("waiting for OpenSearch Outbound Connection (%s) delete: %s", d.Id(), err)
 	}
 
@@ -21,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -724,7 +725,7 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 	d.SetId(instanceId)
 
 	if v, ok := d.GetOk(names.AttrState); ok && v.(string) == instanceStatusRunning {
-		err := startInstance(ctx, d, meta, true, d.Timeout(schema.TimeoutCreate))
+		err := startInstance(ctx, d, meta, true, sdkv2.ResourceTimeout(ctx, d, schema.TimeoutCreate))
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "creating OpsWorks Instance: %s", err)
 		}
@@ -797,14 +798,14 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		state := v.(string)
 		if state == instanceStatusRunning {
 			if status == instanceStatusStopped || status == instanceStatusStopping || status == instanceStatusShuttingDown {
-				err := startInstance(ctx, d, meta, false, d.Timeout(schema.TimeoutUpdate))
+				err := startInstance(ctx, d, meta, false, sdkv2.ResourceTimeout(ctx, d, schema.TimeoutUpdate))
 				if err != nil {
 					return sdkdiag.AppendErrorf(diags, "updating OpsWorks Instance (%s): %s", d.Id(), err)
 				}
 			}
 		} else {
 			if status != instanceStatusStopped && status != instanceStatusStopping && status != instanceStatusShuttingDown {
-				err := stopInstance(ctx, d, meta, d.Timeout(schema.TimeoutUpdate))
+				err := stopInstance(ctx, d, meta, sdkv2.ResourceTimeout(ctx, d, schema.TimeoutUpdate))
 				if err != nil {
 					return sdkdiag.AppendErrorf(diags, "updating OpsWorks Instance (%s): %s", d.Id(), err)
 				}
@@ -820,7 +821,7 @@ func resourceInstanceDelete(ctx context.Context, d *schema.ResourceData, meta in
 	conn := meta.(*conns.AWSClient).OpsWorksConn(ctx)
 
 	if v, ok := d.GetOk(names.AttrStatus); ok && v.(string) != instanceStatusStopped {
-		err := stopInstance(ctx, d, meta, d.Timeout(schema.TimeoutDelete))
+		err := stopInstance(ctx, d, meta, sdkv2.ResourceTimeout(ctx, d, schema.TimeoutDelete))
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "deleting OpsWorks instance (%s): %s", d.Id(), err)
 		}
@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -145,7 +146,7 @@ func resourceClusterSnapshotCreate(ctx context.Context, d *schema.ResourceData,
 
 	d.SetId(id)
 
-	if _, err := waitDBClusterSnapshotCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+	if _, err := waitDBClusterSnapshotCreated(ctx, conn, d.Id(), sdkv2.ResourceTimeout(ctx, d, schema.TimeoutCreate)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for RDS DB Cluster Snapshot (%s) create: %s", d.Id(), err)
 	}
 
@@ -278,37 +279,25 @@ func findDBClusterSnapshots(ctx context.Context, conn *rds.RDS, input *rds.Descr
 	return output, nil
 }
 
-func statusDBClusterSnapshot(ctx context.Context, conn *rds.RDS, id string) retry.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		output, err := FindDBClusterSnapshotByID(ctx, conn, id)
-
-		if tfresource.NotFound(err) {
-			return nil, "", nil
-		}
-
-		if err != nil {
-			return nil, "", err
-		}
-
-		return output, aws.StringValue(output.Status), nil
-	}
-}
-
 func waitDBClusterSnapshotCreated(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration) (*rds.DBClusterSnapshot, error) {
-	stateConf := &retry.StateChangeConf{
-		Pending:    []string{ClusterSnapshotStatusCreating},
-		Target:     []string{ClusterSnapshotStatusAvailable},
-		Refresh:    statusDBClusterSnapshot(ctx, conn, id),
-		Timeout:    timeout,
-		MinTimeout: 10 * time.Second,
-		Delay:      5 * time.Second,
-	}
-
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
-
-	if output, ok := outputRaw.(*rds.DBClusterSnapshot); ok {
-		return output, err
+	waiter := tfresource.StateWaiter[*rds.DBClusterSnapshot]{
+		Pending: []string{ClusterSnapshotStatusCreating},
+		Target:  []string{ClusterSnapshotStatusAvailable},
+		Find: func(ctx context.Context) (*rds.DBClusterSnapshot, error) {
+			return FindDBClusterSnapshotByID(ctx, conn, id)
+		},
+		Status: func(v *rds.DBClusterSnapshot) string {
+			return aws.StringValue(v.Status)
+		},
+		Percent: func(v *rds.DBClusterSnapshot) (int, bool) {
+			return int(aws.Int64Value(v.PercentProgress)), v.PercentProgress != nil
+		},
+		Timeout: timeout,
+		Options: tfresource.Options{
+			MinPollInterval: 10 * time.Second,
+			Delay:           5 * time.Second,
+		},
 	}
 
-	return nil, err
+	return waiter.Wait(ctx)
 }
@@ -32,6 +32,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -1767,7 +1768,7 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 
 	var instance *rds.DBInstance
 	var err error
-	if instance, err = waitDBInstanceAvailableSDKv1(ctx, conn, identifier, d.Timeout(schema.TimeoutCreate)); err != nil {
+	if instance, err = waitDBInstanceAvailableSDKv1(ctx, conn, identifier, sdkv2.ResourceTimeout(ctx, d, schema.TimeoutCreate)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for RDS DB Instance (%s) create: %s", identifier, err)
 	}
 
@@ -1785,7 +1786,7 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 			return sdkdiag.AppendErrorf(diags, "updating RDS DB Instance (%s): %s", identifier, err)
 		}
 
-		if _, err := waitDBInstanceAvailableSDKv1(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		if _, err := waitDBInstanceAvailableSDKv1(ctx, conn, d.Id(), sdkv2.ResourceTimeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for RDS DB Instance (%s) update: %s", identifier, err)
 		}
 	}
@@ -1798,7 +1799,7 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 			return sdkdiag.AppendErrorf(diags, "rebooting RDS DB Instance (%s): %s", identifier, err)
 		}
 
-		if _, err := waitDBInstanceAvailableSDKv1(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		if _, err := waitDBInstanceAvailableSDKv1(ctx, conn, d.Id(), sdkv2.ResourceTimeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for RDS DB Instance (%s) update: %s", identifier, err)
 		}
 	}
@@ -1963,7 +1964,7 @@ func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta inte
 func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).RDSClient(ctx)
-	deadline := tfresource.NewDeadline(d.Timeout(schema.TimeoutUpdate))
+	deadline := tfresource.NewDeadline(sdkv2.ResourceTimeout(ctx, d, schema.TimeoutUpdate))
 
 	// Separate request to promote a database.
 	if d.HasChange("replicate_source_db") {
@@ -2482,7 +2483,7 @@ func resourceInstanceDelete(ctx context.Context, d *schema.ResourceData, meta in
 
 	if tfawserr.ErrMessageContains(err, errCodeInvalidParameterCombination, "disable deletion pro") {
 		if v, ok := d.GetOk(names.AttrDeletionProtection); (!ok || !v.(bool)) && d.Get(names.AttrApplyImmediately).(bool) {
-			_, ierr := tfresource.RetryWhen(ctx, d.Timeout(schema.TimeoutUpdate),
+			_, ierr := tfresource.RetryWhen(ctx, sdkv2.ResourceTimeout(ctx, d, schema.TimeoutUpdate),
 				func() (interface{}, error) {
 					return conn.ModifyDBInstanceWithContext(ctx, &rds.ModifyDBInstanceInput{
 						ApplyImmediately:     aws.Bool(true),
@@ -2509,7 +2510,7 @@ func resourceInstanceDelete(ctx context.Context, d *schema.ResourceData, meta in
 				return sdkdiag.AppendErrorf(diags, "updating RDS DB Instance (%s): %s", d.Get(names.AttrIdentifier).(string), err)
 			}
 
-			if _, ierr := waitDBInstanceAvailableSDKv1(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); ierr != nil {
+			if _, ierr := waitDBInstanceAvailableSDKv1(ctx, conn, d.Id(), sdkv2.ResourceTimeout(ctx, d, schema.TimeoutUpdate)); ierr != nil {
 				return sdkdiag.AppendErrorf(diags, "waiting for RDS DB Instance (%s) update: %s", d.Get(names.AttrIdentifier).(string), ierr)
 			}
 
@@ -2525,7 +2526,7 @@ func resourceInstanceDelete(ctx context.Context, d *schema.ResourceData, meta in
 		return sdkdiag.AppendErrorf(diags, "deleting RDS DB Instance (%s): %s", d.Get(names.AttrIdentifier).(string), err)
 	}
 
-	if _, err := waitDBInstanceDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+	if _, err := waitDBInstanceDeleted(ctx, conn, d.Id(), sdkv2.ResourceTimeout(ctx, d, schema.TimeoutDelete)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for RDS DB Instance (%s) delete: %s", d.Get(names.AttrIdentifier).(string), err)
 	}
 
@@ -2732,148 +2733,95 @@ func statusDBInstanceSDKv1(ctx context.Context, conn *rds.RDS, id string) retry.
 	}
 }
 
-func statusDBInstanceSDKv2(ctx context.Context, conn *rds_sdkv2.Client, id string) retry.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		output, err := findDBInstanceByIDSDKv2(ctx, conn, id)
-
-		if tfresource.NotFound(err) {
-			return nil, "", nil
-		}
-
-		if err != nil {
-			return nil, "", err
-		}
-
-		return output, aws.StringValue(output.DBInstanceStatus), nil
-	}
-}
-
-func waitDBInstanceAvailableSDKv1(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration, optFns ...tfresource.OptionsFunc) (*rds.DBInstance, error) {
-	options := tfresource.Options{
-		PollInterval:              10 * time.Second,
-		Delay:                     1 * time.Minute,
-		ContinuousTargetOccurence: 3,
-	}
-	for _, fn := range optFns {
-		fn(&options)
-	}
-
-	stateConf := &retry.StateChangeConf{
-		Pending: []string{
-			InstanceStatusBackingUp,
-			InstanceStatusConfiguringEnhancedMonitoring,
-			InstanceStatusConfiguringIAMDatabaseAuth,
-			InstanceStatusConfiguringLogExports,
-			InstanceStatusCreating,
-			InstanceStatusMaintenance,
-			InstanceStatusModifying,
-			InstanceStatusMovingToVPC,
-			InstanceStatusRebooting,
-			InstanceStatusRenaming,
-			InstanceStatusResettingMasterCredentials,
-			InstanceStatusStarting,
-			InstanceStatusStopping,
-			InstanceStatusStorageFull,
-			InstanceStatusUpgrading,
+func newDBInstanceWaiterSDKv1(conn *rds.RDS, id string, timeout time.Duration) tfresource.StateWaiter[*rds.DBInstance] {
+	return tfresource.StateWaiter[*rds.DBInstance]{
+		Find: func(ctx context.Context) (*rds.DBInstance, error) {
+			return findDBInstanceByIDSDKv1(ctx, conn, id)
+		},
+		Status: func(v *rds.DBInstance) string {
+			return aws.StringValue(v.DBInstanceStatus)
 		},
-		Target:  []string{InstanceStatusAvailable, InstanceStatusStorageOptimization},
-		Refresh: statusDBInstanceSDKv1(ctx, conn, id),
 		Timeout: timeout,
+		Options: tfresource.Options{
+			PollInterval:              10 * time.Second,
+			Delay:                     1 * time.Minute,
+			ContinuousTargetOccurence: 3,
+		},
 	}
-	options.Apply(stateConf)
-
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
-
-	if output, ok := outputRaw.(*rds.DBInstance); ok {
-		return output, err
-	}
-
-	return nil, err
 }
 
-func waitDBInstanceAvailableSDKv2(ctx context.Context, conn *rds_sdkv2.Client, id string, timeout time.Duration, optFns ...tfresource.OptionsFunc) (*rds.DBInstance, error) {
-	options := tfresource.Options{
-		PollInterval:              10 * time.Second,
-		Delay:                     1 * time.Minute,
-		ContinuousTargetOccurence: 3,
-	}
-	for _, fn := range optFns {
-		fn(&options)
-	}
-
-	stateConf := &retry.StateChangeConf{
-		Pending: []string{
-			InstanceStatusBackingUp,
-			InstanceStatusConfiguringEnhancedMonitoring,
-			InstanceStatusConfiguringIAMDatabaseAuth,
-			InstanceStatusConfiguringLogExports,
-			InstanceStatusCreating,
-			InstanceStatusMaintenance,
-			InstanceStatusModifying,
-			InstanceStatusMovingToVPC,
-			InstanceStatusRebooting,
-			InstanceStatusRenaming,
-			InstanceStatusResettingMasterCredentials,
-			InstanceStatusStarting,
-			InstanceStatusStopping,
-			InstanceStatusStorageFull,
-			InstanceStatusUpgrading,
+func newDBInstanceWaiterSDKv2(conn *rds_sdkv2.Client, id string, timeout time.Duration) tfresource.StateWaiter[*types.DBInstance] {
+	return tfresource.StateWaiter[*types.DBInstance]{
+		Find: func(ctx context.Context) (*types.DBInstance, error) {
+			return findDBInstanceByIDSDKv2(ctx, conn, id)
+		},
+		Status: func(v *types.DBInstance) string {
+			return aws.StringValue(v.DBInstanceStatus)
 		},
-		Target:  []string{InstanceStatusAvailable, InstanceStatusStorageOptimization},
-		Refresh: statusDBInstanceSDKv2(ctx, conn, id),
 		Timeout: timeout,
+		Options: tfresource.Options{
+			PollInterval:              10 * time.Second,
+			Delay:                     1 * time.Minute,
+			ContinuousTargetOccurence: 3,
+		},
 	}
-	options.Apply(stateConf)
-
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
-
-	if output, ok := outputRaw.(*rds.DBInstance); ok {
-		return output, err
-	}
+}
 
-	return nil, err
+var dbInstanceAvailablePendingStatuses = []string{
+	InstanceStatusBackingUp,
+	InstanceStatusConfiguringEnhancedMonitoring,
+	InstanceStatusConfiguringIAMDatabaseAuth,
+	InstanceStatusConfiguringLogExports,
+	InstanceStatusCreating,
+	InstanceStatusMaintenance,
+	InstanceStatusModifying,
+	InstanceStatusMovingToVPC,
+	InstanceStatusRebooting,
+	InstanceStatusRenaming,
+	InstanceStatusResettingMasterCredentials,
+	InstanceStatusStarting,
+	InstanceStatusStopping,
+	InstanceStatusStorageFull,
+	InstanceStatusUpgrading,
 }
 
-func waitDBInstanceDeleted(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration, optFns ...tfresource.OptionsFunc) (*rds.DBInstance, error) {
-	options := tfresource.Options{
-		PollInterval:              10 * time.Second,
-		Delay:                     1 * time.Minute,
-		ContinuousTargetOccurence: 3,
-	}
-	for _, fn := range optFns {
-		fn(&options)
-	}
+func waitDBInstanceAvailableSDKv1(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration, optFns ...tfresource.OptionsFunc) (*rds.DBInstance, error) {
+	waiter := newDBInstanceWaiterSDKv1(conn, id, timeout)
+	waiter.Pending = dbInstanceAvailablePendingStatuses
+	waiter.Target = []string{InstanceStatusAvailable, InstanceStatusStorageOptimization}
 
-	stateConf := &retry.StateChangeConf{
-		Pending: []string{
-			InstanceStatusAvailable,
-			InstanceStatusBackingUp,
-			InstanceStatusConfiguringEnhancedMonitoring,
-			InstanceStatusConfiguringLogExports,
-			InstanceStatusCreating,
-			InstanceStatusDeletePreCheck,
-			InstanceStatusDeleting,
-			InstanceStatusIncompatibleParameters,
-			InstanceStatusIncompatibleRestore,
-			InstanceStatusModifying,
-			InstanceStatusStarting,
-			InstanceStatusStopping,
-			InstanceStatusStorageFull,
-			InstanceStatusStorageOptimization,
-		},
-		Target:  []string{},
-		Refresh: statusDBInstanceSDKv1(ctx, conn, id),
-		Timeout: timeout,
-	}
-	options.Apply(stateConf)
+	return waiter.Wait(ctx, optFns...)
+}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+func waitDBInstanceAvailableSDKv2(ctx context.Context, conn *rds_sdkv2.Client, id string, timeout time.Duration, optFns ...tfresource.OptionsFunc) (*types.DBInstance, error) {
+	waiter := newDBInstanceWaiterSDKv2(conn, id, timeout)
+	waiter.Pending = dbInstanceAvailablePendingStatuses
+	waiter.Target = []string{InstanceStatusAvailable, InstanceStatusStorageOptimization}
 
-	if output, ok := outputRaw.(*rds.DBInstance); ok {
-		return output, err
-	}
+	return waiter.Wait(ctx, optFns...)
+}
 
-	return nil, err
+func waitDBInstanceDeleted(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration, optFns ...tfresource.OptionsFunc) (*rds.DBInstance, error) {
+	waiter := newDBInstanceWaiterSDKv1(conn, id, timeout)
+	waiter.Pending = []string{
+		InstanceStatusAvailable,
+		InstanceStatusBackingUp,
+		InstanceStatusConfiguringEnhancedMonitoring,
+		InstanceStatusConfiguringLogExports,
+		InstanceStatusCreating,
+		InstanceStatusDeletePreCheck,
+		InstanceStatusDeleting,
+		InstanceStatusIncompatibleParameters,
+		InstanceStatusIncompatibleRestore,
+		InstanceStatusModifying,
+		InstanceStatusStarting,
+		InstanceStatusStopping,
+		InstanceStatusStorageFull,
+		InstanceStatusStorageOptimization,
+	}
+	waiter.Target = []string{}
+
+	return waiter.Wait(ctx, optFns...)
 }
 
 func findBlueGreenDeploymentByID(ctx context.Context, conn *rds_sdkv2.Client, id string) (*types.BlueGreenDeployment, error) {
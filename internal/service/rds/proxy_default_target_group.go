@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -124,9 +125,9 @@ func resourceProxyDefaultTargetGroupPut(ctx context.Context, d *schema.ResourceD
 		return sdkdiag.AppendErrorf(diags, "updating RDS DB Proxy Default Target Group (%s): %s", dbProxyName, err)
 	}
 
-	timeout := d.Timeout(schema.TimeoutUpdate)
+	timeout := sdkv2.ResourceTimeout(ctx, d, schema.TimeoutUpdate)
 	if d.IsNewResource() {
-		timeout = d.Timeout(schema.TimeoutCreate)
+		timeout = sdkv2.ResourceTimeout(ctx, d, schema.TimeoutCreate)
 
 		d.SetId(dbProxyName)
 	}
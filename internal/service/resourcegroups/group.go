@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -147,7 +148,7 @@ func resourceGroupCreate(ctx context.Context, d *schema.ResourceData, meta inter
 	d.SetId(aws.ToString(output.Group.Name))
 
 	if waitForConfigurationAttached {
-		if _, err := waitGroupConfigurationUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		if _, err := waitGroupConfigurationUpdated(ctx, conn, d.Id(), sdkv2.ResourceTimeout(ctx, d, schema.TimeoutCreate)); err != nil {
 			return diag.Errorf("waiting for Resource Groups Group (%s) configuration update: %s", d.Id(), err)
 		}
 	}
@@ -264,7 +265,7 @@ func resourceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 			return diag.Errorf("updating Resource Groups Group (%s) configuration: %s", d.Id(), err)
 		}
 
-		if _, err := waitGroupConfigurationUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		if _, err := waitGroupConfigurationUpdated(ctx, conn, d.Id(), sdkv2.ResourceTimeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 			return diag.Errorf("waiting for Resource Groups Group (%s) configuration update: %s", d.Id(), err)
 		}
 	}
@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -79,7 +80,7 @@ func resourceResourceCreate(ctx context.Context, d *schema.ResourceData, meta in
 
 	d.SetId(id)
 
-	if _, err := waitResourceCreated(ctx, conn, groupARN, resourceARN, d.Timeout(schema.TimeoutDelete)); err != nil {
+	if _, err := waitResourceCreated(ctx, conn, groupARN, resourceARN, sdkv2.ResourceTimeout(ctx, d, schema.TimeoutDelete)); err != nil {
 		return diag.Errorf("waiting for Resource Groups Resource (%s) create: %s", d.Id(), err)
 	}
 
@@ -127,7 +128,7 @@ func resourceResourceDelete(ctx context.Context, d *schema.ResourceData, meta in
 		return diag.Errorf("deleting Resource Groups Resource (%s): %s", d.Id(), err)
 	}
 
-	if _, err := waitResourceDeleted(ctx, conn, groupARN, resourceARN, d.Timeout(schema.TimeoutDelete)); err != nil {
+	if _, err := waitResourceDeleted(ctx, conn, groupARN, resourceARN, sdkv2.ResourceTimeout(ctx, d, schema.TimeoutDelete)); err != nil {
 		return diag.Errorf("waiting for Resource Groups Resource (%s) delete: %s", d.Id(), err)
 	}
 
@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -146,7 +147,7 @@ func resourceRuleCreate(ctx context.Context, d *schema.ResourceData, meta interf
 
 	d.SetId(aws.StringValue(output.ResolverRule.Id))
 
-	if _, err := waitRuleCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+	if _, err := waitRuleCreated(ctx, conn, d.Id(), sdkv2.ResourceTimeout(ctx, d, schema.TimeoutCreate)); err != nil {
 		return diag.Errorf("waiting for Route53 Resolver Rule (%s) create: %s", d.Id(), err)
 	}
 
@@ -212,7 +213,7 @@ func resourceRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 			return diag.Errorf("updating Route53 Resolver Rule (%s): %s", d.Id(), err)
 		}
 
-		if _, err := waitRuleUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		if _, err := waitRuleUpdated(ctx, conn, d.Id(), sdkv2.ResourceTimeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 			return diag.Errorf("waiting for Route53 Resolver Rule (%s) update: %s", d.Id(), err)
 		}
 	}
@@ -236,7 +237,7 @@ func resourceRuleDelete(ctx context.Context, d *schema.ResourceData, meta interf
 		return diag.Errorf("deleting Route53 Resolver Rule (%s): %s", d.Id(), err)
 	}
 
-	if _, err := waitRuleDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+	if _, err := waitRuleDeleted(ctx, conn, d.Id(), sdkv2.ResourceTimeout(ctx, d, schema.TimeoutDelete)); err != nil {
 		return diag.Errorf("waiting for Route53 Resolver Rule (%s) delete: %s", d.Id(), err)
 	}
 
@@ -8,15 +8,18 @@ import (
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -309,6 +312,8 @@ func resourceBucketReplicationConfiguration() *schema.Resource {
 				Sensitive: true,
 			},
 		},
+
+		CustomizeDiff: resourceBucketReplicationConfigurationCustomizeDiff,
 	}
 }
 
@@ -317,6 +322,12 @@ func resourceBucketReplicationConfigurationCreate(ctx context.Context, d *schema
 	conn := meta.(*conns.AWSClient).S3Client(ctx)
 
 	bucket := d.Get(names.AttrBucket).(string)
+
+	diags = append(diags, checkReplicationBucketsVersioning(ctx, meta.(*conns.AWSClient), bucket, d.Get(names.AttrRule).([]interface{}))...)
+	if diags.HasError() {
+		return diags
+	}
+
 	input := &s3.PutBucketReplicationInput{
 		Bucket: aws.String(bucket),
 		ReplicationConfiguration: &types.ReplicationConfiguration{
@@ -397,6 +408,13 @@ func resourceBucketReplicationConfigurationUpdate(ctx context.Context, d *schema
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).S3Client(ctx)
 
+	if d.HasChange(names.AttrRule) {
+		diags = append(diags, checkReplicationBucketsVersioning(ctx, meta.(*conns.AWSClient), d.Id(), d.Get(names.AttrRule).([]interface{}))...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
 	input := &s3.PutBucketReplicationInput{
 		Bucket: aws.String(d.Id()),
 		ReplicationConfiguration: &types.ReplicationConfiguration{
@@ -446,6 +464,82 @@ func resourceBucketReplicationConfigurationDelete(ctx context.Context, d *schema
 	return diags
 }
 
+func resourceBucketReplicationConfigurationCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	// Validate rule semantics at plan time instead of surfacing MalformedXML or InvalidRequest errors on apply.
+	if diags := validateReplicationRules(d.GetRawConfig().GetAttr(names.AttrRule)); diags.HasError() {
+		return sdkdiag.DiagnosticsError(diags)
+	}
+
+	return nil
+}
+
+// checkReplicationBucketsVersioning returns an error for the source bucket and for each rule whose destination bucket
+// doesn't have versioning enabled, as replication requires versioning on both.
+// Buckets whose versioning can't be read, e.g. in another account, are not checked.
+func checkReplicationBucketsVersioning(ctx context.Context, awsClient *conns.AWSClient, bucket string, tfList []interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	statuses := make(map[string]string)
+
+	check := func(path cty.Path, bucket string) {
+		status, ok := statuses[bucket]
+		if !ok {
+			status = findReplicationBucketVersioningStatus(ctx, awsClient, bucket)
+			statuses[bucket] = status
+		}
+
+		if status != "" && status != string(types.BucketVersioningStatusEnabled) {
+			diags = append(diags, errs.NewInvalidValueAttributeErrorf(path, "Versioning must be %s on S3 Bucket (%s) to replicate objects, but it is %s.", types.BucketVersioningStatusEnabled, bucket, status))
+		}
+	}
+
+	check(cty.GetAttrPath(names.AttrBucket), bucket)
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		v, ok := tfMap[names.AttrDestination].([]interface{})
+		if !ok || len(v) == 0 || v[0] == nil {
+			continue
+		}
+		bucketARN, err := arn.Parse(v[0].(map[string]interface{})[names.AttrBucket].(string))
+		if err != nil {
+			continue
+		}
+
+		check(cty.GetAttrPath(names.AttrRule).IndexInt(i).GetAttr(names.AttrDestination).IndexInt(0).GetAttr(names.AttrBucket), bucketARN.Resource)
+	}
+
+	return diags
+}
+
+// findReplicationBucketVersioningStatus returns the versioning status of a bucket in any Region,
+// or "" if it can't be read.
+func findReplicationBucketVersioningStatus(ctx context.Context, awsClient *conns.AWSClient, bucket string) string {
+	region, err := findBucketRegion(ctx, awsClient, bucket)
+
+	if err != nil {
+		log.Printf("[DEBUG] Skipping S3 Bucket (%s) versioning check: %s", bucket, err)
+		return ""
+	}
+
+	output, err := findBucketVersioning(ctx, awsClient.S3Client(ctx), bucket, "", func(o *s3.Options) {
+		o.Region = region
+	})
+
+	if err != nil {
+		log.Printf("[DEBUG] Skipping S3 Bucket (%s) versioning check: %s", bucket, err)
+		return ""
+	}
+
+	if output.Status == "" {
+		return bucketVersioningStatusDisabled
+	}
+
+	return string(output.Status)
+}
+
 func findReplicationConfiguration(ctx context.Context, conn *s3.Client, bucket string) (*types.ReplicationConfiguration, error) {
 	input := &s3.GetBucketReplicationInput{
 		Bucket: aws.String(bucket),
@@ -48,6 +48,31 @@ func testAccErrorCheckSkip(t *testing.T) resource.ErrorCheckFunc {
 	)
 }
 
+func TestOfflineS3Bucket_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	bucketName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket.test"
+
+	acctest.FakeAWSTest(ctx, t, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketConfig_basic(bucketName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, names.AttrARN, "arn:aws:s3:::"+bucketName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrBucket, bucketName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrRegion, names.USWest2RegionID),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrForceDestroy},
+			},
+		},
+	})
+}
+
 func TestAccS3Bucket_Basic_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix("tf-test-bucket")
@@ -0,0 +1,501 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"maps"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/mitchellh/go-homedir"
+)
+
+const (
+	directorySyncResourceIDPartCount = 2
+
+	directorySyncDefaultParallelism = 10
+	directorySyncMaxParallelism     = 100
+
+	// DeleteObjects deletes at most 1000 objects per request.
+	deleteObjectsMaxKeys = 1000
+)
+
+// @SDKResource("aws_s3_directory_sync", name="Directory Sync")
+func resourceDirectorySync() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDirectorySyncCreate,
+		ReadWithoutTimeout:   resourceDirectorySyncRead,
+		UpdateWithoutTimeout: resourceDirectorySyncUpdate,
+		DeleteWithoutTimeout: resourceDirectorySyncDelete,
+
+		CustomizeDiff: resourceDirectorySyncCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrBucket: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"cache_control": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"content_types": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				ValidateDiagFunc: validation.MapKeyMatch(
+					regexache.MustCompile(`^\.[^./]+$`), "must be a file extension, including the leading dot",
+				),
+			},
+			"exclude": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.NoZeroValues,
+				},
+			},
+			"include": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.NoZeroValues,
+				},
+			},
+			"key_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"objects": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"parallelism": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      directorySyncDefaultParallelism,
+				ValidateFunc: validation.IntBetween(1, directorySyncMaxParallelism),
+			},
+			"part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      manager.DefaultUploadPartSize,
+				ValidateFunc: validation.IntAtLeast(int(manager.MinUploadPartSize)),
+			},
+			names.AttrSource: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			names.AttrStorageClass: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.StorageClass](),
+			},
+		},
+	}
+}
+
+func resourceDirectorySyncCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	bucket := d.Get(names.AttrBucket).(string)
+	keyPrefix := d.Get("key_prefix").(string)
+	id, err := flex.FlattenResourceId([]string{bucket, keyPrefix}, directorySyncResourceIDPartCount, true)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceDirectorySyncPut(ctx, d, meta)...)
+}
+
+func resourceDirectorySyncRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	bucket := d.Get(names.AttrBucket).(string)
+	etags, err := findObjectETagsByPrefix(ctx, conn, bucket, d.Get("key_prefix").(string))
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Directory Sync (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Directory Sync (%s): %s", d.Id(), err)
+	}
+
+	// Objects that were deleted or changed outside of Terraform are uploaded again.
+	// Other objects with the key prefix are not managed.
+	objects := flex.ExpandStringValueMap(d.Get("objects").(map[string]interface{}))
+	var changed []string
+	for key, etag := range objects {
+		if v, ok := etags[key]; !ok {
+			delete(objects, key)
+		} else if v != etag {
+			changed = append(changed, key)
+		}
+	}
+
+	// The ETag of an object encrypted with SSE-KMS or SSE-C is not the MD5 digest of its content,
+	// so the ETag in state is kept and only deleted objects are detected.
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	var errs []error
+	sem := make(chan struct{}, d.Get("parallelism").(int))
+	for _, key := range changed {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			ok, err := objectETagIsDigest(ctx, conn, bucket, key)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			switch {
+			case tfresource.NotFound(err):
+				delete(objects, key)
+			case err != nil:
+				errs = append(errs, fmt.Errorf("reading S3 Object (%s) in Bucket (%s): %w", key, bucket, err))
+			case ok:
+				objects[key] = etags[key]
+			}
+		}()
+	}
+	wg.Wait()
+
+	if err := errors.Join(errs...); err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Directory Sync (%s): %s", d.Id(), err)
+	}
+
+	d.Set("objects", objects)
+
+	return diags
+}
+
+func resourceDirectorySyncUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	return append(diags, resourceDirectorySyncPut(ctx, d, meta)...)
+}
+
+func resourceDirectorySyncDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	bucket := d.Get(names.AttrBucket).(string)
+	keys := tfmaps.Keys(d.Get("objects").(map[string]interface{}))
+
+	log.Printf("[DEBUG] Deleting S3 Directory Sync: %s", d.Id())
+	deleted, err := deleteObjectsByKey(ctx, conn, bucket, keys)
+
+	if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
+		return diags
+	}
+
+	if err != nil {
+		log.Printf("[DEBUG] Deleted %d of %d S3 Directory Sync (%s) objects", len(deleted), len(keys), d.Id())
+		return sdkdiag.AppendErrorf(diags, "deleting S3 Directory Sync (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceDirectorySyncCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if bucket := d.Get(names.AttrBucket).(string); isDirectoryBucket(bucket) {
+		return fmt.Errorf("directory buckets are not supported: %s", bucket)
+	}
+
+	for _, key := range []string{names.AttrSource, "exclude", "include", "key_prefix", "part_size"} {
+		if !d.NewValueKnown(key) {
+			return d.SetNewComputed("objects")
+		}
+	}
+
+	files, err := expandDirectorySyncFiles(d)
+	if err != nil {
+		return err
+	}
+
+	manifest, err := directorySyncManifest(files, int64(d.Get("part_size").(int)))
+	if err != nil {
+		return err
+	}
+
+	if o := flex.ExpandStringValueMap(d.Get("objects").(map[string]interface{})); maps.Equal(o, manifest) {
+		return nil
+	}
+
+	return d.SetNew("objects", manifest)
+}
+
+// resourceDirectorySyncPut uploads new and changed files and deletes the objects of removed files.
+// All files are uploaded again if the object settings change.
+// The manifest in state records the objects that were uploaded or deleted successfully, even if others failed.
+func resourceDirectorySyncPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	bucket := d.Get(names.AttrBucket).(string)
+	partSize := int64(d.Get("part_size").(int))
+
+	files, err := expandDirectorySyncFiles(d)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "syncing S3 Directory Sync (%s): %s", d.Id(), err)
+	}
+
+	o, _ := d.GetChange("objects")
+	old := flex.ExpandStringValueMap(o.(map[string]interface{}))
+	objects := maps.Clone(old)
+
+	manifest, err := directorySyncManifest(files, partSize)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "syncing S3 Directory Sync (%s): %s", d.Id(), err)
+	}
+
+	// Changes to the object settings only take effect on upload.
+	uploadAll := d.HasChanges("cache_control", "content_types", names.AttrStorageClass)
+	toUpload, toDelete := diffDirectorySyncManifests(old, manifest, uploadAll)
+	var errs []error
+
+	log.Printf("[DEBUG] Uploading %d S3 Directory Sync (%s) objects", len(toUpload), d.Id())
+	uploader := manager.NewUploader(conn, func(u *manager.Uploader) {
+		u.PartSize = partSize
+	})
+	filesByKey := make(map[string]directorySyncFile, len(files))
+	for _, file := range files {
+		filesByKey[file.key] = file
+	}
+	contentTypes := flex.ExpandStringValueMap(d.Get("content_types").(map[string]interface{}))
+	// Objects are uploaded concurrently, so settings are read from the resource data up front.
+	template := s3.PutObjectInput{
+		Bucket: aws.String(bucket),
+	}
+	if v, ok := d.GetOk("cache_control"); ok {
+		template.CacheControl = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk(names.AttrStorageClass); ok {
+		template.StorageClass = types.StorageClass(v.(string))
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, d.Get("parallelism").(int))
+	for _, key := range toUpload {
+		file := filesByKey[key]
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			err := uploadDirectorySyncFile(ctx, uploader, template, file, contentTypes)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if err != nil {
+				errs = append(errs, fmt.Errorf("uploading S3 Object (%s) to Bucket (%s): %w", file.key, bucket, err))
+				return
+			}
+
+			objects[file.key] = manifest[file.key]
+		}()
+	}
+	wg.Wait()
+
+	log.Printf("[DEBUG] Deleting %d S3 Directory Sync (%s) objects", len(toDelete), d.Id())
+	deleted, err := deleteObjectsByKey(ctx, conn, bucket, toDelete)
+	for _, key := range deleted {
+		delete(objects, key)
+	}
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	d.Set("objects", objects)
+
+	if err := errors.Join(errs...); err != nil {
+		return sdkdiag.AppendErrorf(diags, "syncing S3 Directory Sync (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceDirectorySyncRead(ctx, d, meta)...)
+}
+
+func uploadDirectorySyncFile(ctx context.Context, uploader *manager.Uploader, input s3.PutObjectInput, file directorySyncFile, contentTypes map[string]string) error {
+	contentType, err := file.contentType(contentTypes)
+	if err != nil {
+		return err
+	}
+
+	body, err := os.Open(file.path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err := body.Close()
+		if err != nil {
+			log.Printf("[WARN] Error closing S3 Directory Sync source (%s): %s", file.path, err)
+		}
+	}()
+
+	input.Body = body
+	input.ContentType = aws.String(contentType)
+	input.Key = aws.String(file.key)
+
+	_, err = uploader.Upload(ctx, &input)
+
+	return err
+}
+
+func expandDirectorySyncFiles(d sdkv2.ResourceDiffer) ([]directorySyncFile, error) {
+	source := d.Get(names.AttrSource).(string)
+	path, err := homedir.Expand(source)
+	if err != nil {
+		return nil, fmt.Errorf("expanding homedir in source (%s): %w", source, err)
+	}
+
+	return findDirectorySyncFiles(path, d.Get("key_prefix").(string), flex.ExpandStringValueList(d.Get("include").([]interface{})), flex.ExpandStringValueList(d.Get("exclude").([]interface{})))
+}
+
+// findObjectETagsByPrefix returns the ETags of the objects with the key prefix keyed by object key.
+func findObjectETagsByPrefix(ctx context.Context, conn *s3.Client, bucket, keyPrefix string) (map[string]string, error) {
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+	}
+	if keyPrefix != "" {
+		input.Prefix = aws.String(keyPrefix)
+	}
+
+	etags := make(map[string]string)
+	pages := s3.NewListObjectsV2Paginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Contents {
+			// See https://forums.aws.amazon.com/thread.jspa?threadID=44003
+			etags[aws.ToString(v.Key)] = strings.Trim(aws.ToString(v.ETag), `"`)
+		}
+	}
+
+	return etags, nil
+}
+
+// objectETagIsDigest returns whether the ETag of an object is derived from the MD5 digest of its content,
+// which is not the case for objects encrypted with SSE-KMS or SSE-C.
+func objectETagIsDigest(ctx context.Context, conn *s3.Client, bucket, key string) (bool, error) {
+	input := &s3.HeadObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	}
+
+	output, err := findObject(ctx, conn, input)
+
+	if err != nil {
+		return false, err
+	}
+
+	if output.SSECustomerAlgorithm != nil {
+		return false, nil
+	}
+
+	switch output.ServerSideEncryption {
+	case types.ServerSideEncryptionAwsKms, types.ServerSideEncryptionAwsKmsDsse:
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// deleteObjectsByKey deletes the current versions of the objects and returns the keys of the deleted objects.
+func deleteObjectsByKey(ctx context.Context, conn *s3.Client, bucket string, keys []string) ([]string, error) {
+	var deleted []string
+	var errs []error
+
+	for _, chunk := range tfslices.Chunks(keys, deleteObjectsMaxKeys) {
+		input := &s3.DeleteObjectsInput{
+			Bucket: aws.String(bucket),
+			Delete: &types.Delete{
+				Objects: tfslices.ApplyToAll(chunk, func(v string) types.ObjectIdentifier {
+					return types.ObjectIdentifier{
+						Key: aws.String(v),
+					}
+				}),
+				Quiet: aws.Bool(true), // Only report errors.
+			},
+		}
+
+		output, err := conn.DeleteObjects(ctx, input)
+
+		if err != nil {
+			return deleted, err
+		}
+
+		failed := make(map[string]bool, len(output.Errors))
+		for _, v := range output.Errors {
+			failed[aws.ToString(v.Key)] = true
+			errs = append(errs, newDeleteObjectVersionError(v))
+		}
+		for _, key := range chunk {
+			if !failed[key] {
+				deleted = append(deleted, key)
+			}
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return deleted, fmt.Errorf("deleting S3 bucket (%s) objects: %w", bucket, err)
+	}
+
+	return deleted, nil
+}
@@ -0,0 +1,261 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+)
+
+// directorySyncFile is a file in the source directory of a directory sync.
+type directorySyncFile struct {
+	path string // Path on the local file system.
+	key  string // Object key.
+	size int64
+}
+
+// directorySyncGlob compiles a glob pattern matched against slash-separated paths relative to the source directory.
+// "*" and "?" match any characters and any single character other than "/", and "**" matches any characters
+// including "/", so that "**/" matches zero or more directories.
+// Patterns without "/" match file names in any directory.
+func directorySyncGlob(pattern string) *regexp.Regexp {
+	if !strings.Contains(pattern, "/") {
+		pattern = "**/" + pattern
+	}
+
+	var sb strings.Builder
+	sb.WriteString("^")
+	runes := []rune(pattern)
+	for i := 0; i < len(runes); i++ {
+		switch r := runes[i]; {
+		case r == '*' && i+1 < len(runes) && runes[i+1] == '*':
+			i++
+			if i+1 < len(runes) && runes[i+1] == '/' {
+				i++
+				sb.WriteString("(?:.*/)?")
+			} else {
+				sb.WriteString(".*")
+			}
+		case r == '*':
+			sb.WriteString("[^/]*")
+		case r == '?':
+			sb.WriteString("[^/]")
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	sb.WriteString("$")
+
+	return regexp.MustCompile(sb.String())
+}
+
+func directorySyncGlobs(patterns []string) []*regexp.Regexp {
+	globs := make([]*regexp.Regexp, 0, len(patterns))
+	for _, pattern := range patterns {
+		globs = append(globs, directorySyncGlob(pattern))
+	}
+
+	return globs
+}
+
+func matchesAnyGlob(globs []*regexp.Regexp, path string) bool {
+	for _, glob := range globs {
+		if glob.MatchString(path) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// findDirectorySyncFiles returns the regular files in the source directory that match any of the include patterns,
+// or all files if there are none, and none of the exclude patterns. Symbolic links to files are followed.
+func findDirectorySyncFiles(source, keyPrefix string, include, exclude []string) ([]directorySyncFile, error) {
+	includeGlobs, excludeGlobs := directorySyncGlobs(include), directorySyncGlobs(exclude)
+
+	var files []directorySyncFile
+	err := filepath.WalkDir(source, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			return nil
+		}
+
+		info, err := os.Stat(path)
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(source, path)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+
+		if len(includeGlobs) > 0 && !matchesAnyGlob(includeGlobs, rel) {
+			return nil
+		}
+		if matchesAnyGlob(excludeGlobs, rel) {
+			return nil
+		}
+
+		files = append(files, directorySyncFile{
+			path: path,
+			key:  sdkv1CompatibleCleanKey(keyPrefix + rel),
+			size: info.Size(),
+		})
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("reading source directory (%s): %w", source, err)
+	}
+
+	return files, nil
+}
+
+// uploadPartSize returns the part size used by the upload manager for an object of the specified size.
+func uploadPartSize(size, partSize int64) int64 {
+	if size/partSize >= int64(manager.MaxUploadParts) {
+		return size/int64(manager.MaxUploadParts) + 1
+	}
+
+	return partSize
+}
+
+// objectETag returns the ETag of an object uploaded by the upload manager with the specified part size.
+// Objects that fit in a single part have the MD5 digest of their content as ETag. Multipart uploads have the
+// MD5 digest of the concatenated MD5 digests of the parts, followed by "-" and the number of parts.
+// ETags of objects encrypted with SSE-C or SSE-KMS are not MD5 digests.
+func objectETag(r io.Reader, size, partSize int64) (string, error) {
+	partSize = uploadPartSize(size, partSize)
+
+	if size <= partSize {
+		h := md5.New()
+		if _, err := io.Copy(h, r); err != nil {
+			return "", err
+		}
+
+		return hex.EncodeToString(h.Sum(nil)), nil
+	}
+
+	var digests []byte
+	var parts int
+	for {
+		h := md5.New()
+		n, err := io.CopyN(h, r, partSize)
+		if n > 0 {
+			digests = h.Sum(digests)
+			parts++
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+	}
+
+	h := md5.New()
+	h.Write(digests)
+
+	return fmt.Sprintf("%s-%d", hex.EncodeToString(h.Sum(nil)), parts), nil
+}
+
+func (f directorySyncFile) etag(partSize int64) (string, error) {
+	file, err := os.Open(f.path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	etag, err := objectETag(file, f.size, partSize)
+	if err != nil {
+		return "", fmt.Errorf("reading %s: %w", f.path, err)
+	}
+
+	return etag, nil
+}
+
+// contentType returns the content type of the file inferred from its extension or, if the extension is unknown, its content.
+// Overrides are keyed by file extension, including the leading dot.
+func (f directorySyncFile) contentType(overrides map[string]string) (string, error) {
+	ext := strings.ToLower(filepath.Ext(f.path))
+
+	if v, ok := overrides[ext]; ok {
+		return v, nil
+	}
+
+	if v := mime.TypeByExtension(ext); v != "" {
+		return v, nil
+	}
+
+	file, err := os.Open(f.path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	// DetectContentType considers at most the first 512 bytes.
+	head := make([]byte, 512)
+	n, err := io.ReadFull(file, head)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return "", fmt.Errorf("reading %s: %w", f.path, err)
+	}
+
+	return http.DetectContentType(head[:n]), nil
+}
+
+// directorySyncManifest returns the ETags of the files keyed by object key.
+func directorySyncManifest(files []directorySyncFile, partSize int64) (map[string]string, error) {
+	manifest := make(map[string]string, len(files))
+
+	for _, file := range files {
+		etag, err := file.etag(partSize)
+		if err != nil {
+			return nil, err
+		}
+
+		manifest[file.key] = etag
+	}
+
+	return manifest, nil
+}
+
+// diffDirectorySyncManifests returns the keys of the objects to upload and to delete to go from the old manifest to the new one.
+// If uploadAll is set, all objects in the new manifest are uploaded, whether they changed or not.
+func diffDirectorySyncManifests(old, new map[string]string, uploadAll bool) ([]string, []string) {
+	var toUpload, toDelete []string
+
+	for key, etag := range new {
+		if v, ok := old[key]; uploadAll || !ok || v != etag {
+			toUpload = append(toUpload, key)
+		}
+	}
+
+	for key := range old {
+		if _, ok := new[key]; !ok {
+			toDelete = append(toDelete, key)
+		}
+	}
+
+	return toUpload, toDelete
+}
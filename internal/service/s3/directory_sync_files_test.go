@@ -0,0 +1,248 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDirectorySyncGlob(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		pattern  string
+		path     string
+		expected bool
+	}{
+		{"*.html", "index.html", true},
+		{"*.html", "docs/index.html", true},
+		{"*.html", "index.htm", false},
+		{"docs/*.html", "docs/index.html", true},
+		{"docs/*.html", "docs/api/index.html", false},
+		{"docs/**/*.html", "docs/index.html", true},
+		{"docs/**/*.html", "docs/api/v1/index.html", true},
+		{"docs/**", "docs/api/v1/index.html", true},
+		{"docs/**", "doc/index.html", false},
+		{"img/?.png", "img/a.png", true},
+		{"img/?.png", "img/ab.png", false},
+		{"a+b/(c).txt", "a+b/(c).txt", true},
+		{"données/*", "données/é.txt", true},
+	}
+
+	for _, testCase := range testCases {
+		if got, want := directorySyncGlob(testCase.pattern).MatchString(testCase.path), testCase.expected; got != want {
+			t.Errorf("glob %q matches %q = %t, want %t", testCase.pattern, testCase.path, got, want)
+		}
+	}
+}
+
+func TestFindDirectorySyncFiles(t *testing.T) {
+	t.Parallel()
+
+	source := t.TempDir()
+	for _, name := range []string{"index.html", "app.js", "app.js.map", "img/logo.png", "docs/api/index.html", ".git/HEAD"} {
+		path := filepath.Join(source, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(name), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	testCases := map[string]struct {
+		include  []string
+		exclude  []string
+		expected []string
+	}{
+		"all": {
+			expected: []string{"site/.git/HEAD", "site/app.js", "site/app.js.map", "site/docs/api/index.html", "site/img/logo.png", "site/index.html"},
+		},
+		"include": {
+			include:  []string{"*.html", "img/**"},
+			expected: []string{"site/docs/api/index.html", "site/img/logo.png", "site/index.html"},
+		},
+		"exclude": {
+			exclude:  []string{".git/**", "*.map"},
+			expected: []string{"site/app.js", "site/docs/api/index.html", "site/img/logo.png", "site/index.html"},
+		},
+		"include and exclude": {
+			include:  []string{"*.html"},
+			exclude:  []string{"docs/**"},
+			expected: []string{"site/index.html"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			files, err := findDirectorySyncFiles(source, "site/", testCase.include, testCase.exclude)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var keys []string
+			for _, file := range files {
+				keys = append(keys, file.key)
+			}
+			slices.Sort(keys)
+
+			if diff := cmp.Diff(keys, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestObjectETag(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		content  string
+		partSize int64
+		expected string
+	}{
+		"empty": {
+			partSize: 5,
+			expected: "d41d8cd98f00b204e9800998ecf8427e",
+		},
+		"single part": {
+			content:  "hello",
+			partSize: 5,
+			expected: "5d41402abc4b2a76b9719d911017c592",
+		},
+		"multipart": {
+			content:  "helloworld!",
+			partSize: 5,
+			// MD5 of the MD5 digests of "hello", "world" and "!".
+			expected: "0fe6b306db3706d84493a6b930bb5ea8-3",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := objectETag(strings.NewReader(testCase.content), int64(len(testCase.content)), testCase.partSize)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("ETag = %q, want %q", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestUploadPartSize(t *testing.T) {
+	t.Parallel()
+
+	const partSize = 5 * 1024 * 1024
+
+	if got, want := uploadPartSize(100*partSize, partSize), int64(partSize); got != want {
+		t.Errorf("part size = %d, want %d", got, want)
+	}
+	if got, want := uploadPartSize(10_000*partSize, partSize), int64(partSize+1); got != want {
+		t.Errorf("part size = %d, want %d", got, want)
+	}
+}
+
+func TestDirectorySyncFileContentType(t *testing.T) {
+	t.Parallel()
+
+	source := t.TempDir()
+	files := map[string]string{
+		"index.html":  "<p>hello</p>",
+		"module.wasm": "\x00asm",
+		"README":      "<!DOCTYPE html><html></html>",
+		"data":        "plain text",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(source, name), []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	overrides := map[string]string{
+		".wasm": "application/wasm",
+	}
+
+	testCases := map[string]string{
+		"index.html":  "text/html; charset=utf-8",
+		"module.wasm": "application/wasm",
+		"README":      "text/html; charset=utf-8",
+		"data":        "text/plain; charset=utf-8",
+	}
+
+	for name, expected := range testCases {
+		file := directorySyncFile{path: filepath.Join(source, name)}
+
+		got, err := file.contentType(overrides)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if got != expected {
+			t.Errorf("content type of %s = %q, want %q", name, got, expected)
+		}
+	}
+}
+
+func TestDiffDirectorySyncManifests(t *testing.T) {
+	t.Parallel()
+
+	old := map[string]string{
+		"index.html": "a",
+		"app.js":     "b",
+		"old.css":    "c",
+	}
+	new := map[string]string{
+		"index.html": "a",
+		"app.js":     "x",
+		"new.css":    "d",
+	}
+
+	testCases := map[string]struct {
+		uploadAll        bool
+		expectedToUpload []string
+	}{
+		"changed": {
+			expectedToUpload: []string{"app.js", "new.css"},
+		},
+		"upload all": {
+			uploadAll:        true,
+			expectedToUpload: []string{"app.js", "index.html", "new.css"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			toUpload, toDelete := diffDirectorySyncManifests(old, new, testCase.uploadAll)
+			slices.Sort(toUpload)
+
+			if diff := cmp.Diff(toUpload, testCase.expectedToUpload); diff != "" {
+				t.Errorf("unexpected upload diff (+wanted, -got): %s", diff)
+			}
+			if diff := cmp.Diff(toDelete, []string{"old.css"}); diff != "" {
+				t.Errorf("unexpected delete diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
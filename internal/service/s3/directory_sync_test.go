@@ -0,0 +1,353 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3DirectorySync_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory_sync.test"
+	source := testAccDirectorySyncSource(t, map[string]string{
+		"index.html":   "<h1>Hello</h1>",
+		"css/site.css": "body {}",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectorySyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_basic(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectorySyncExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "objects.%", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "objects.index.html", "4b214a5d71afee6f818a599f43db3e56"),
+					resource.TestCheckResourceAttrSet(resourceName, "objects.css/site.css"),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccDirectorySyncWriteFiles(t, source, map[string]string{
+						"index.html": "<h1>Hello, World</h1>",
+						"app.js":     "console.log(1)",
+					})
+					if err := os.Remove(filepath.Join(source, "css", "site.css")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccDirectorySyncConfig_basic(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectorySyncExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "objects.%", acctest.Ct2),
+					resource.TestCheckNoResourceAttr(resourceName, "objects.css/site.css"),
+					resource.TestCheckResourceAttrSet(resourceName, "objects.app.js"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_includeExclude(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory_sync.test"
+	source := testAccDirectorySyncSource(t, map[string]string{
+		"index.html":          "<h1>Hello</h1>",
+		"docs/index.html":     "<h1>Docs</h1>",
+		"docs/draft.html":     "<h1>Draft</h1>",
+		"img/logo.svg":        "<svg></svg>",
+		"node_modules/x/a.js": "module.exports = 1",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectorySyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_includeExclude(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectorySyncExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "objects.%", acctest.Ct3),
+					resource.TestCheckResourceAttrSet(resourceName, "objects.site/index.html"),
+					resource.TestCheckResourceAttrSet(resourceName, "objects.site/docs/index.html"),
+					resource.TestCheckResourceAttrSet(resourceName, "objects.site/img/logo.svg"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_cacheControl(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory_sync.test"
+	source := testAccDirectorySyncSource(t, map[string]string{
+		"index.html":   "<h1>Hello</h1>",
+		"css/site.css": "body {}",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectorySyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_cacheControl(rName, source, "max-age=300"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectorySyncExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "objects.%", acctest.Ct2),
+				),
+			},
+			{
+				PreConfig: func() {
+					if err := os.Remove(filepath.Join(source, "css", "site.css")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccDirectorySyncConfig_cacheControl(rName, source, "max-age=600"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectorySyncExists(ctx, resourceName),
+					testAccCheckDirectorySyncObjectNotExists(ctx, resourceName, "css/site.css"),
+					resource.TestCheckResourceAttr(resourceName, "objects.%", acctest.Ct1),
+					resource.TestCheckNoResourceAttr(resourceName, "objects.css/site.css"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_drift(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory_sync.test"
+	source := testAccDirectorySyncSource(t, map[string]string{
+		"index.html": "<h1>Hello</h1>",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectorySyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_basic(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectorySyncExists(ctx, resourceName),
+					testAccCheckDirectorySyncDeleteObjects(ctx, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccDirectorySyncConfig_basic(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectorySyncExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "objects.%", acctest.Ct1),
+				),
+			},
+		},
+	})
+}
+
+func testAccDirectorySyncSource(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	source := t.TempDir()
+	testAccDirectorySyncWriteFiles(t, source, files)
+
+	return source
+}
+
+func testAccDirectorySyncWriteFiles(t *testing.T, source string, files map[string]string) {
+	t.Helper()
+
+	for name, content := range files {
+		path := filepath.Join(source, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// testAccCheckDirectorySyncExists checks that the objects in state exist with the recorded ETags.
+func testAccCheckDirectorySyncExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		etags, err := tfs3.FindObjectETagsByPrefix(ctx, conn, rs.Primary.Attributes[names.AttrBucket], rs.Primary.Attributes["key_prefix"])
+
+		if err != nil {
+			return err
+		}
+
+		objects := testAccDirectorySyncObjects(rs)
+		for key, etag := range objects {
+			if v, ok := etags[key]; !ok {
+				return fmt.Errorf("S3 Directory Sync (%s) object %s not found", rs.Primary.ID, key)
+			} else if v != etag {
+				return fmt.Errorf("S3 Directory Sync (%s) object %s ETag is %s, expected %s", rs.Primary.ID, key, v, etag)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDirectorySyncObjectNotExists(ctx context.Context, n, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		etags, err := tfs3.FindObjectETagsByPrefix(ctx, conn, rs.Primary.Attributes[names.AttrBucket], rs.Primary.Attributes["key_prefix"])
+
+		if err != nil {
+			return err
+		}
+
+		if _, ok := etags[key]; ok {
+			return fmt.Errorf("S3 Directory Sync (%s) object %s still exists", rs.Primary.ID, key)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDirectorySyncDeleteObjects(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		for key := range testAccDirectorySyncObjects(rs) {
+			if _, err := tfs3.DeleteAllObjectVersions(ctx, conn, rs.Primary.Attributes[names.AttrBucket], key, true, false); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDirectorySyncDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_s3_directory_sync" {
+				continue
+			}
+
+			etags, err := tfs3.FindObjectETagsByPrefix(ctx, conn, rs.Primary.Attributes[names.AttrBucket], rs.Primary.Attributes["key_prefix"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			for key := range testAccDirectorySyncObjects(rs) {
+				if _, ok := etags[key]; ok {
+					return fmt.Errorf("S3 Directory Sync (%s) object %s still exists", rs.Primary.ID, key)
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+// testAccDirectorySyncObjects returns the objects map from the flattened state attributes.
+func testAccDirectorySyncObjects(rs *terraform.ResourceState) map[string]string {
+	objects := make(map[string]string)
+
+	for k, v := range rs.Primary.Attributes {
+		if key, ok := strings.CutPrefix(k, "objects."); ok && key != "%" {
+			objects[key] = v
+		}
+	}
+
+	return objects
+}
+
+func testAccDirectorySyncConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+`, rName)
+}
+
+func testAccDirectorySyncConfig_basic(rName, source string) string {
+	return acctest.ConfigCompose(testAccDirectorySyncConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_directory_sync" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  source = %[1]q
+}
+`, source))
+}
+
+func testAccDirectorySyncConfig_cacheControl(rName, source, cacheControl string) string {
+	return acctest.ConfigCompose(testAccDirectorySyncConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_directory_sync" "test" {
+  bucket        = aws_s3_bucket.test.bucket
+  source        = %[1]q
+  cache_control = %[2]q
+}
+`, source, cacheControl))
+}
+
+func testAccDirectorySyncConfig_includeExclude(rName, source string) string {
+	return acctest.ConfigCompose(testAccDirectorySyncConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_directory_sync" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  source     = %[1]q
+  key_prefix = "site/"
+
+  include = ["*.html", "*.svg"]
+  exclude = ["**/draft.html", "node_modules/**"]
+
+  cache_control = "max-age=300"
+}
+`, source))
+}
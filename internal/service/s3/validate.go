@@ -0,0 +1,509 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Lifecycle and replication rules are validated against the raw configuration so that
+// unknown values can be skipped and arguments that are not set can be told apart from zero values.
+
+const (
+	// Objects must be stored for at least 30 days before transitioning to, and after transitioning to, S3 Standard-IA or S3 One Zone-IA.
+	// https://docs.aws.amazon.com/AmazonS3/latest/userguide/lifecycle-transition-general-considerations.html#lifecycle-configuration-constraints
+	lifecycleInfrequentAccessMinimumDays = 30
+)
+
+// transitionStorageClassOrder is the order in which lifecycle transitions can move objects between storage classes.
+// https://docs.aws.amazon.com/AmazonS3/latest/userguide/lifecycle-transition-general-considerations.html#lifecycle-general-considerations-transition-sc
+var transitionStorageClassOrder = []types.TransitionStorageClass{
+	types.TransitionStorageClassStandardIa,
+	types.TransitionStorageClassIntelligentTiering,
+	types.TransitionStorageClassOnezoneIa,
+	types.TransitionStorageClassGlacierIr,
+	types.TransitionStorageClassGlacier,
+	types.TransitionStorageClassDeepArchive,
+}
+
+func isInfrequentAccessStorageClass(storageClass types.TransitionStorageClass) bool {
+	return storageClass == types.TransitionStorageClassStandardIa || storageClass == types.TransitionStorageClassOnezoneIa
+}
+
+// validateLifecycleRules validates the semantics of the rules of a lifecycle configuration.
+func validateLifecycleRules(rules cty.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ids := make(map[string]int)
+	for i, rule := range configElements(rules) {
+		path := cty.GetAttrPath(names.AttrRule).IndexInt(i)
+
+		if id, ok := configString(configAttr(rule, names.AttrID)); ok {
+			if j, ok := ids[id]; ok {
+				diags = append(diags, errs.NewInvalidValueAttributeErrorf(path.GetAttr(names.AttrID), "Rule ID %q is already used by rule %d.", id, j))
+			} else {
+				ids[id] = i
+			}
+		}
+
+		diags = append(diags, validateLifecycleRule(path, rule)...)
+	}
+
+	return diags
+}
+
+func validateLifecycleRule(path cty.Path, rule cty.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	filter, hasFilter := configBlock(configAttr(rule, names.AttrFilter))
+	if prefix, ok := configString(configAttr(rule, names.AttrPrefix)); ok && prefix != "" && hasFilter {
+		diags = append(diags, newAttributeConflictsError(path.GetAttr(names.AttrPrefix), path.GetAttr(names.AttrFilter)))
+	}
+
+	var tagFilter bool
+	if hasFilter {
+		filterPath := path.GetAttr(names.AttrFilter).IndexInt(0)
+		and, hasAnd := configBlock(configAttr(filter, "and"))
+		_, hasTag := configBlock(configAttr(filter, "tag"))
+		greaterThan, hasGreaterThan := configInt(configAttr(filter, "object_size_greater_than"))
+		lessThan, hasLessThan := configInt(configAttr(filter, "object_size_less_than"))
+		prefix, _ := configString(configAttr(filter, names.AttrPrefix))
+
+		var specified []string
+		for _, v := range []struct {
+			name string
+			ok   bool
+		}{
+			{"and", hasAnd},
+			{"object_size_greater_than", hasGreaterThan},
+			{"object_size_less_than", hasLessThan},
+			{names.AttrPrefix, prefix != ""},
+			{"tag", hasTag},
+		} {
+			if v.ok {
+				specified = append(specified, v.name)
+			}
+		}
+		if len(specified) > 1 {
+			diags = append(diags, errs.NewInvalidValueAttributeErrorf(filterPath, "Only one of %s can be specified. Use \"and\" to combine conditions.", strings.Join(specified, ", ")))
+		}
+
+		if hasAnd {
+			greaterThan, hasGreaterThan = configInt(configAttr(and, "object_size_greater_than"))
+			lessThan, hasLessThan = configInt(configAttr(and, "object_size_less_than"))
+			filterPath = filterPath.GetAttr("and").IndexInt(0)
+
+			tags := configAttr(and, names.AttrTags)
+			tagFilter = tags.IsKnown() && !tags.IsNull() && tags.LengthInt() > 0
+		}
+		if hasGreaterThan && hasLessThan && greaterThan > 0 && lessThan > 0 && greaterThan >= lessThan {
+			diags = append(diags, errs.NewInvalidValueAttributeErrorf(filterPath.GetAttr("object_size_less_than"), "Must be greater than object_size_greater_than (%d).", greaterThan))
+		}
+
+		tagFilter = tagFilter || hasTag
+	}
+
+	expirationPath := path.GetAttr("expiration").IndexInt(0)
+	expiration, hasExpiration := configBlock(configAttr(rule, "expiration"))
+	expirationDays, hasExpirationDays := configInt(configAttr(expiration, "days"))
+	hasExpirationDays = hasExpirationDays && expirationDays > 0
+	expirationDate, hasExpirationDate := configDate(configAttr(expiration, "date"))
+	if hasExpiration {
+		if hasExpirationDays && hasExpirationDate {
+			diags = append(diags, newAttributeConflictsError(expirationPath.GetAttr("days"), expirationPath.GetAttr("date")))
+		}
+		if v, ok := configBool(configAttr(expiration, "expired_object_delete_marker")); ok && v {
+			switch {
+			case hasExpirationDays:
+				diags = append(diags, newAttributeConflictsError(expirationPath.GetAttr("expired_object_delete_marker"), expirationPath.GetAttr("days")))
+			case hasExpirationDate:
+				diags = append(diags, newAttributeConflictsError(expirationPath.GetAttr("expired_object_delete_marker"), expirationPath.GetAttr("date")))
+			case tagFilter:
+				diags = append(diags, errs.NewInvalidValueAttributeError(expirationPath.GetAttr("expired_object_delete_marker"), "Cannot be specified with a tag-based filter."))
+			}
+		}
+	}
+
+	if _, ok := configBlock(configAttr(rule, "abort_incomplete_multipart_upload")); ok && tagFilter {
+		diags = append(diags, errs.NewInvalidValueAttributeError(path.GetAttr("abort_incomplete_multipart_upload"), "Cannot be specified with a tag-based filter."))
+	}
+
+	var transitions []lifecycleTransition
+	for _, v := range configElements(configAttr(rule, "transition")) {
+		transition := lifecycleTransition{}
+		transition.storageClass, transition.known = configTransitionStorageClass(configAttr(v, names.AttrStorageClass))
+		transition.date, transition.hasDate = configDate(configAttr(v, "date"))
+		days := configAttr(v, "days")
+		transition.days, transition.hasDays = configInt(days)
+		transition.known = transition.known && configAttr(v, "date").IsKnown() && days.IsKnown()
+		transitions = append(transitions, transition)
+	}
+	var limit *lifecycleTransition
+	if hasExpirationDays || hasExpirationDate {
+		limit = &lifecycleTransition{days: expirationDays, hasDays: hasExpirationDays, date: expirationDate, hasDate: hasExpirationDate}
+	}
+	diags = append(diags, validateLifecycleTransitions(path.GetAttr("transition"), "days", transitions, limit)...)
+
+	var noncurrentTransitions []lifecycleTransition
+	for _, v := range configElements(configAttr(rule, "noncurrent_version_transition")) {
+		transition := lifecycleTransition{}
+		transition.storageClass, transition.known = configTransitionStorageClass(configAttr(v, names.AttrStorageClass))
+		days := configAttr(v, "noncurrent_days")
+		transition.days, transition.hasDays = configInt(days)
+		transition.known = transition.known && days.IsKnown()
+		noncurrentTransitions = append(noncurrentTransitions, transition)
+	}
+	limit = nil
+	if noncurrentExpiration, ok := configBlock(configAttr(rule, "noncurrent_version_expiration")); ok {
+		if v, ok := configInt(configAttr(noncurrentExpiration, "noncurrent_days")); ok && v > 0 {
+			limit = &lifecycleTransition{days: v, hasDays: true}
+		}
+	}
+	diags = append(diags, validateLifecycleTransitions(path.GetAttr("noncurrent_version_transition"), "noncurrent_days", noncurrentTransitions, limit)...)
+
+	return diags
+}
+
+type lifecycleTransition struct {
+	storageClass types.TransitionStorageClass
+	days         int64
+	hasDays      bool
+	date         time.Time
+	hasDate      bool
+	known        bool
+}
+
+// when returns a sortable representation of the time at which the transition happens.
+func (t lifecycleTransition) when() int64 {
+	if t.hasDate {
+		return t.date.Unix()
+	}
+
+	return t.days
+}
+
+func (t lifecycleTransition) String() string {
+	if t.hasDate {
+		return t.date.Format(time.RFC3339)
+	}
+
+	return strconv.FormatInt(t.days, 10) + " days"
+}
+
+// validateLifecycleTransitions validates the transitions of a rule against each other and against the rule's expiration.
+func validateLifecycleTransitions(path cty.Path, daysAttribute string, transitions []lifecycleTransition, expiration *lifecycleTransition) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if slices.ContainsFunc(transitions, func(t lifecycleTransition) bool { return !t.known }) {
+		return diags
+	}
+
+	var dateBased, daysBased int
+	for _, t := range transitions {
+		switch {
+		case t.hasDate && t.hasDays:
+			diags = append(diags, errs.NewInvalidValueAttributeErrorf(path, "Transition to %s: only one of date or %s can be specified.", t.storageClass, daysAttribute))
+		case t.hasDate:
+			dateBased++
+		default:
+			daysBased++
+		}
+	}
+	if dateBased > 0 && daysBased > 0 {
+		diags = append(diags, errs.NewInvalidValueAttributeErrorf(path, "Date-based and %s-based transitions cannot be mixed in a rule.", daysAttribute))
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	for _, t := range transitions {
+		if !t.hasDate && isInfrequentAccessStorageClass(t.storageClass) && t.days < lifecycleInfrequentAccessMinimumDays {
+			diags = append(diags, errs.NewInvalidValueAttributeErrorf(path, "Transition to %s: %s must be at least %d, got %d.", t.storageClass, daysAttribute, lifecycleInfrequentAccessMinimumDays, t.days))
+		}
+	}
+
+	slices.SortStableFunc(transitions, func(a, b lifecycleTransition) int {
+		if a.when() != b.when() {
+			return cmp.Compare(a.when(), b.when())
+		}
+
+		return cmp.Compare(slices.Index(transitionStorageClassOrder, a.storageClass), slices.Index(transitionStorageClassOrder, b.storageClass))
+	})
+
+	for i := 1; i < len(transitions); i++ {
+		previous, t := transitions[i-1], transitions[i]
+
+		switch {
+		case previous.storageClass == t.storageClass:
+			diags = append(diags, errs.NewInvalidValueAttributeErrorf(path, "Only one transition to %s can be specified.", t.storageClass))
+		case previous.when() == t.when():
+			diags = append(diags, errs.NewInvalidValueAttributeErrorf(path, "Transitions to %s and %s cannot both happen at %s.", previous.storageClass, t.storageClass, t))
+		case slices.Index(transitionStorageClassOrder, t.storageClass) < slices.Index(transitionStorageClassOrder, previous.storageClass):
+			diags = append(diags, errs.NewInvalidValueAttributeErrorf(path, "Transition to %s at %s cannot follow transition to %s at %s. Objects can only transition to storage classes in the order %s.", t.storageClass, t, previous.storageClass, previous, strings.Join(transitionStorageClassOrderValues(), ", ")))
+		case !t.hasDate && isInfrequentAccessStorageClass(previous.storageClass) && t.days < previous.days+lifecycleInfrequentAccessMinimumDays:
+			diags = append(diags, errs.NewInvalidValueAttributeErrorf(path, "Transition to %s: %s must be at least %d days after the transition to %s (%d).", t.storageClass, daysAttribute, lifecycleInfrequentAccessMinimumDays, previous.storageClass, previous.days))
+		}
+	}
+
+	if expiration != nil && len(transitions) > 0 {
+		last := transitions[len(transitions)-1]
+
+		if expiration.hasDate == last.hasDate && expiration.when() < last.when() {
+			diags = append(diags, errs.NewInvalidValueAttributeErrorf(path, "Transition to %s at %s cannot happen after the expiration at %s.", last.storageClass, last, expiration))
+		}
+	}
+
+	return diags
+}
+
+func transitionStorageClassOrderValues() []string {
+	values := make([]string, 0, len(transitionStorageClassOrder))
+	for _, v := range transitionStorageClassOrder {
+		values = append(values, string(v))
+	}
+
+	return values
+}
+
+// validateReplicationRules validates the semantics of the rules of a replication configuration.
+func validateReplicationRules(rules cty.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ids := make(map[string]int)
+	priorities := make(map[int64]int)
+	var v1Rules, v2Rules []int
+	for i, rule := range configElements(rules) {
+		path := cty.GetAttrPath(names.AttrRule).IndexInt(i)
+
+		if id, ok := configString(configAttr(rule, names.AttrID)); ok && id != "" {
+			if j, ok := ids[id]; ok {
+				diags = append(diags, errs.NewInvalidValueAttributeErrorf(path.GetAttr(names.AttrID), "Rule ID %q is already used by rule %d.", id, j))
+			} else {
+				ids[id] = i
+			}
+		}
+
+		filterValue := configAttr(rule, names.AttrFilter)
+		if !filterValue.IsKnown() {
+			continue
+		}
+
+		filter, hasFilter := configBlock(filterValue)
+		if !hasFilter {
+			// XML schema V1.
+			v1Rules = append(v1Rules, i)
+
+			if _, ok := configBlock(configAttr(rule, "delete_marker_replication")); ok {
+				diags = append(diags, newAttributeRequiredWithError(path.GetAttr(names.AttrFilter), path.GetAttr("delete_marker_replication")))
+			}
+
+			continue
+		}
+
+		// XML schema V2.
+		v2Rules = append(v2Rules, i)
+
+		if prefix, ok := configString(configAttr(rule, names.AttrPrefix)); ok && prefix != "" {
+			diags = append(diags, newAttributeConflictsError(path.GetAttr(names.AttrPrefix), path.GetAttr(names.AttrFilter)))
+		}
+
+		priorityValue := configAttr(rule, names.AttrPriority)
+		if priorityValue.IsKnown() {
+			// The priority defaults to 0.
+			priority, _ := configInt(priorityValue)
+			if j, ok := priorities[priority]; ok {
+				diags = append(diags, errs.NewInvalidValueAttributeErrorf(path.GetAttr(names.AttrPriority), "Priority %d is already used by rule %d.", priority, j))
+			} else {
+				priorities[priority] = i
+			}
+		}
+
+		filterPath := path.GetAttr(names.AttrFilter).IndexInt(0)
+		and, hasAnd := configBlock(configAttr(filter, "and"))
+		_, hasTag := configBlock(configAttr(filter, "tag"))
+		prefix, _ := configString(configAttr(filter, names.AttrPrefix))
+
+		var specified []string
+		for _, v := range []struct {
+			name string
+			ok   bool
+		}{
+			{"and", hasAnd},
+			{names.AttrPrefix, prefix != ""},
+			{"tag", hasTag},
+		} {
+			if v.ok {
+				specified = append(specified, v.name)
+			}
+		}
+		if len(specified) > 1 {
+			diags = append(diags, errs.NewInvalidValueAttributeErrorf(filterPath, "Only one of %s can be specified. Use \"and\" to combine conditions.", strings.Join(specified, ", ")))
+		}
+
+		tagFilter := hasTag
+		if hasAnd {
+			tags := configAttr(and, names.AttrTags)
+			tagFilter = tagFilter || (tags.IsKnown() && !tags.IsNull() && tags.LengthInt() > 0)
+		}
+
+		deleteMarkerReplicationValue := configAttr(rule, "delete_marker_replication")
+		if !deleteMarkerReplicationValue.IsKnown() {
+			continue
+		}
+		deleteMarkerReplicationPath := path.GetAttr("delete_marker_replication")
+		deleteMarkerReplication, ok := configBlock(deleteMarkerReplicationValue)
+		if !ok {
+			diags = append(diags, newAttributeRequiredWithError(deleteMarkerReplicationPath, path.GetAttr(names.AttrFilter)))
+			continue
+		}
+		if status, ok := configString(configAttr(deleteMarkerReplication, names.AttrStatus)); ok && status == string(types.DeleteMarkerReplicationStatusEnabled) && tagFilter {
+			diags = append(diags, errs.NewInvalidValueAttributeErrorf(deleteMarkerReplicationPath.IndexInt(0).GetAttr(names.AttrStatus), "Delete marker replication must be %s for rules with a tag-based filter.", types.DeleteMarkerReplicationStatusDisabled))
+		}
+	}
+
+	if len(v1Rules) > 0 && len(v2Rules) > 0 {
+		diags = append(diags, errs.NewInvalidValueAttributeErrorf(cty.GetAttrPath(names.AttrRule).IndexInt(v1Rules[0]), "Either all or no rules must specify filter. Rule %d specifies filter.", v2Rules[0]))
+	}
+
+	for i, rule := range configElements(rules) {
+		destination, ok := configBlock(configAttr(rule, names.AttrDestination))
+		if !ok {
+			continue
+		}
+
+		replicationTime, ok := configBlock(configAttr(destination, "replication_time"))
+		if !ok {
+			continue
+		}
+		if status, ok := configString(configAttr(replicationTime, names.AttrStatus)); !ok || status != string(types.ReplicationTimeStatusEnabled) {
+			continue
+		}
+
+		metricsValue := configAttr(destination, "metrics")
+		if !metricsValue.IsKnown() {
+			continue
+		}
+		metricsPath := cty.GetAttrPath(names.AttrRule).IndexInt(i).GetAttr(names.AttrDestination).IndexInt(0).GetAttr("metrics")
+		metrics, ok := configBlock(metricsValue)
+		status, known := configString(configAttr(metrics, names.AttrStatus))
+		if !ok || (known && status != string(types.MetricsStatusEnabled)) {
+			diags = append(diags, errs.NewInvalidValueAttributeErrorf(metricsPath, "Metrics must be %s when replication_time is %s.", types.MetricsStatusEnabled, types.ReplicationTimeStatusEnabled))
+		}
+	}
+
+	return diags
+}
+
+func newAttributeConflictsError(path, otherPath cty.Path) diag.Diagnostic {
+	return errs.NewAttributeErrorDiagnostic(
+		path,
+		"Invalid Attribute Combination",
+		fmt.Sprintf("Attribute %q cannot be specified when %q is specified.", errs.PathString(path), errs.PathString(otherPath)),
+	)
+}
+
+func newAttributeRequiredWithError(path, otherPath cty.Path) diag.Diagnostic {
+	return errs.NewAttributeErrorDiagnostic(
+		otherPath,
+		"Invalid Attribute Combination",
+		fmt.Sprintf("Attribute %q must be specified when %q is specified.", errs.PathString(path), errs.PathString(otherPath)),
+	)
+}
+
+// configAttr returns the named attribute of an object in the raw configuration.
+// A null value is returned if the object is null or doesn't have the attribute.
+func configAttr(v cty.Value, name string) cty.Value {
+	switch {
+	case !v.IsKnown():
+		return cty.DynamicVal
+	case v.IsNull(), !v.Type().IsObjectType(), !v.Type().HasAttribute(name):
+		return cty.NullVal(cty.DynamicPseudoType)
+	}
+
+	return v.GetAttr(name)
+}
+
+// configElements returns the elements of a known list or set in the raw configuration.
+func configElements(v cty.Value) []cty.Value {
+	if !v.IsKnown() || v.IsNull() || !v.CanIterateElements() {
+		return nil
+	}
+
+	var elements []cty.Value
+	for it := v.ElementIterator(); it.Next(); {
+		_, element := it.Element()
+		elements = append(elements, element)
+	}
+
+	return elements
+}
+
+// configBlock returns the single block of a known list or set nested block in the raw configuration.
+func configBlock(v cty.Value) (cty.Value, bool) {
+	if elements := configElements(v); len(elements) > 0 {
+		return elements[0], true
+	}
+
+	return cty.NilVal, false
+}
+
+func configString(v cty.Value) (string, bool) {
+	if !v.IsKnown() || v.IsNull() || v.Type() != cty.String {
+		return "", false
+	}
+
+	return v.AsString(), true
+}
+
+// configInt returns the value of a known number, or of a string containing a number as used by nullable types.
+func configInt(v cty.Value) (int64, bool) {
+	if !v.IsKnown() || v.IsNull() {
+		return 0, false
+	}
+
+	switch v.Type() {
+	case cty.Number:
+		i, _ := v.AsBigFloat().Int64()
+		return i, true
+	case cty.String:
+		i, err := strconv.ParseInt(v.AsString(), 10, 64)
+		return i, err == nil
+	}
+
+	return 0, false
+}
+
+func configBool(v cty.Value) (bool, bool) {
+	if !v.IsKnown() || v.IsNull() || v.Type() != cty.Bool {
+		return false, false
+	}
+
+	return v.True(), true
+}
+
+func configDate(v cty.Value) (time.Time, bool) {
+	s, ok := configString(v)
+	if !ok || s == "" {
+		return time.Time{}, false
+	}
+
+	t, err := time.Parse(time.RFC3339, s)
+	return t, err == nil
+}
+
+func configTransitionStorageClass(v cty.Value) (types.TransitionStorageClass, bool) {
+	s, ok := configString(v)
+	return types.TransitionStorageClass(s), ok
+}
@@ -0,0 +1,332 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+func TestValidateLifecycleRules(t *testing.T) {
+	t.Parallel()
+
+	transition := func(days int64, storageClass string) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"date":          cty.NullVal(cty.String),
+			"days":          cty.NumberIntVal(days),
+			"storage_class": cty.StringVal(storageClass),
+		})
+	}
+
+	testCases := map[string]struct {
+		rules         []cty.Value
+		expectedError string
+	}{
+		"valid": {
+			rules: []cty.Value{
+				cty.ObjectVal(map[string]cty.Value{
+					"id": cty.StringVal("a"),
+					"filter": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+						"prefix": cty.StringVal("logs/"),
+					})}),
+					"transition": cty.SetVal([]cty.Value{
+						transition(30, "STANDARD_IA"),
+						transition(60, "GLACIER"),
+						transition(180, "DEEP_ARCHIVE"),
+					}),
+					"expiration": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+						"days": cty.NumberIntVal(365),
+					})}),
+				}),
+				cty.ObjectVal(map[string]cty.Value{
+					"id": cty.StringVal("b"),
+				}),
+			},
+		},
+		"duplicate id": {
+			rules: []cty.Value{
+				cty.ObjectVal(map[string]cty.Value{"id": cty.StringVal("a")}),
+				cty.ObjectVal(map[string]cty.Value{"id": cty.StringVal("a")}),
+			},
+			expectedError: `Rule ID "a" is already used by rule 0.`,
+		},
+		"prefix and filter": {
+			rules: []cty.Value{
+				cty.ObjectVal(map[string]cty.Value{
+					"prefix": cty.StringVal("logs/"),
+					"filter": cty.ListVal([]cty.Value{cty.EmptyObjectVal}),
+				}),
+			},
+			expectedError: `"rule[0].prefix" cannot be specified when "rule[0].filter" is specified`,
+		},
+		"multiple filter conditions": {
+			rules: []cty.Value{
+				cty.ObjectVal(map[string]cty.Value{
+					"filter": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+						"object_size_greater_than": cty.StringVal("100"),
+						"prefix":                   cty.StringVal("logs/"),
+					})}),
+				}),
+			},
+			expectedError: "Only one of object_size_greater_than, prefix can be specified.",
+		},
+		"object size range": {
+			rules: []cty.Value{
+				cty.ObjectVal(map[string]cty.Value{
+					"filter": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+						"and": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+							"object_size_greater_than": cty.NumberIntVal(500),
+							"object_size_less_than":    cty.NumberIntVal(100),
+						})}),
+					})}),
+				}),
+			},
+			expectedError: "Must be greater than object_size_greater_than (500).",
+		},
+		"expired object delete marker with tag filter": {
+			rules: []cty.Value{
+				cty.ObjectVal(map[string]cty.Value{
+					"filter": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+						"tag": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+							"key": cty.StringVal("k"),
+						})}),
+					})}),
+					"expiration": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+						"expired_object_delete_marker": cty.True,
+					})}),
+				}),
+			},
+			expectedError: "Cannot be specified with a tag-based filter.",
+		},
+		"standard ia minimum": {
+			rules: []cty.Value{
+				cty.ObjectVal(map[string]cty.Value{
+					"transition": cty.SetVal([]cty.Value{transition(7, "STANDARD_IA")}),
+				}),
+			},
+			expectedError: "Transition to STANDARD_IA: days must be at least 30, got 7.",
+		},
+		"storage class order": {
+			rules: []cty.Value{
+				cty.ObjectVal(map[string]cty.Value{
+					"transition": cty.SetVal([]cty.Value{
+						transition(30, "GLACIER"),
+						transition(90, "STANDARD_IA"),
+					}),
+				}),
+			},
+			expectedError: "Transition to STANDARD_IA at 90 days cannot follow transition to GLACIER at 30 days.",
+		},
+		"after infrequent access": {
+			rules: []cty.Value{
+				cty.ObjectVal(map[string]cty.Value{
+					"transition": cty.SetVal([]cty.Value{
+						transition(30, "STANDARD_IA"),
+						transition(45, "GLACIER"),
+					}),
+				}),
+			},
+			expectedError: "Transition to GLACIER: days must be at least 30 days after the transition to STANDARD_IA (30).",
+		},
+		"same day": {
+			rules: []cty.Value{
+				cty.ObjectVal(map[string]cty.Value{
+					"transition": cty.SetVal([]cty.Value{
+						transition(0, "INTELLIGENT_TIERING"),
+						transition(0, "GLACIER"),
+					}),
+				}),
+			},
+			expectedError: "Transitions to INTELLIGENT_TIERING and GLACIER cannot both happen at 0 days.",
+		},
+		"transition after expiration": {
+			rules: []cty.Value{
+				cty.ObjectVal(map[string]cty.Value{
+					"transition": cty.SetVal([]cty.Value{transition(90, "GLACIER")}),
+					"expiration": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+						"days": cty.NumberIntVal(60),
+					})}),
+				}),
+			},
+			expectedError: "Transition to GLACIER at 90 days cannot happen after the expiration at 60 days.",
+		},
+		"noncurrent standard ia minimum": {
+			rules: []cty.Value{
+				cty.ObjectVal(map[string]cty.Value{
+					"noncurrent_version_transition": cty.SetVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+						"noncurrent_days": cty.NumberIntVal(10),
+						"storage_class":   cty.StringVal("ONEZONE_IA"),
+					})}),
+				}),
+			},
+			expectedError: "Transition to ONEZONE_IA: noncurrent_days must be at least 30, got 10.",
+		},
+		"unknown": {
+			rules: []cty.Value{
+				cty.ObjectVal(map[string]cty.Value{
+					"transition": cty.SetVal([]cty.Value{
+						transition(30, "GLACIER"),
+						cty.ObjectVal(map[string]cty.Value{
+							"date":          cty.NullVal(cty.String),
+							"days":          cty.UnknownVal(cty.Number),
+							"storage_class": cty.StringVal("STANDARD_IA"),
+						}),
+					}),
+				}),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := validateLifecycleRules(cty.TupleVal(testCase.rules))
+
+			checkValidateDiagnostics(t, diags, testCase.expectedError)
+		})
+	}
+}
+
+func TestValidateReplicationRules(t *testing.T) {
+	t.Parallel()
+
+	deleteMarkerReplication := func(status string) cty.Value {
+		return cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+			"status": cty.StringVal(status),
+		})})
+	}
+	prefixFilter := func(prefix string) cty.Value {
+		return cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+			"prefix": cty.StringVal(prefix),
+		})})
+	}
+
+	testCases := map[string]struct {
+		rules         []cty.Value
+		expectedError string
+	}{
+		"valid": {
+			rules: []cty.Value{
+				cty.ObjectVal(map[string]cty.Value{
+					"id":                        cty.StringVal("a"),
+					"priority":                  cty.NumberIntVal(1),
+					"filter":                    prefixFilter("a/"),
+					"delete_marker_replication": deleteMarkerReplication("Enabled"),
+				}),
+				cty.ObjectVal(map[string]cty.Value{
+					"id":                        cty.StringVal("b"),
+					"priority":                  cty.NumberIntVal(2),
+					"filter":                    prefixFilter("b/"),
+					"delete_marker_replication": deleteMarkerReplication("Disabled"),
+				}),
+			},
+		},
+		"duplicate priority": {
+			rules: []cty.Value{
+				cty.ObjectVal(map[string]cty.Value{
+					"priority":                  cty.NumberIntVal(1),
+					"filter":                    prefixFilter("a/"),
+					"delete_marker_replication": deleteMarkerReplication("Disabled"),
+				}),
+				cty.ObjectVal(map[string]cty.Value{
+					"priority":                  cty.NumberIntVal(1),
+					"filter":                    prefixFilter("b/"),
+					"delete_marker_replication": deleteMarkerReplication("Disabled"),
+				}),
+			},
+			expectedError: "Priority 1 is already used by rule 0.",
+		},
+		"mixed schema versions": {
+			rules: []cty.Value{
+				cty.ObjectVal(map[string]cty.Value{
+					"prefix": cty.StringVal("a/"),
+				}),
+				cty.ObjectVal(map[string]cty.Value{
+					"filter":                    prefixFilter("b/"),
+					"delete_marker_replication": deleteMarkerReplication("Disabled"),
+				}),
+			},
+			expectedError: "Either all or no rules must specify filter. Rule 1 specifies filter.",
+		},
+		"missing delete marker replication": {
+			rules: []cty.Value{
+				cty.ObjectVal(map[string]cty.Value{
+					"filter": prefixFilter("a/"),
+				}),
+			},
+			expectedError: `"rule[0].delete_marker_replication" must be specified when "rule[0].filter" is specified`,
+		},
+		"delete marker replication with tag filter": {
+			rules: []cty.Value{
+				cty.ObjectVal(map[string]cty.Value{
+					"filter": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+						"and": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+							"prefix": cty.StringVal("a/"),
+							"tags":   cty.MapVal(map[string]cty.Value{"k": cty.StringVal("v")}),
+						})}),
+					})}),
+					"delete_marker_replication": deleteMarkerReplication("Enabled"),
+				}),
+			},
+			expectedError: "Delete marker replication must be Disabled for rules with a tag-based filter.",
+		},
+		"replication time without metrics": {
+			rules: []cty.Value{
+				cty.ObjectVal(map[string]cty.Value{
+					"destination": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+						"replication_time": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+							"status": cty.StringVal("Enabled"),
+						})}),
+						"metrics": cty.ListValEmpty(cty.EmptyObject),
+					})}),
+				}),
+			},
+			expectedError: "Metrics must be Enabled when replication_time is Enabled.",
+		},
+		"unknown filter": {
+			rules: []cty.Value{
+				cty.ObjectVal(map[string]cty.Value{
+					"filter": cty.UnknownVal(cty.List(cty.EmptyObject)),
+				}),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := validateReplicationRules(cty.TupleVal(testCase.rules))
+
+			checkValidateDiagnostics(t, diags, testCase.expectedError)
+		})
+	}
+}
+
+func checkValidateDiagnostics(t *testing.T, diags diag.Diagnostics, expectedError string) {
+	t.Helper()
+
+	if expectedError == "" {
+		if diags.HasError() {
+			t.Fatalf("unexpected errors: %v", diags)
+		}
+		return
+	}
+
+	for _, d := range diags {
+		if d.Severity == diag.Error && strings.Contains(d.Detail, expectedError) {
+			return
+		}
+	}
+
+	t.Fatalf("expected error %q, got %v", expectedError, diags)
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -79,11 +80,11 @@ func resourceConfigurationPolicyAssociationCreateOrUpdate(ctx context.Context, d
 		return sdkdiag.AppendErrorf(diags, "starting Security Hub Configuration Policy Association (%s): %s", targetID, err)
 	}
 
-	timeout := d.Timeout(schema.TimeoutCreate)
+	timeout := sdkv2.ResourceTimeout(ctx, d, schema.TimeoutCreate)
 	if d.IsNewResource() {
 		d.SetId(targetID)
 	} else {
-		timeout = d.Timeout(schema.TimeoutUpdate)
+		timeout = sdkv2.ResourceTimeout(ctx, d, schema.TimeoutUpdate)
 	}
 
 	if _, err := waitConfigurationPolicyAssociationSucceeded(ctx, conn, d.Id(), timeout); err != nil {
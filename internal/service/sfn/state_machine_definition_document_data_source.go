@@ -0,0 +1,462 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sfn
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_sfn_state_machine_definition_document", name="State Machine Definition Document")
+func dataSourceStateMachineDefinitionDocument() *schema.Resource {
+	jsonSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsJSON,
+		}
+	}
+	errorEqualsSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		}
+	}
+
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceStateMachineDefinitionDocumentRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrComment: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			names.AttrJSON: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"query_language": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{queryLanguageJSONata, queryLanguageJSONPath}, false),
+			},
+			"start_at": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			names.AttrState: {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arguments": jsonSchema(),
+						"assign":    jsonSchema(),
+						"branches": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsJSON,
+							},
+						},
+						"catch": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"error_equals": errorEqualsSchema(),
+									"next": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"result_path": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"cause": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"choice": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrCondition: {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"next": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"operator": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									names.AttrRule: jsonSchema(),
+									names.AttrValue: {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"variable": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						names.AttrComment: {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"default": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"end": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"error": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"heartbeat_seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"input_path": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"item_processor": jsonSchema(),
+						"item_selector":  jsonSchema(),
+						"items_path": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"max_concurrency": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						names.AttrName: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, stateNameMaxLength),
+						},
+						"next": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"output": jsonSchema(),
+						"output_path": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						names.AttrParameters: jsonSchema(),
+						"query_language": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{queryLanguageJSONata, queryLanguageJSONPath}, false),
+						},
+						"resource": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"result": jsonSchema(),
+						"result_path": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"result_selector": jsonSchema(),
+						"retry": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"backoff_rate": {
+										Type:         schema.TypeFloat,
+										Optional:     true,
+										ValidateFunc: validation.FloatAtLeast(1),
+									},
+									"error_equals": errorEqualsSchema(),
+									"interval_seconds": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"jitter_strategy": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice([]string{"FULL", "NONE"}, false),
+									},
+									"max_attempts": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      3,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+									"max_delay_seconds": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+								},
+							},
+						},
+						"seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"seconds_path": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"timeout_seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"timestamp": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsRFC3339Time,
+						},
+						"timestamp_path": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						names.AttrType: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(stateTypeValues(), false),
+						},
+					},
+				},
+			},
+			"timeout_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			names.AttrVersion: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"1.0"}, false),
+			},
+		},
+	}
+}
+
+func dataSourceStateMachineDefinitionDocumentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	doc := &stateMachineDefinitionDoc{
+		Comment:        d.Get(names.AttrComment).(string),
+		QueryLanguage:  d.Get("query_language").(string),
+		StartAt:        d.Get("start_at").(string),
+		TimeoutSeconds: int64(d.Get("timeout_seconds").(int)),
+		Version:        d.Get(names.AttrVersion).(string),
+	}
+
+	states, err := expandStateMachineDefinitionStates(d.Get(names.AttrState).([]interface{}))
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+	doc.States = states
+
+	jsonDoc, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+	jsonString := string(jsonDoc)
+
+	if err := validateDefinition(jsonString); err != nil {
+		return sdkdiag.AppendErrorf(diags, "state machine definition is not valid Amazon States Language: %s", err)
+	}
+
+	d.Set(names.AttrJSON, jsonString)
+
+	d.SetId(strconv.Itoa(schema.HashString(jsonString)))
+
+	return diags
+}
+
+func expandStateMachineDefinitionStates(tfList []interface{}) (map[string]*stateMachineDefinitionState, error) {
+	apiObjects := make(map[string]*stateMachineDefinitionState, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		name := tfMap[names.AttrName].(string)
+		if _, ok := apiObjects[name]; ok {
+			return nil, fmt.Errorf("duplicate state name %q", name)
+		}
+
+		apiObject, err := expandStateMachineDefinitionState(tfMap)
+		if err != nil {
+			return nil, fmt.Errorf("state %q: %w", name, err)
+		}
+
+		apiObjects[name] = apiObject
+	}
+
+	return apiObjects, nil
+}
+
+func expandStateMachineDefinitionState(tfMap map[string]interface{}) (*stateMachineDefinitionState, error) {
+	apiObject := &stateMachineDefinitionState{
+		Cause:            tfMap["cause"].(string),
+		Comment:          tfMap[names.AttrComment].(string),
+		Default:          tfMap["default"].(string),
+		End:              tfMap["end"].(bool),
+		Error:            tfMap["error"].(string),
+		HeartbeatSeconds: int64(tfMap["heartbeat_seconds"].(int)),
+		InputPath:        tfMap["input_path"].(string),
+		ItemsPath:        tfMap["items_path"].(string),
+		MaxConcurrency:   int64(tfMap["max_concurrency"].(int)),
+		Next:             tfMap["next"].(string),
+		OutputPath:       tfMap["output_path"].(string),
+		QueryLanguage:    tfMap["query_language"].(string),
+		Resource:         tfMap["resource"].(string),
+		ResultPath:       tfMap["result_path"].(string),
+		Seconds:          int64(tfMap["seconds"].(int)),
+		SecondsPath:      tfMap["seconds_path"].(string),
+		TimeoutSeconds:   int64(tfMap["timeout_seconds"].(int)),
+		Timestamp:        tfMap["timestamp"].(string),
+		TimestampPath:    tfMap["timestamp_path"].(string),
+		Type:             tfMap[names.AttrType].(string),
+	}
+
+	for _, v := range []struct {
+		key   string
+		field *json.RawMessage
+	}{
+		{"arguments", &apiObject.Arguments},
+		{"assign", &apiObject.Assign},
+		{"item_processor", &apiObject.ItemProcessor},
+		{"item_selector", &apiObject.ItemSelector},
+		{"output", &apiObject.Output},
+		{names.AttrParameters, &apiObject.Parameters},
+		{"result", &apiObject.Result},
+		{"result_selector", &apiObject.ResultSelector},
+	} {
+		if s := tfMap[v.key].(string); s != "" {
+			*v.field = json.RawMessage(s)
+		}
+	}
+
+	for _, v := range tfMap["branches"].([]interface{}) {
+		if s, ok := v.(string); ok && s != "" {
+			apiObject.Branches = append(apiObject.Branches, json.RawMessage(s))
+		}
+	}
+
+	for i, v := range tfMap["choice"].([]interface{}) {
+		if tfMap, ok := v.(map[string]interface{}); ok {
+			choice, err := expandStateMachineDefinitionChoice(tfMap)
+			if err != nil {
+				return nil, fmt.Errorf("choice %d: %w", i, err)
+			}
+			apiObject.Choices = append(apiObject.Choices, choice)
+		}
+	}
+
+	for _, v := range tfMap["retry"].([]interface{}) {
+		if tfMap, ok := v.(map[string]interface{}); ok {
+			apiObject.Retry = append(apiObject.Retry, &stateMachineDefinitionRetrier{
+				BackoffRate:     tfMap["backoff_rate"].(float64),
+				ErrorEquals:     flex.ExpandStringValueList(tfMap["error_equals"].([]interface{})),
+				IntervalSeconds: int64(tfMap["interval_seconds"].(int)),
+				JitterStrategy:  tfMap["jitter_strategy"].(string),
+				MaxAttempts:     int64(tfMap["max_attempts"].(int)),
+				MaxDelaySeconds: int64(tfMap["max_delay_seconds"].(int)),
+			})
+		}
+	}
+
+	for _, v := range tfMap["catch"].([]interface{}) {
+		if tfMap, ok := v.(map[string]interface{}); ok {
+			apiObject.Catch = append(apiObject.Catch, &stateMachineDefinitionCatcher{
+				ErrorEquals: flex.ExpandStringValueList(tfMap["error_equals"].([]interface{})),
+				Next:        tfMap["next"].(string),
+				ResultPath:  tfMap["result_path"].(string),
+			})
+		}
+	}
+
+	return apiObject, nil
+}
+
+// expandStateMachineDefinitionChoice expands a top-level Choice state rule.
+// A rule is either a JSON document, such as an And, Or or Not rule, or a single comparison
+// whose value is converted to the type expected by the operator.
+func expandStateMachineDefinitionChoice(tfMap map[string]interface{}) (map[string]any, error) {
+	apiObject := make(map[string]any)
+
+	if s := tfMap[names.AttrRule].(string); s != "" {
+		if err := json.Unmarshal([]byte(s), &apiObject); err != nil {
+			return nil, err
+		}
+	}
+
+	if s := tfMap[names.AttrCondition].(string); s != "" {
+		apiObject["Condition"] = s
+	}
+
+	if s := tfMap["variable"].(string); s != "" {
+		apiObject["Variable"] = s
+	}
+
+	if operator := tfMap["operator"].(string); operator != "" {
+		kind, ok := choiceComparisonOperators[operator]
+		if !ok {
+			return nil, fmt.Errorf("unsupported comparison operator %q", operator)
+		}
+
+		value := tfMap[names.AttrValue].(string)
+		switch kind {
+		case choiceOperandBoolean:
+			v, err := strconv.ParseBool(value)
+			if err != nil {
+				return nil, fmt.Errorf("%s value %q is not a boolean", operator, value)
+			}
+			apiObject[operator] = v
+		case choiceOperandNumber:
+			v := json.Number(value)
+			if _, err := v.Float64(); err != nil {
+				return nil, fmt.Errorf("%s value %q is not a number", operator, value)
+			}
+			apiObject[operator] = v
+		default:
+			apiObject[operator] = value
+		}
+	}
+
+	apiObject["Next"] = tfMap["next"].(string)
+
+	return apiObject, nil
+}
@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sfn_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSFNStateMachineDefinitionDocumentDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_sfn_state_machine_definition_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SFNServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStateMachineDefinitionDocumentDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, names.AttrJSON, testAccStateMachineDefinitionDocumentDataSourceExpectedJSON_basic),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSFNStateMachineDefinitionDocumentDataSource_invalid(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SFNServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStateMachineDefinitionDocumentDataSourceConfig_invalid,
+				ExpectError: regexp.MustCompile(`States.Orphan: state is not reachable from StartAt "Start"`),
+			},
+		},
+	})
+}
+
+const testAccStateMachineDefinitionDocumentDataSourceConfig_basic = `
+data "aws_sfn_state_machine_definition_document" "test" {
+  comment  = "test"
+  start_at = "Check"
+
+  state {
+    name    = "Check"
+    type    = "Choice"
+    default = "Done"
+
+    choice {
+      variable = "$.count"
+      operator = "NumericGreaterThan"
+      value    = "10"
+      next     = "Work"
+    }
+  }
+
+  state {
+    name     = "Work"
+    type     = "Task"
+    resource = "arn:aws:states:::lambda:invoke"
+    parameters = jsonencode({
+      FunctionName = "test"
+      "Payload.$"  = "$"
+    })
+    next = "Done"
+
+    retry {
+      error_equals = ["States.ALL"]
+    }
+  }
+
+  state {
+    name = "Done"
+    type = "Succeed"
+  }
+}
+`
+
+const testAccStateMachineDefinitionDocumentDataSourceExpectedJSON_basic = `{
+  "Comment": "test",
+  "StartAt": "Check",
+  "States": {
+    "Check": {
+      "Type": "Choice",
+      "Choices": [
+        {
+          "Variable": "$.count",
+          "NumericGreaterThan": 10,
+          "Next": "Work"
+        }
+      ],
+      "Default": "Done"
+    },
+    "Work": {
+      "Type": "Task",
+      "Resource": "arn:aws:states:::lambda:invoke",
+      "Parameters": {
+        "FunctionName": "test",
+        "Payload.$": "$"
+      },
+      "Retry": [
+        {
+          "ErrorEquals": ["States.ALL"],
+          "MaxAttempts": 3
+        }
+      ],
+      "Next": "Done"
+    },
+    "Done": {
+      "Type": "Succeed"
+    }
+  }
+}`
+
+const testAccStateMachineDefinitionDocumentDataSourceConfig_invalid = `
+data "aws_sfn_state_machine_definition_document" "test" {
+  start_at = "Start"
+
+  state {
+    name = "Start"
+    type = "Succeed"
+  }
+
+  state {
+    name = "Orphan"
+    type = "Succeed"
+  }
+}
+`
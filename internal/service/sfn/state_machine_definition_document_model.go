@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sfn
+
+import (
+	"encoding/json"
+)
+
+type stateMachineDefinitionDoc struct {
+	Comment        string                                  `json:",omitempty"`
+	QueryLanguage  string                                  `json:",omitempty"`
+	StartAt        string                                  `json:"StartAt"`
+	States         map[string]*stateMachineDefinitionState `json:"States"`
+	TimeoutSeconds int64                                   `json:",omitempty"`
+	Version        string                                  `json:",omitempty"`
+}
+
+type stateMachineDefinitionState struct {
+	Type             string                           `json:"Type"`
+	Comment          string                           `json:",omitempty"`
+	QueryLanguage    string                           `json:",omitempty"`
+	Resource         string                           `json:",omitempty"`
+	InputPath        string                           `json:",omitempty"`
+	ItemsPath        string                           `json:",omitempty"`
+	Parameters       json.RawMessage                  `json:",omitempty"`
+	ItemSelector     json.RawMessage                  `json:",omitempty"`
+	Arguments        json.RawMessage                  `json:",omitempty"`
+	Result           json.RawMessage                  `json:",omitempty"`
+	ResultSelector   json.RawMessage                  `json:",omitempty"`
+	ResultPath       string                           `json:",omitempty"`
+	Output           json.RawMessage                  `json:",omitempty"`
+	OutputPath       string                           `json:",omitempty"`
+	Assign           json.RawMessage                  `json:",omitempty"`
+	TimeoutSeconds   int64                            `json:",omitempty"`
+	HeartbeatSeconds int64                            `json:",omitempty"`
+	Seconds          int64                            `json:",omitempty"`
+	SecondsPath      string                           `json:",omitempty"`
+	Timestamp        string                           `json:",omitempty"`
+	TimestampPath    string                           `json:",omitempty"`
+	Error            string                           `json:",omitempty"`
+	Cause            string                           `json:",omitempty"`
+	Choices          []map[string]any                 `json:",omitempty"`
+	Default          string                           `json:",omitempty"`
+	Branches         []json.RawMessage                `json:",omitempty"`
+	ItemProcessor    json.RawMessage                  `json:",omitempty"`
+	MaxConcurrency   int64                            `json:",omitempty"`
+	Retry            []*stateMachineDefinitionRetrier `json:",omitempty"`
+	Catch            []*stateMachineDefinitionCatcher `json:",omitempty"`
+	Next             string                           `json:",omitempty"`
+	End              bool                             `json:",omitempty"`
+}
+
+type stateMachineDefinitionRetrier struct {
+	ErrorEquals     []string `json:"ErrorEquals"`
+	IntervalSeconds int64    `json:",omitempty"`
+	MaxAttempts     int64    `json:"MaxAttempts"`
+	BackoffRate     float64  `json:",omitempty"`
+	MaxDelaySeconds int64    `json:",omitempty"`
+	JitterStrategy  string   `json:",omitempty"`
+}
+
+type stateMachineDefinitionCatcher struct {
+	ErrorEquals []string `json:"ErrorEquals"`
+	Next        string   `json:"Next"`
+	ResultPath  string   `json:",omitempty"`
+}
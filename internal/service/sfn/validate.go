@@ -0,0 +1,891 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sfn
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Offline validation of Amazon States Language (ASL) state machine definitions.
+// See https://states-language.net/spec.html and https://docs.aws.amazon.com/step-functions/latest/dg/concepts-amazon-states-language.html.
+// The checks are deliberately conservative: a definition that passes may still be rejected by Step Functions,
+// but a definition that fails would be.
+
+const (
+	queryLanguageJSONata  = "JSONata"
+	queryLanguageJSONPath = "JSONPath"
+
+	stateTypeChoice   = "Choice"
+	stateTypeFail     = "Fail"
+	stateTypeMap      = "Map"
+	stateTypeParallel = "Parallel"
+	stateTypePass     = "Pass"
+	stateTypeSucceed  = "Succeed"
+	stateTypeTask     = "Task"
+	stateTypeWait     = "Wait"
+
+	errorStatesAll = "States.ALL"
+
+	stateNameMaxLength = 80
+)
+
+func stateTypeValues() []string {
+	return []string{
+		stateTypeChoice,
+		stateTypeFail,
+		stateTypeMap,
+		stateTypeParallel,
+		stateTypePass,
+		stateTypeSucceed,
+		stateTypeTask,
+		stateTypeWait,
+	}
+}
+
+type choiceOperandKind int
+
+const (
+	choiceOperandString choiceOperandKind = iota
+	choiceOperandNumber
+	choiceOperandBoolean
+	choiceOperandTimestamp
+	choiceOperandPath
+)
+
+// choiceComparisonOperators maps each Choice rule comparison operator to the kind of its operand.
+var choiceComparisonOperators = func() map[string]choiceOperandKind {
+	operators := map[string]choiceOperandKind{
+		"BooleanEquals":     choiceOperandBoolean,
+		"IsBoolean":         choiceOperandBoolean,
+		"IsNull":            choiceOperandBoolean,
+		"IsNumeric":         choiceOperandBoolean,
+		"IsPresent":         choiceOperandBoolean,
+		"IsString":          choiceOperandBoolean,
+		"IsTimestamp":       choiceOperandBoolean,
+		"StringMatches":     choiceOperandString,
+		"BooleanEqualsPath": choiceOperandPath,
+	}
+	for _, kind := range []struct {
+		prefix string
+		kind   choiceOperandKind
+	}{
+		{"String", choiceOperandString},
+		{"Numeric", choiceOperandNumber},
+		{"Timestamp", choiceOperandTimestamp},
+	} {
+		for _, suffix := range []string{"Equals", "LessThan", "GreaterThan", "LessThanEquals", "GreaterThanEquals"} {
+			operators[kind.prefix+suffix] = kind.kind
+			operators[kind.prefix+suffix+"Path"] = choiceOperandPath
+		}
+	}
+
+	return operators
+}()
+
+var intrinsicFunctions = []string{
+	"States.Array",
+	"States.ArrayContains",
+	"States.ArrayGetItem",
+	"States.ArrayLength",
+	"States.ArrayPartition",
+	"States.ArrayRange",
+	"States.ArrayUnique",
+	"States.Base64Decode",
+	"States.Base64Encode",
+	"States.Format",
+	"States.Hash",
+	"States.JsonMerge",
+	"States.JsonToString",
+	"States.MathAdd",
+	"States.MathRandom",
+	"States.StringSplit",
+	"States.StringToJson",
+	"States.UUID",
+}
+
+// validateDefinition validates a state machine definition offline.
+// All problems found are returned, joined.
+func validateDefinition(definition string) error {
+	var m map[string]any
+	decoder := json.NewDecoder(strings.NewReader(definition))
+	decoder.UseNumber()
+	if err := decoder.Decode(&m); err != nil {
+		return fmt.Errorf("invalid JSON: %w", err)
+	}
+	if m == nil {
+		return errors.New("must be a JSON object")
+	}
+
+	v := &definitionValidator{}
+	v.stateMachine("", m, queryLanguageJSONPath, true)
+
+	return errors.Join(v.errs...)
+}
+
+// customizeDiffValidateDefinition validates a changed state machine definition at plan time.
+func customizeDiffValidateDefinition(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("definition") {
+		return nil
+	}
+
+	if d.Id() != "" && !d.HasChange("definition") {
+		return nil
+	}
+
+	if err := validateDefinition(d.Get("definition").(string)); err != nil {
+		return fmt.Errorf("definition is not valid Amazon States Language: %w", err)
+	}
+
+	return nil
+}
+
+type definitionValidator struct {
+	errs []error
+}
+
+func (v *definitionValidator) errorf(path, format string, a ...any) {
+	if path == "" {
+		v.errs = append(v.errs, fmt.Errorf(format, a...))
+		return
+	}
+
+	v.errs = append(v.errs, fmt.Errorf("%s: %s", path, fmt.Sprintf(format, a...)))
+}
+
+// stateMachine validates a top-level state machine, or a Parallel state branch or Map state item processor.
+func (v *definitionValidator) stateMachine(path string, m map[string]any, queryLanguage string, topLevel bool) {
+	if topLevel {
+		queryLanguage = v.queryLanguage(joinPath(path, "QueryLanguage"), m, queryLanguage)
+
+		if value, ok := m["TimeoutSeconds"]; ok {
+			v.integer(joinPath(path, "TimeoutSeconds"), value, 1, queryLanguage)
+		}
+	}
+
+	statesPath := joinPath(path, "States")
+	states, ok := m["States"].(map[string]any)
+	if !ok || len(states) == 0 {
+		v.errorf(statesPath, "must be a non-empty object")
+		return
+	}
+
+	startAtPath := joinPath(path, "StartAt")
+	startAt, ok := m["StartAt"].(string)
+	if !ok {
+		v.errorf(startAtPath, "must be a string")
+	} else if _, ok := states[startAt]; !ok {
+		v.errorf(startAtPath, "state %q does not exist", startAt)
+		startAt = ""
+	}
+
+	names := make([]string, 0, len(states))
+	for name := range states {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	transitions := make(map[string][]string, len(states))
+	for _, name := range names {
+		statePath := joinPath(statesPath, name)
+
+		if len(name) > stateNameMaxLength {
+			v.errorf(statePath, "state name must be at most %d characters", stateNameMaxLength)
+		}
+
+		state, ok := states[name].(map[string]any)
+		if !ok {
+			v.errorf(statePath, "must be an object")
+			continue
+		}
+
+		transitions[name] = v.state(statePath, state, states, queryLanguage)
+	}
+
+	if startAt == "" {
+		return
+	}
+
+	// Every state must be reachable from StartAt.
+	reachable := map[string]bool{startAt: true}
+	for queue := []string{startAt}; len(queue) > 0; queue = queue[1:] {
+		for _, next := range transitions[queue[0]] {
+			if !reachable[next] {
+				reachable[next] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+	for _, name := range names {
+		if !reachable[name] {
+			v.errorf(joinPath(statesPath, name), "state is not reachable from StartAt %q", startAt)
+		}
+	}
+}
+
+// state validates a single state and returns the names of the states it can transition to.
+func (v *definitionValidator) state(path string, state map[string]any, states map[string]any, queryLanguage string) []string {
+	var transitions []string
+	transition := func(path string, value any) {
+		name, ok := value.(string)
+		if !ok {
+			v.errorf(path, "must be a string")
+			return
+		}
+		if _, ok := states[name]; !ok {
+			v.errorf(path, "state %q does not exist", name)
+			return
+		}
+		transitions = append(transitions, name)
+	}
+
+	queryLanguage = v.queryLanguage(joinPath(path, "QueryLanguage"), state, queryLanguage)
+
+	stateType, _ := state["Type"].(string)
+	switch stateType {
+	case stateTypeMap, stateTypeParallel, stateTypePass, stateTypeTask, stateTypeWait:
+		next, hasNext := state["Next"]
+		end, hasEnd := state["End"]
+		switch {
+		case hasNext && hasEnd:
+			v.errorf(path, "Next and End are mutually exclusive")
+		case hasNext:
+			transition(joinPath(path, "Next"), next)
+		case !hasEnd:
+			v.errorf(path, "a %s state must have either Next or End", stateType)
+		case end != true:
+			v.errorf(joinPath(path, "End"), "must be true")
+		}
+
+	case stateTypeChoice, stateTypeFail, stateTypeSucceed:
+		for _, field := range []string{"Next", "End"} {
+			if _, ok := state[field]; ok {
+				v.errorf(joinPath(path, field), "is not allowed in a %s state", stateType)
+			}
+		}
+
+	default:
+		v.errorf(joinPath(path, "Type"), "must be one of %s", strings.Join(stateTypeValues(), ", "))
+		return transitions
+	}
+
+	switch stateType {
+	case stateTypeChoice:
+		choicesPath := joinPath(path, "Choices")
+		choices, ok := state["Choices"].([]any)
+		if !ok || len(choices) == 0 {
+			v.errorf(choicesPath, "must be a non-empty array")
+		}
+		for i, choice := range choices {
+			choicePath := indexPath(choicesPath, i)
+			rule, ok := choice.(map[string]any)
+			if !ok {
+				v.errorf(choicePath, "must be an object")
+				continue
+			}
+			if next, ok := rule["Next"]; ok {
+				transition(joinPath(choicePath, "Next"), next)
+			} else {
+				v.errorf(choicePath, "a top-level choice rule must have Next")
+			}
+			v.choiceRule(choicePath, rule, queryLanguage)
+		}
+		if value, ok := state["Default"]; ok {
+			transition(joinPath(path, "Default"), value)
+		}
+
+	case stateTypeFail:
+		v.exclusive(path, state, "Error", "ErrorPath")
+		v.exclusive(path, state, "Cause", "CausePath")
+		if queryLanguage == queryLanguageJSONPath {
+			for _, field := range []string{"ErrorPath", "CausePath"} {
+				if value, ok := state[field]; ok {
+					v.pathOrIntrinsicFunction(joinPath(path, field), value)
+				}
+			}
+		}
+
+	case stateTypeMap:
+		processorPath := joinPath(path, "ItemProcessor")
+		processor, hasProcessor := state["ItemProcessor"]
+		if iterator, ok := state["Iterator"]; ok {
+			if hasProcessor {
+				v.errorf(path, "ItemProcessor and Iterator are mutually exclusive")
+			}
+			processorPath, processor, hasProcessor = joinPath(path, "Iterator"), iterator, true
+		}
+		if !hasProcessor {
+			v.errorf(path, "a Map state must have ItemProcessor")
+		} else if processor, ok := processor.(map[string]any); ok {
+			v.stateMachine(processorPath, processor, queryLanguage, false)
+		} else {
+			v.errorf(processorPath, "must be an object")
+		}
+		if value, ok := state["MaxConcurrency"]; ok {
+			v.integer(joinPath(path, "MaxConcurrency"), value, 0, queryLanguage)
+		}
+		if value, ok := state["ToleratedFailurePercentage"]; ok {
+			v.number(joinPath(path, "ToleratedFailurePercentage"), value, 0, 100, queryLanguage) //nolint:mnd // percentage
+		}
+		if queryLanguage == queryLanguageJSONPath {
+			if value, ok := state["ItemsPath"]; ok {
+				v.referencePath(joinPath(path, "ItemsPath"), value)
+			}
+			for _, field := range []string{"ItemSelector", "Parameters"} {
+				if value, ok := state[field]; ok {
+					v.payloadTemplate(joinPath(path, field), value)
+				}
+			}
+		}
+
+	case stateTypeParallel:
+		branchesPath := joinPath(path, "Branches")
+		branches, ok := state["Branches"].([]any)
+		if !ok || len(branches) == 0 {
+			v.errorf(branchesPath, "must be a non-empty array")
+		}
+		for i, branch := range branches {
+			branchPath := indexPath(branchesPath, i)
+			if branch, ok := branch.(map[string]any); ok {
+				v.stateMachine(branchPath, branch, queryLanguage, false)
+			} else {
+				v.errorf(branchPath, "must be an object")
+			}
+		}
+
+	case stateTypeTask:
+		if _, ok := state["Resource"].(string); !ok {
+			v.errorf(joinPath(path, "Resource"), "must be a string")
+		}
+		v.exclusive(path, state, "TimeoutSeconds", "TimeoutSecondsPath")
+		v.exclusive(path, state, "HeartbeatSeconds", "HeartbeatSecondsPath")
+		timeout, hasTimeout := state["TimeoutSeconds"]
+		if hasTimeout {
+			v.integer(joinPath(path, "TimeoutSeconds"), timeout, 1, queryLanguage)
+		}
+		heartbeat, hasHeartbeat := state["HeartbeatSeconds"]
+		if hasHeartbeat {
+			v.integer(joinPath(path, "HeartbeatSeconds"), heartbeat, 1, queryLanguage)
+		}
+		if hasTimeout && hasHeartbeat {
+			if timeout, ok := integerValue(timeout); ok {
+				if heartbeat, ok := integerValue(heartbeat); ok && heartbeat >= timeout {
+					v.errorf(joinPath(path, "HeartbeatSeconds"), "must be smaller than TimeoutSeconds")
+				}
+			}
+		}
+		if queryLanguage == queryLanguageJSONPath {
+			for _, field := range []string{"TimeoutSecondsPath", "HeartbeatSecondsPath"} {
+				if value, ok := state[field]; ok {
+					v.referencePath(joinPath(path, field), value)
+				}
+			}
+		}
+
+	case stateTypeWait:
+		fields := []string{"Seconds", "Timestamp"}
+		if queryLanguage == queryLanguageJSONPath {
+			fields = append(fields, "SecondsPath", "TimestampPath")
+		}
+		var n int
+		for _, field := range fields {
+			if _, ok := state[field]; ok {
+				n++
+			}
+		}
+		if n != 1 {
+			v.errorf(path, "a Wait state must have exactly one of %s", strings.Join(fields, ", "))
+		}
+		if value, ok := state["Seconds"]; ok {
+			v.integer(joinPath(path, "Seconds"), value, 0, queryLanguage)
+		}
+		if value, ok := state["Timestamp"]; ok {
+			v.timestamp(joinPath(path, "Timestamp"), value, queryLanguage)
+		}
+		if queryLanguage == queryLanguageJSONPath {
+			for _, field := range []string{"SecondsPath", "TimestampPath"} {
+				if value, ok := state[field]; ok {
+					v.referencePath(joinPath(path, field), value)
+				}
+			}
+		}
+	}
+
+	// Error handling.
+	for _, field := range []string{"Retry", "Catch"} {
+		value, ok := state[field]
+		if !ok {
+			continue
+		}
+
+		fieldPath := joinPath(path, field)
+		switch stateType {
+		case stateTypeMap, stateTypeParallel, stateTypeTask:
+		default:
+			v.errorf(fieldPath, "is not allowed in a %s state", stateType)
+			continue
+		}
+
+		handlers, ok := value.([]any)
+		if !ok {
+			v.errorf(fieldPath, "must be an array")
+			continue
+		}
+		for i, handler := range handlers {
+			handlerPath := indexPath(fieldPath, i)
+			handler, ok := handler.(map[string]any)
+			if !ok {
+				v.errorf(handlerPath, "must be an object")
+				continue
+			}
+
+			if v.errorEquals(handlerPath, handler) && i != len(handlers)-1 {
+				v.errorf(handlerPath, "a %s rule matching %s must be the last rule", field, errorStatesAll)
+			}
+
+			if field == "Catch" {
+				if next, ok := handler["Next"]; ok {
+					transition(joinPath(handlerPath, "Next"), next)
+				} else {
+					v.errorf(handlerPath, "a Catch rule must have Next")
+				}
+				if value, ok := handler["ResultPath"]; ok && value != nil && queryLanguage == queryLanguageJSONPath {
+					v.referencePath(joinPath(handlerPath, "ResultPath"), value)
+				}
+				continue
+			}
+
+			for _, field := range []string{"IntervalSeconds", "MaxDelaySeconds"} {
+				if value, ok := handler[field]; ok {
+					v.integer(joinPath(handlerPath, field), value, 1, queryLanguageJSONPath)
+				}
+			}
+			if value, ok := handler["MaxAttempts"]; ok {
+				v.integer(joinPath(handlerPath, "MaxAttempts"), value, 0, queryLanguageJSONPath)
+			}
+			if value, ok := handler["BackoffRate"]; ok {
+				v.number(joinPath(handlerPath, "BackoffRate"), value, 1, -1, queryLanguageJSONPath)
+			}
+			if value, ok := handler["JitterStrategy"]; ok && value != "FULL" && value != "NONE" {
+				v.errorf(joinPath(handlerPath, "JitterStrategy"), "must be FULL or NONE")
+			}
+		}
+	}
+
+	// Input and output processing.
+	if queryLanguage == queryLanguageJSONPath {
+		for _, field := range []string{"InputPath", "OutputPath"} {
+			if value, ok := state[field]; ok && value != nil {
+				v.path(joinPath(path, field), value)
+			}
+		}
+		if value, ok := state["ResultPath"]; ok && value != nil {
+			v.referencePath(joinPath(path, "ResultPath"), value)
+		}
+		for _, field := range []string{"Parameters", "ResultSelector"} {
+			if value, ok := state[field]; ok && stateType != stateTypeMap {
+				v.payloadTemplate(joinPath(path, field), value)
+			}
+		}
+	}
+
+	return transitions
+}
+
+// choiceRule validates a Choice state rule, ignoring its Next field.
+func (v *definitionValidator) choiceRule(path string, rule map[string]any, queryLanguage string) {
+	if queryLanguage == queryLanguageJSONata {
+		if _, ok := rule["Condition"].(string); !ok {
+			v.errorf(joinPath(path, "Condition"), "must be a string")
+		}
+		return
+	}
+
+	var operators []string
+	for key := range rule {
+		if _, ok := choiceComparisonOperators[key]; ok || key == "And" || key == "Or" || key == "Not" {
+			operators = append(operators, key)
+		}
+	}
+	if len(operators) != 1 {
+		slices.Sort(operators)
+		v.errorf(path, "a choice rule must have exactly one of And, Or, Not or a comparison operator, found [%s]", strings.Join(operators, ", "))
+		return
+	}
+
+	nested := func(path string, value any) {
+		rule, ok := value.(map[string]any)
+		if !ok {
+			v.errorf(path, "must be an object")
+			return
+		}
+		if _, ok := rule["Next"]; ok {
+			v.errorf(joinPath(path, "Next"), "is only allowed in a top-level choice rule")
+		}
+		v.choiceRule(path, rule, queryLanguage)
+	}
+
+	switch operator := operators[0]; operator {
+	case "And", "Or":
+		operatorPath := joinPath(path, operator)
+		rules, ok := rule[operator].([]any)
+		if !ok || len(rules) == 0 {
+			v.errorf(operatorPath, "must be a non-empty array")
+			return
+		}
+		for i, rule := range rules {
+			nested(indexPath(operatorPath, i), rule)
+		}
+
+	case "Not":
+		nested(joinPath(path, operator), rule[operator])
+
+	default:
+		if value, ok := rule["Variable"]; ok {
+			v.path(joinPath(path, "Variable"), value)
+		} else {
+			v.errorf(path, "a choice rule with a comparison operator must have Variable")
+		}
+
+		operatorPath := joinPath(path, operator)
+		switch value := rule[operator]; choiceComparisonOperators[operator] {
+		case choiceOperandBoolean:
+			if _, ok := value.(bool); !ok {
+				v.errorf(operatorPath, "must be a boolean")
+			}
+		case choiceOperandNumber:
+			if _, ok := value.(json.Number); !ok {
+				v.errorf(operatorPath, "must be a number")
+			}
+		case choiceOperandPath:
+			v.path(operatorPath, value)
+		case choiceOperandString:
+			if _, ok := value.(string); !ok {
+				v.errorf(operatorPath, "must be a string")
+			}
+		case choiceOperandTimestamp:
+			v.timestamp(operatorPath, value, queryLanguage)
+		}
+	}
+}
+
+// errorEquals validates a Retry or Catch rule's ErrorEquals and returns whether it matches States.ALL.
+func (v *definitionValidator) errorEquals(path string, handler map[string]any) bool {
+	errorEqualsPath := joinPath(path, "ErrorEquals")
+	values, ok := handler["ErrorEquals"].([]any)
+	if !ok || len(values) == 0 {
+		v.errorf(errorEqualsPath, "must be a non-empty array")
+		return false
+	}
+
+	var all bool
+	for i, value := range values {
+		switch value {
+		case errorStatesAll:
+			all = true
+		default:
+			if _, ok := value.(string); !ok {
+				v.errorf(indexPath(errorEqualsPath, i), "must be a string")
+			}
+		}
+	}
+	if all && len(values) > 1 {
+		v.errorf(errorEqualsPath, "%s must be the only error name", errorStatesAll)
+	}
+
+	return all
+}
+
+// exclusive validates that at most one of two fields is set.
+func (v *definitionValidator) exclusive(path string, state map[string]any, field1, field2 string) {
+	_, ok1 := state[field1]
+	_, ok2 := state[field2]
+	if ok1 && ok2 {
+		v.errorf(path, "%s and %s are mutually exclusive", field1, field2)
+	}
+}
+
+// queryLanguage validates a QueryLanguage field and returns the effective query language.
+func (v *definitionValidator) queryLanguage(path string, m map[string]any, inherited string) string {
+	value, ok := m["QueryLanguage"]
+	if !ok {
+		return inherited
+	}
+
+	switch value {
+	case queryLanguageJSONata:
+		return queryLanguageJSONata
+	case queryLanguageJSONPath:
+		if inherited == queryLanguageJSONata {
+			v.errorf(path, "cannot be %s when the state machine's query language is %s", queryLanguageJSONPath, queryLanguageJSONata)
+		}
+		return queryLanguageJSONPath
+	}
+
+	v.errorf(path, "must be %s or %s", queryLanguageJSONPath, queryLanguageJSONata)
+	return inherited
+}
+
+// integer validates an integer field.
+// With JSONata, the value can also be a JSONata expression.
+func (v *definitionValidator) integer(path string, value any, minimum int64, queryLanguage string) {
+	if isJSONataExpression(value, queryLanguage) {
+		return
+	}
+
+	n, ok := integerValue(value)
+	if !ok {
+		v.errorf(path, "must be an integer")
+		return
+	}
+	if n < minimum {
+		v.errorf(path, "must be at least %d", minimum)
+	}
+}
+
+// number validates a numeric field. A negative maximum means no maximum.
+// With JSONata, the value can also be a JSONata expression.
+func (v *definitionValidator) number(path string, value any, minimum, maximum float64, queryLanguage string) {
+	if isJSONataExpression(value, queryLanguage) {
+		return
+	}
+
+	number, ok := value.(json.Number)
+	if !ok {
+		v.errorf(path, "must be a number")
+		return
+	}
+	n, err := number.Float64()
+	if err != nil {
+		v.errorf(path, "must be a number")
+		return
+	}
+	if n < minimum || (maximum >= 0 && n > maximum) {
+		if maximum >= 0 {
+			v.errorf(path, "must be between %g and %g", minimum, maximum)
+		} else {
+			v.errorf(path, "must be at least %g", minimum)
+		}
+	}
+}
+
+// timestamp validates an RFC 3339 timestamp field.
+// With JSONata, the value can also be a JSONata expression.
+func (v *definitionValidator) timestamp(path string, value any, queryLanguage string) {
+	if isJSONataExpression(value, queryLanguage) {
+		return
+	}
+
+	s, ok := value.(string)
+	if !ok {
+		v.errorf(path, "must be a string")
+		return
+	}
+	if _, err := time.Parse(time.RFC3339, s); err != nil {
+		v.errorf(path, "must be an RFC 3339 timestamp, got %q", s)
+	}
+}
+
+// path validates a JSONPath path, such as an InputPath or a choice rule Variable.
+func (v *definitionValidator) path(path string, value any) {
+	s, ok := value.(string)
+	if !ok {
+		v.errorf(path, "must be a string")
+		return
+	}
+	if err := validPath(s); err != nil {
+		v.errorf(path, "%s", err)
+	}
+}
+
+// referencePath validates a JSONPath reference path, which must identify a single node.
+func (v *definitionValidator) referencePath(path string, value any) {
+	s, ok := value.(string)
+	if !ok {
+		v.errorf(path, "must be a string")
+		return
+	}
+	if err := validPath(s); err != nil {
+		v.errorf(path, "%s", err)
+		return
+	}
+	for _, operator := range []string{"*", "..", "?(", "@", ":", ","} {
+		if strings.Contains(s, operator) {
+			v.errorf(path, "reference path %q must not contain %q", s, operator)
+			return
+		}
+	}
+}
+
+// pathOrIntrinsicFunction validates a field that can be a JSONPath path or an intrinsic function.
+func (v *definitionValidator) pathOrIntrinsicFunction(path string, value any) {
+	s, ok := value.(string)
+	if !ok {
+		v.errorf(path, "must be a string")
+		return
+	}
+
+	var err error
+	if strings.HasPrefix(s, "States.") {
+		err = validIntrinsicFunction(s)
+	} else {
+		err = validPath(s)
+	}
+	if err != nil {
+		v.errorf(path, "%s", err)
+	}
+}
+
+// payloadTemplate validates a payload template, such as Parameters.
+// The values of fields whose names end in ".$" must be paths or intrinsic functions.
+func (v *definitionValidator) payloadTemplate(path string, value any) {
+	switch value := value.(type) {
+	case map[string]any:
+		keys := make([]string, 0, len(value))
+		for key := range value {
+			keys = append(keys, key)
+		}
+		slices.Sort(keys)
+
+		for _, key := range keys {
+			keyPath := joinPath(path, key)
+			if strings.HasSuffix(key, ".$") {
+				v.pathOrIntrinsicFunction(keyPath, value[key])
+			} else {
+				v.payloadTemplate(keyPath, value[key])
+			}
+		}
+
+	case []any:
+		for i, value := range value {
+			v.payloadTemplate(indexPath(path, i), value)
+		}
+	}
+}
+
+// validPath returns an error if the specified string isn't a syntactically valid JSONPath path.
+func validPath(s string) error {
+	if !strings.HasPrefix(s, "$") {
+		return fmt.Errorf("path %q must begin with $", s)
+	}
+	if strings.HasSuffix(s, ".") {
+		return fmt.Errorf("path %q must not end with .", s)
+	}
+
+	var depth int
+	var quote rune
+	for _, r := range s {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '\'' || r == '"':
+			quote = r
+		case r == '[':
+			depth++
+		case r == ']':
+			depth--
+			if depth < 0 {
+				return fmt.Errorf("path %q has unbalanced brackets", s)
+			}
+		}
+	}
+	if quote != 0 {
+		return fmt.Errorf("path %q has an unterminated string", s)
+	}
+	if depth != 0 {
+		return fmt.Errorf("path %q has unbalanced brackets", s)
+	}
+
+	return nil
+}
+
+// validIntrinsicFunction returns an error if the specified string isn't a syntactically valid intrinsic function call.
+func validIntrinsicFunction(s string) error {
+	if !strings.HasSuffix(s, ")") {
+		return fmt.Errorf("intrinsic function %q must end with )", s)
+	}
+
+	var depth int
+	var inString bool
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+
+		if inString {
+			switch c {
+			case '\\':
+				i++
+			case '\'':
+				inString = false
+			}
+			continue
+		}
+
+		switch c {
+		case '\'':
+			inString = true
+		case '(':
+			j := i
+			for j > 0 && isIntrinsicFunctionNameChar(s[j-1]) {
+				j--
+			}
+			if name := s[j:i]; !slices.Contains(intrinsicFunctions, name) {
+				return fmt.Errorf("unknown intrinsic function %q", name)
+			}
+			depth++
+		case ')':
+			depth--
+			if depth < 0 {
+				return fmt.Errorf("intrinsic function %q has unbalanced parentheses", s)
+			}
+		}
+	}
+	if inString {
+		return fmt.Errorf("intrinsic function %q has an unterminated string", s)
+	}
+	if depth != 0 {
+		return fmt.Errorf("intrinsic function %q has unbalanced parentheses", s)
+	}
+
+	return nil
+}
+
+func isIntrinsicFunctionNameChar(c byte) bool {
+	return c == '.' || c == '_' || ('0' <= c && c <= '9') || ('A' <= c && c <= 'Z') || ('a' <= c && c <= 'z')
+}
+
+func isJSONataExpression(value any, queryLanguage string) bool {
+	s, ok := value.(string)
+	return ok && queryLanguage == queryLanguageJSONata && strings.HasPrefix(s, "{%") && strings.HasSuffix(s, "%}")
+}
+
+func integerValue(value any) (int64, bool) {
+	number, ok := value.(json.Number)
+	if !ok {
+		return 0, false
+	}
+
+	n, err := number.Int64()
+	return n, err == nil
+}
+
+func joinPath(path, field string) string {
+	if path == "" {
+		return field
+	}
+
+	return path + "." + field
+}
+
+func indexPath(path string, i int) string {
+	return fmt.Sprintf("%s[%d]", path, i)
+}
@@ -0,0 +1,210 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sfn
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateDefinition(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		definition     string
+		expectedErrors []string
+	}{
+		"valid": {
+			definition: `{
+  "Comment": "An example",
+  "StartAt": "Check",
+  "States": {
+    "Check": {
+      "Type": "Choice",
+      "Choices": [
+        {"Variable": "$.count", "NumericGreaterThan": 10, "Next": "Work"},
+        {"And": [{"Variable": "$.name", "IsPresent": true}, {"Not": {"Variable": "$.name", "StringEquals": ""}}], "Next": "Wait"}
+      ],
+      "Default": "Done"
+    },
+    "Wait": {"Type": "Wait", "Seconds": 5, "Next": "Work"},
+    "Work": {
+      "Type": "Task",
+      "Resource": "arn:aws:states:::lambda:invoke",
+      "Parameters": {"FunctionName": "f", "Payload.$": "$", "Id.$": "States.Format('id-{}', $.id)"},
+      "ResultPath": "$.result",
+      "TimeoutSeconds": 60,
+      "HeartbeatSeconds": 10,
+      "Retry": [{"ErrorEquals": ["States.Timeout"], "MaxAttempts": 2}, {"ErrorEquals": ["States.ALL"]}],
+      "Catch": [{"ErrorEquals": ["States.ALL"], "Next": "Failed", "ResultPath": "$.error"}],
+      "Next": "Fan"
+    },
+    "Fan": {
+      "Type": "Parallel",
+      "Branches": [{"StartAt": "A", "States": {"A": {"Type": "Pass", "End": true}}}],
+      "Next": "Each"
+    },
+    "Each": {
+      "Type": "Map",
+      "ItemsPath": "$.items",
+      "ItemProcessor": {"StartAt": "B", "States": {"B": {"Type": "Succeed"}}},
+      "End": true
+    },
+    "Done": {"Type": "Succeed"},
+    "Failed": {"Type": "Fail", "Error": "Failed", "CausePath": "$.error.Cause"}
+  }
+}`,
+		},
+		"valid JSONata": {
+			definition: `{
+  "QueryLanguage": "JSONata",
+  "StartAt": "Check",
+  "States": {
+    "Check": {
+      "Type": "Choice",
+      "Choices": [{"Condition": "{% $states.input.count > 10 %}", "Next": "Wait"}],
+      "Default": "Done"
+    },
+    "Wait": {"Type": "Wait", "Seconds": "{% $states.input.delay %}", "Next": "Done"},
+    "Done": {"Type": "Succeed"}
+  }
+}`,
+		},
+		"invalid JSON": {
+			definition:     `{"StartAt": }`,
+			expectedErrors: []string{"invalid JSON"},
+		},
+		"no states": {
+			definition:     `{"StartAt": "A", "States": {}}`,
+			expectedErrors: []string{"States: must be a non-empty object"},
+		},
+		"missing StartAt state": {
+			definition:     `{"StartAt": "B", "States": {"A": {"Type": "Succeed"}}}`,
+			expectedErrors: []string{`StartAt: state "B" does not exist`},
+		},
+		"unreachable state": {
+			definition:     `{"StartAt": "A", "States": {"A": {"Type": "Succeed"}, "B": {"Type": "Succeed"}}}`,
+			expectedErrors: []string{`States.B: state is not reachable from StartAt "A"`},
+		},
+		"invalid type": {
+			definition:     `{"StartAt": "A", "States": {"A": {"Type": "Lambda", "End": true}}}`,
+			expectedErrors: []string{"States.A.Type: must be one of"},
+		},
+		"Next and End": {
+			definition: `{"StartAt": "A", "States": {"A": {"Type": "Pass", "Next": "B", "End": true}, "B": {"Type": "Succeed"}}}`,
+			expectedErrors: []string{
+				"States.A: Next and End are mutually exclusive",
+				`States.B: state is not reachable from StartAt "A"`,
+			},
+		},
+		"no Next or End": {
+			definition:     `{"StartAt": "A", "States": {"A": {"Type": "Pass"}}}`,
+			expectedErrors: []string{"States.A: a Pass state must have either Next or End"},
+		},
+		"missing Next state": {
+			definition:     `{"StartAt": "A", "States": {"A": {"Type": "Pass", "Next": "B"}}}`,
+			expectedErrors: []string{`States.A.Next: state "B" does not exist`},
+		},
+		"End in terminal state": {
+			definition:     `{"StartAt": "A", "States": {"A": {"Type": "Succeed", "End": true}}}`,
+			expectedErrors: []string{"States.A.End: is not allowed in a Succeed state"},
+		},
+		"choice rule with two operators": {
+			definition:     `{"StartAt": "A", "States": {"A": {"Type": "Choice", "Choices": [{"Variable": "$.x", "StringEquals": "a", "IsNull": false, "Next": "B"}]}, "B": {"Type": "Succeed"}}}`,
+			expectedErrors: []string{"States.A.Choices[0]: a choice rule must have exactly one of And, Or, Not or a comparison operator, found [IsNull, StringEquals]"},
+		},
+		"choice rule operand type": {
+			definition:     `{"StartAt": "A", "States": {"A": {"Type": "Choice", "Choices": [{"Variable": "$.x", "NumericEquals": "1", "Next": "B"}]}, "B": {"Type": "Succeed"}}}`,
+			expectedErrors: []string{"States.A.Choices[0].NumericEquals: must be a number"},
+		},
+		"nested choice rule with Next": {
+			definition:     `{"StartAt": "A", "States": {"A": {"Type": "Choice", "Choices": [{"Not": {"Variable": "$.x", "IsNull": true, "Next": "B"}, "Next": "B"}]}, "B": {"Type": "Succeed"}}}`,
+			expectedErrors: []string{"States.A.Choices[0].Not.Next: is only allowed in a top-level choice rule"},
+		},
+		"choice rule variable": {
+			definition:     `{"StartAt": "A", "States": {"A": {"Type": "Choice", "Choices": [{"Variable": "x", "IsNull": true, "Next": "B"}]}, "B": {"Type": "Succeed"}}}`,
+			expectedErrors: []string{`States.A.Choices[0].Variable: path "x" must begin with $`},
+		},
+		"wait with two durations": {
+			definition:     `{"StartAt": "A", "States": {"A": {"Type": "Wait", "Seconds": 1, "SecondsPath": "$.s", "End": true}}}`,
+			expectedErrors: []string{"States.A: a Wait state must have exactly one of Seconds, Timestamp, SecondsPath, TimestampPath"},
+		},
+		"task": {
+			definition: `{"StartAt": "A", "States": {"A": {"Type": "Task", "TimeoutSeconds": 10, "HeartbeatSeconds": 10, "End": true}}}`,
+			expectedErrors: []string{
+				"States.A.Resource: must be a string",
+				"States.A.HeartbeatSeconds: must be smaller than TimeoutSeconds",
+			},
+		},
+		"States.ALL not last": {
+			definition: `{"StartAt": "A", "States": {"A": {"Type": "Task", "Resource": "r", "Retry": [{"ErrorEquals": ["States.ALL", "X"]}, {"ErrorEquals": ["Y"]}], "End": true}}}`,
+			expectedErrors: []string{
+				"States.A.Retry[0].ErrorEquals: States.ALL must be the only error name",
+				"States.A.Retry[0]: a Retry rule matching States.ALL must be the last rule",
+			},
+		},
+		"catch on pass": {
+			definition:     `{"StartAt": "A", "States": {"A": {"Type": "Pass", "Catch": [], "End": true}}}`,
+			expectedErrors: []string{"States.A.Catch: is not allowed in a Pass state"},
+		},
+		"reference path": {
+			definition:     `{"StartAt": "A", "States": {"A": {"Type": "Pass", "ResultPath": "$.items[*]", "End": true}}}`,
+			expectedErrors: []string{`States.A.ResultPath: reference path "$.items[*]" must not contain "*"`},
+		},
+		"intrinsic function": {
+			definition: `{"StartAt": "A", "States": {"A": {"Type": "Pass", "Parameters": {"a.$": "States.Nope($.x)", "b": {"c.$": "States.Format('{}', $.x"}}, "End": true}}}`,
+			expectedErrors: []string{
+				`States.A.Parameters.a.$: unknown intrinsic function "States.Nope"`,
+				`States.A.Parameters.b.c.$: intrinsic function "States.Format('{}', $.x" must end with )`,
+			},
+		},
+		"nested branch": {
+			definition:     `{"StartAt": "A", "States": {"A": {"Type": "Parallel", "Branches": [{"StartAt": "B", "States": {"B": {"Type": "Pass", "Next": "A"}}}], "End": true}}}`,
+			expectedErrors: []string{`States.A.Branches[0].States.B.Next: state "A" does not exist`},
+		},
+		"map without processor": {
+			definition:     `{"StartAt": "A", "States": {"A": {"Type": "Map", "End": true}}}`,
+			expectedErrors: []string{"States.A: a Map state must have ItemProcessor"},
+		},
+		"fail": {
+			definition:     `{"StartAt": "A", "States": {"A": {"Type": "Fail", "Error": "E", "ErrorPath": "$.e"}}}`,
+			expectedErrors: []string{"States.A: Error and ErrorPath are mutually exclusive"},
+		},
+		"JSONPath state in JSONata state machine": {
+			definition:     `{"QueryLanguage": "JSONata", "StartAt": "A", "States": {"A": {"Type": "Succeed", "QueryLanguage": "JSONPath"}}}`,
+			expectedErrors: []string{"States.A.QueryLanguage: cannot be JSONPath when the state machine's query language is JSONata"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateDefinition(testCase.definition)
+
+			if len(testCase.expectedErrors) == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error")
+			}
+
+			got := strings.Split(err.Error(), "\n")
+			if len(got) != len(testCase.expectedErrors) {
+				t.Fatalf("got %d errors, want %d:\n%s", len(got), len(testCase.expectedErrors), err)
+			}
+			for i, want := range testCase.expectedErrors {
+				if !strings.HasPrefix(got[i], want) {
+					t.Errorf("error %d = %q, want prefix %q", i, got[i], want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,173 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// generator writes import blocks, and optionally resource blocks, for listed resources.
+type generator struct {
+	region  string
+	config  bool
+	header  bool
+	names   map[string]struct{} // Resource addresses already written.
+	invalid *regexp.Regexp
+
+	imported, skipped int
+}
+
+func newGenerator(region string, config bool) *generator {
+	return &generator{
+		region:  region,
+		config:  config,
+		names:   make(map[string]struct{}),
+		invalid: regexp.MustCompile(`[^a-z0-9_-]+`),
+	}
+}
+
+// write writes the configuration for the resources listed by a sweeper.
+func (g *generator) write(ctx context.Context, w io.Writer, result *sweep.ListResult) error {
+	if !g.header {
+		g.header = true
+
+		if _, err := fmt.Fprintf(w, "# Resources in %s. Configure the AWS provider for this Region before running terraform plan.\n", g.region); err != nil {
+			return err
+		}
+		if !g.config {
+			if _, err := fmt.Fprintf(w, "# Run terraform plan -generate-config-out=generated.tf to generate configuration for the imported resources.\n"); err != nil {
+				return err
+			}
+		}
+	}
+
+	resourceType := result.Sweeper
+
+	if result.Status != sweep.SweeperStatusListed {
+		_, err := fmt.Fprintf(w, "\n# %s: %s (%s)\n", resourceType, result.Status, result.Reason)
+		return err
+	}
+
+	if _, err := fmt.Fprintf(w, "\n# %s: %d resources\n", resourceType, len(result.Sweepables)); err != nil {
+		return err
+	}
+
+	for _, sweepable := range result.Sweepables {
+		v, ok := sweepable.(sweep.Importable)
+
+		if !ok || !v.Importable(ctx) {
+			g.skipped += len(result.Sweepables)
+			_, err := fmt.Fprintf(w, "# %s does not support import\n", resourceType)
+			return err
+		}
+
+		if v.ID() == "" {
+			g.skipped++
+			continue
+		}
+
+		if err := g.writeResource(ctx, w, resourceType, v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (g *generator) writeResource(ctx context.Context, w io.Writer, resourceType string, importable sweep.Importable) error {
+	id := importable.ID()
+
+	var resource *hclwrite.Block
+	var comment string
+
+	if v, ok := importable.(sweep.Configurable); ok && g.config {
+		block := hclwrite.NewBlock("resource", nil)
+		exists, err := v.Config(ctx, block.Body())
+
+		switch {
+		case err != nil:
+			comment = fmt.Sprintf("# Configuration of %s (%s) could not be generated: %s\n", resourceType, id, strings.ReplaceAll(err.Error(), "\n", " "))
+		case !exists:
+			g.skipped++
+			return nil
+		default:
+			resource = block
+		}
+	}
+
+	// The resource's name is known once it has been read.
+	name := g.resourceName(resourceType, importable.Name(), id)
+
+	f := hclwrite.NewEmptyFile()
+	body := f.Body()
+
+	block := body.AppendNewBlock("import", nil)
+	block.Body().SetAttributeTraversal("to", hcl.Traversal{
+		hcl.TraverseRoot{Name: resourceType},
+		hcl.TraverseAttr{Name: name},
+	})
+	block.Body().SetAttributeValue(names.AttrID, cty.StringVal(id))
+
+	if resource != nil {
+		resource.SetLabels([]string{resourceType, name})
+		body.AppendNewline()
+		body.AppendBlock(resource)
+	}
+
+	g.names[resourceType+"."+name] = struct{}{}
+	g.imported++
+
+	if _, err := io.WriteString(w, "\n"+comment); err != nil {
+		return err
+	}
+
+	_, err := w.Write(f.Bytes())
+
+	return err
+}
+
+// resourceName returns a unique resource name, derived from the resource's name or ID, that is a valid Terraform identifier.
+func (g *generator) resourceName(resourceType, name, id string) string {
+	if name == "" {
+		name = id
+	}
+
+	name = strings.Trim(g.invalid.ReplaceAllString(strings.ToLower(name), "_"), "_-")
+
+	if name == "" || !(name[0] >= 'a' && name[0] <= 'z') {
+		name = "resource_" + name
+	}
+
+	for i, v := 2, name; ; i++ {
+		if _, ok := g.names[resourceType+"."+v]; !ok {
+			return v
+		}
+		v = fmt.Sprintf("%s_%d", name, i)
+	}
+}
+
+// resourceTypeName returns the type name of a Terraform Plugin Framework resource.
+func resourceTypeName(ctx context.Context, factory func(context.Context) (fwresource.ResourceWithConfigure, error)) string {
+	r, err := factory(ctx)
+
+	if err != nil {
+		return ""
+	}
+
+	var response fwresource.MetadataResponse
+	r.Metadata(ctx, fwresource.MetadataRequest{ProviderTypeName: "aws"}, &response)
+
+	return response.TypeName
+}
@@ -0,0 +1,193 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+var (
+	generateConfig = flag.Bool("config", true, "Generate a resource block for each imported resource, where supported")
+	outPath        = flag.String("out", "-", "Path of the generated configuration, or - for standard output")
+	region         = flag.String("region", "us-west-2", "Region of the resources to import")
+	resourceTypes  = flag.String("resources", "", "Comma-separated list of resource types to import (default all resource types of the service)")
+	service        = flag.String("service", "", "Service package name or alias, e.g. sqs")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\timportgen -service <service> [-region <region>] [-resources <resource types>] [-config=false] [-out <path>]\n\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+
+	if flag.NArg() > 0 || *service == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	os.Exit(run(context.Background()))
+}
+
+// run generates the import configuration and returns the process exit code.
+func run(ctx context.Context) int {
+	sweep.ServicePackages = servicePackages(ctx)
+
+	registerSweepers()
+
+	sweepers, unswept, err := serviceSweepers(ctx, *service, splitList(*resourceTypes))
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error: %s\n", err)
+		return 1
+	}
+
+	results, err := sweep.List(ctx, *region, sweepers)
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error listing resources: %s\n", err)
+		return 1
+	}
+
+	out := io.Writer(os.Stdout)
+	var f *os.File
+	if *outPath != "-" {
+		f, err = os.Create(*outPath)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "error creating %s: %s\n", *outPath, err)
+			return 1
+		}
+
+		out = f
+	}
+
+	exitCode := generate(ctx, out, unswept, results)
+
+	if f != nil {
+		if err := f.Close(); err != nil {
+			fmt.Fprintf(os.Stderr, "error closing %s: %s\n", *outPath, err)
+			return 1
+		}
+	}
+
+	return exitCode
+}
+
+// generate writes the import configuration of the listed resources and returns the process exit code.
+func generate(ctx context.Context, out io.Writer, unswept []string, results []*sweep.ListResult) int {
+	g := newGenerator(*region, *generateConfig)
+	failed := false
+	listed := 0
+
+	for _, v := range unswept {
+		fmt.Fprintf(os.Stderr, "%s: %s (no sweeper)\n", v, sweep.SweeperStatusSkipped)
+	}
+
+	for _, result := range results {
+		switch result.Status {
+		case sweep.SweeperStatusListed:
+			listed++
+		case sweep.SweeperStatusFailed:
+			failed = true
+			fallthrough
+		default:
+			fmt.Fprintf(os.Stderr, "%s: %s (%s)\n", result.Sweeper, result.Status, result.Reason)
+		}
+
+		if err := g.write(ctx, out, result); err != nil {
+			fmt.Fprintf(os.Stderr, "error writing configuration: %s\n", err)
+			return 1
+		}
+	}
+
+	fmt.Fprintf(os.Stderr, "Resource types: %d listed, %d not listed. Resources: %d imported, %d skipped\n", listed, len(unswept)+len(results)-listed, g.imported, g.skipped)
+
+	if failed {
+		return 1
+	}
+
+	return 0
+}
+
+// serviceSweepers returns the names of the sweepers of the service package's resource types,
+// and the resource types that don't have a sweeper.
+// Only the specified resource types are included if any are specified.
+func serviceSweepers(ctx context.Context, service string, resourceTypes []string) ([]string, []string, error) {
+	pkg, err := names.ProviderPackageForAlias(service)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	idx := slices.IndexFunc(sweep.ServicePackages, func(sp conns.ServicePackage) bool {
+		return sp.ServicePackageName() == pkg
+	})
+
+	if idx == -1 {
+		return nil, nil, fmt.Errorf("service package (%s) not found", pkg)
+	}
+
+	sp := sweep.ServicePackages[idx]
+
+	var typeNames []string
+	for _, v := range sp.FrameworkResources(ctx) {
+		typeNames = append(typeNames, resourceTypeName(ctx, v.Factory))
+	}
+	for _, v := range sp.SDKResources(ctx) {
+		typeNames = append(typeNames, v.TypeName)
+	}
+
+	for _, v := range resourceTypes {
+		if !slices.Contains(typeNames, v) {
+			return nil, nil, fmt.Errorf("resource type (%s) not found in service package (%s)", v, pkg)
+		}
+	}
+
+	if len(resourceTypes) > 0 {
+		typeNames = resourceTypes
+	}
+	slices.Sort(typeNames)
+
+	registered := sweep.Sweepers()
+
+	var sweepers, unswept []string
+	for _, v := range typeNames {
+		if slices.Contains(registered, v) {
+			sweepers = append(sweepers, v)
+		} else {
+			unswept = append(unswept, v)
+		}
+	}
+
+	if len(sweepers) == 0 {
+		return nil, nil, fmt.Errorf("no sweepers found for service package (%s)", pkg)
+	}
+
+	return sweepers, unswept, nil
+}
+
+func splitList(s string) []string {
+	var list []string
+
+	for _, v := range strings.Split(s, ",") {
+		if v := strings.TrimSpace(v); v != "" {
+			list = append(list, v)
+		}
+	}
+
+	return list
+}
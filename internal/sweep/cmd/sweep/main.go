@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+var (
+	dryRun      = flag.Bool("dry-run", false, "List the resources that would be swept without deleting them")
+	parallelism = flag.Int("parallelism", 10, "Maximum number of sweepers to run concurrently in each Region")
+	prefixes    = flag.String("prefix", "", "Comma-separated list of name or ID prefixes of the resources to sweep")
+	regions     = flag.String("regions", "us-west-2", "Comma-separated list of Regions to sweep")
+	reportPath  = flag.String("report", "-", "Path of the JSON summary report, or - for standard output")
+	sweepers    = flag.String("sweepers", "", "Comma-separated list of sweepers to run, together with their dependencies (default all)")
+	tags        = make(tagsFlag)
+)
+
+func init() {
+	flag.Var(tags, "tag", "Tag (key or key=value) that the resources to sweep must have. May be repeated")
+}
+
+// tagsFlag is a repeatable key=value flag.
+type tagsFlag map[string]string
+
+func (f tagsFlag) String() string {
+	var s []string
+	for k, v := range f {
+		s = append(s, k+"="+v)
+	}
+	return strings.Join(s, ",")
+}
+
+func (f tagsFlag) Set(value string) error {
+	k, v, _ := strings.Cut(value, "=")
+	if k == "" {
+		return fmt.Errorf("invalid tag %q, expected key or key=value", value)
+	}
+	f[k] = v
+	return nil
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tsweep [-regions <regions>] [-sweepers <sweepers>] [-dry-run] [-prefix <prefixes>] [-tag <key=value>]... [-report <path>]\n\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+
+	if flag.NArg() > 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	ctx := context.Background()
+
+	sweep.ServicePackages = servicePackages(ctx)
+
+	registerSweepers()
+
+	report, err := sweep.Run(ctx, sweep.RunOptions{
+		Regions:     splitList(*regions),
+		Sweepers:    splitList(*sweepers),
+		DryRun:      *dryRun,
+		Parallelism: *parallelism,
+		Prefixes:    splitList(*prefixes),
+		Tags:        tags,
+	})
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error running sweepers: %s\n", err)
+		os.Exit(1)
+	}
+
+	if err := writeReport(report, *reportPath); err != nil {
+		fmt.Fprintf(os.Stderr, "error writing report: %s\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Fprintf(os.Stderr, "Sweepers: %d swept, %d listed, %d skipped, %d failed. Resources: %d\n",
+		report.Summary[sweep.SweeperStatusSwept],
+		report.Summary[sweep.SweeperStatusListed],
+		report.Summary[sweep.SweeperStatusSkipped],
+		report.Summary[sweep.SweeperStatusFailed],
+		report.Resources,
+	)
+
+	if report.Failed() {
+		os.Exit(1)
+	}
+}
+
+func splitList(s string) []string {
+	var list []string
+
+	for _, v := range strings.Split(s, ",") {
+		if v := strings.TrimSpace(v); v != "" {
+			list = append(list, v)
+		}
+	}
+
+	return list
+}
+
+func writeReport(report *sweep.Report, path string) error {
+	b, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+	b = append(b, '\n')
+
+	if path == "-" {
+		_, err = os.Stdout.Write(b)
+		return err
+	}
+
+	return os.WriteFile(path, b, 0600)
+}
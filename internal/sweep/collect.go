@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"sync"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+type collectorContextKey struct{}
+
+// sweepableBatch is the Sweepables passed to a single call of SweepOrchestrator.
+type sweepableBatch struct {
+	sweepables []Sweepable
+	optFns     []tfresource.OptionsFunc
+}
+
+// collector holds the Sweepables passed to SweepOrchestrator instead of sweeping them.
+type collector struct {
+	mu      sync.Mutex
+	batches []sweepableBatch
+}
+
+func (c *collector) add(sweepables []Sweepable, optFns []tfresource.OptionsFunc) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.batches = append(c.batches, sweepableBatch{
+		sweepables: sweepables,
+		optFns:     optFns,
+	})
+}
+
+// Sweepers registered with AddTestSweepers get their context from Context, which only knows the Region,
+// so collectors are keyed by Region and only one sweeper per Region is collected at a time.
+// Context adds the Region's collector to the context, wherever the sweeper calls it.
+var (
+	collectors   = make(map[string]*collector)
+	regionLocks  = make(map[string]*sync.Mutex)
+	collectorsMu sync.Mutex
+)
+
+// collect runs a sweeper registered with AddTestSweepers in a Region and returns the Sweepables that it passes
+// to SweepOrchestrator, in order, without sweeping them.
+func collect(s *sweeper, region string) ([]sweepableBatch, error) {
+	collectorsMu.Lock()
+	l, ok := regionLocks[region]
+	if !ok {
+		l = new(sync.Mutex)
+		regionLocks[region] = l
+	}
+	collectorsMu.Unlock()
+
+	l.Lock()
+	defer l.Unlock()
+
+	c := new(collector)
+
+	collectorsMu.Lock()
+	collectors[region] = c
+	collectorsMu.Unlock()
+
+	defer func() {
+		collectorsMu.Lock()
+		delete(collectors, region)
+		collectorsMu.Unlock()
+	}()
+
+	err := s.f(region)
+
+	return c.batches, err
+}
+
+// regionCollector returns the collector of the sweeper being collected in a Region, if any.
+func regionCollector(region string) *collector {
+	collectorsMu.Lock()
+	defer collectorsMu.Unlock()
+
+	return collectors[region]
+}
+
+// collectorFor returns the collector kept in a context returned by Context, if any.
+func collectorFor(ctx context.Context) *collector {
+	c, _ := ctx.Value(collectorContextKey{}).(*collector)
+
+	return c
+}
@@ -16,5 +16,9 @@ func Context(region string) context.Context {
 
 	ctx = logger(ctx, "sweeper", region)
 
+	if c := regionCollector(region); c != nil {
+		ctx = context.WithValue(ctx, collectorContextKey{}, c)
+	}
+
 	return ctx
 }
@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/maps"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type attribute struct {
@@ -118,6 +119,41 @@ func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optF
 	return err
 }
 
+// ID returns the ID of the resource to be swept, if known.
+func (sr *sweepResource) ID() string {
+	return sr.stringAttribute(names.AttrID)
+}
+
+// Name returns the name of the resource to be swept, if known.
+func (sr *sweepResource) Name() string {
+	return sr.stringAttribute(names.AttrName)
+}
+
+// Importable returns whether the resource to be swept can be imported using its ID.
+func (sr *sweepResource) Importable(ctx context.Context) bool {
+	resource, err := sr.factory(ctx)
+
+	if err != nil {
+		return false
+	}
+
+	_, ok := resource.(fwresource.ResourceWithImportState)
+
+	return ok
+}
+
+func (sr *sweepResource) stringAttribute(path string) string {
+	for _, attr := range sr.attributes {
+		if attr.path == path {
+			if v, ok := attr.value.(string); ok {
+				return v
+			}
+		}
+	}
+
+	return ""
+}
+
 func deleteResource(ctx context.Context, state tfsdk.State, resource fwresource.Resource) error {
 	var response fwresource.DeleteResponse
 	resource.Delete(ctx, fwresource.DeleteRequest{State: state}, &response)
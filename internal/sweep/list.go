@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-log/tfsdklog"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+)
+
+// Importable is implemented by Sweepables whose resource type supports import.
+type Importable interface {
+	Identifiable
+	// Importable returns whether the resource can be imported using its ID.
+	Importable(ctx context.Context) bool
+}
+
+// Configurable is implemented by Sweepables that can describe the resource to be swept as Terraform configuration.
+type Configurable interface {
+	// Config reads the resource and writes its configurable arguments to the body of a resource block.
+	// Returns false if the resource no longer exists.
+	Config(ctx context.Context, body *hclwrite.Body) (bool, error)
+}
+
+// ListResult is the result of listing the resources of a sweeper in a Region.
+type ListResult struct {
+	Sweeper    string
+	Status     SweeperStatus
+	Reason     string
+	Sweepables []Sweepable
+}
+
+// List lists the resources of the specified sweepers in a Region without sweeping them.
+// Unlike Run, dependencies of the sweepers are not listed.
+// Sweepers registered with AddTestSweepers are run with the resources that they pass to SweepOrchestrator collected
+// instead of swept. Sweepers registered with AddUnlistedTestSweepers are skipped.
+// All registered sweepers are listed if none are specified.
+func List(ctx context.Context, region string, names []string) ([]*ListResult, error) {
+	sweepersMu.Lock()
+	registered := maps.Clone(sweepers)
+	sweepersMu.Unlock()
+
+	ctx = logger(tfsdklog.RegisterStdlogSink(ctx), "sweeper", region)
+
+	client, err := SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return nil, fmt.Errorf("getting client: %w", err)
+	}
+
+	return list(ctx, region, registered, names, client)
+}
+
+// Sweepers returns the sorted names of the registered sweepers.
+func Sweepers() []string {
+	sweepersMu.Lock()
+	defer sweepersMu.Unlock()
+
+	names := tfmaps.Keys(sweepers)
+	slices.Sort(names)
+
+	return names
+}
+
+func list(ctx context.Context, region string, sweepers map[string]*sweeper, names []string, client *conns.AWSClient) ([]*ListResult, error) {
+	if len(names) == 0 {
+		names = tfmaps.Keys(sweepers)
+	} else {
+		names = slices.Clone(names)
+	}
+	slices.Sort(names)
+
+	var results []*ListResult
+
+	for _, name := range names {
+		s, ok := sweepers[name]
+		if !ok {
+			return nil, fmt.Errorf("sweeper (%s) not found", name)
+		}
+
+		ctx := logWithResourceType(ctx, name)
+		result := &ListResult{
+			Sweeper: name,
+		}
+		results = append(results, result)
+
+		if s.list == nil && !s.collectable {
+			result.Status, result.Reason = SweeperStatusSkipped, "listing not supported by sweeper"
+			continue
+		}
+
+		sweepables, err := listSweeper(ctx, s, region, client)
+
+		switch {
+		case SkipSweepError(err):
+			result.Status, result.Reason = SweeperStatusSkipped, err.Error()
+		case err != nil:
+			result.Status, result.Reason = SweeperStatusFailed, fmt.Sprintf("listing %q: %s", name, err)
+		default:
+			result.Status, result.Sweepables = SweeperStatusListed, sweepables
+		}
+
+		tflog.Info(ctx, "Sweeper listed resources", map[string]any{
+			"status":    result.Status,
+			"reason":    result.Reason,
+			"resources": len(result.Sweepables),
+		})
+	}
+
+	return results, nil
+}
+
+func listSweeper(ctx context.Context, s *sweeper, region string, client *conns.AWSClient) ([]Sweepable, error) {
+	if s.list != nil {
+		return s.list(ctx, client)
+	}
+
+	batches, err := collect(s, region)
+	if err != nil {
+		return nil, err
+	}
+
+	var sweepables []Sweepable
+	for _, batch := range batches {
+		sweepables = append(sweepables, batch.sweepables...)
+	}
+
+	return sweepables, nil
+}
@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestList(t *testing.T) {
+	t.Parallel()
+
+	listed := func(sweepables []Sweepable, err error) SweeperFn {
+		return func(context.Context, *conns.AWSClient) ([]Sweepable, error) {
+			return sweepables, err
+		}
+	}
+	sweepers := map[string]*sweeper{
+		"aws_a": {name: "aws_a", dependencies: []string{"aws_b"}, list: listed([]Sweepable{testSweepable{id: "a-1"}, testSweepable{id: "a-2"}}, nil)},
+		"aws_b": {name: "aws_b", list: listed(nil, errors.New("failed"))},
+		"aws_c": {name: "aws_c", f: func(string) error {
+			t.Error("unexpected sweep")
+			return nil
+		}},
+		"aws_d": {name: "aws_d", f: func(region string) error {
+			return SweepOrchestrator(Context(region), []Sweepable{testSweepable{id: "d-1"}})
+		}, collectable: true},
+	}
+
+	type result struct {
+		Sweeper   string
+		Status    SweeperStatus
+		Reason    string
+		Resources int
+	}
+
+	testCases := map[string]struct {
+		names       []string
+		expected    []result
+		expectError bool
+	}{
+		"all": {
+			expected: []result{
+				{"aws_a", SweeperStatusListed, "", 2},
+				{"aws_b", SweeperStatusFailed, `listing "aws_b": failed`, 0},
+				{"aws_c", SweeperStatusSkipped, "listing not supported by sweeper", 0},
+				{"aws_d", SweeperStatusListed, "", 1},
+			},
+		},
+		"without dependencies": {
+			names: []string{"aws_a"},
+			expected: []result{
+				{"aws_a", SweeperStatusListed, "", 2},
+			},
+		},
+		"not found": {
+			names:       []string{"aws_e"},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			results, err := list(context.Background(), "us-west-2", sweepers, testCase.names, nil)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("error: got %v, want error %t", err, want)
+			}
+
+			var got []result
+			for _, v := range results {
+				got = append(got, result{v.Sweeper, v.Status, v.Reason, len(v.Sweepables)})
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected results (-got +want):\n%s", diff)
+			}
+		})
+	}
+}
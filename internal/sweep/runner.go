@@ -0,0 +1,447 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-log/tfsdklog"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/depgraph"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+)
+
+// sweeper is a registered sweeper.
+type sweeper struct {
+	name         string
+	dependencies []string // Sweepers that must run before this one.
+	list         SweeperFn
+	f            func(region string) error
+	collectable  bool // Whether f passes all resources to SweepOrchestrator.
+}
+
+var (
+	sweepers   = make(map[string]*sweeper)
+	sweepersMu sync.Mutex
+)
+
+func registerSweeper(s *sweeper) {
+	sweepersMu.Lock()
+	defer sweepersMu.Unlock()
+
+	sweepers[s.name] = s
+}
+
+// Identifiable is implemented by Sweepables that can identify the resource to be swept.
+type Identifiable interface {
+	ID() string
+	Name() string
+}
+
+// Taggable is implemented by Sweepables that can read the tags of the resource to be swept.
+type Taggable interface {
+	Tags(ctx context.Context) (map[string]string, error)
+}
+
+// RunOptions configures a run of the registered sweepers.
+type RunOptions struct {
+	// Regions to sweep concurrently.
+	Regions []string
+	// Sweepers to run, together with their dependencies. All registered sweepers run if empty.
+	Sweepers []string
+	// DryRun lists the resources that would be swept without deleting them.
+	DryRun bool
+	// Parallelism is the maximum number of sweepers that run concurrently in each Region.
+	Parallelism int
+	// Prefixes restricts sweeping to resources whose name or ID starts with one of the prefixes.
+	Prefixes []string
+	// Tags restricts sweeping to resources that have all of the tags.
+	// An empty tag value matches any value.
+	Tags map[string]string
+}
+
+func (o RunOptions) filtered() bool {
+	return len(o.Prefixes) > 0 || len(o.Tags) > 0
+}
+
+type SweeperStatus string
+
+const (
+	SweeperStatusFailed  SweeperStatus = "failed"
+	SweeperStatusListed  SweeperStatus = "listed"
+	SweeperStatusSkipped SweeperStatus = "skipped"
+	SweeperStatusSwept   SweeperStatus = "swept"
+)
+
+// Report summarizes a run of the registered sweepers.
+type Report struct {
+	DryRun  bool                  `json:"dry_run"`
+	Regions []string              `json:"regions"`
+	Summary map[SweeperStatus]int `json:"summary"`
+	// Resources is the number of resources listed or swept by sweepers that list resources.
+	Resources int              `json:"resources"`
+	Results   []*SweeperResult `json:"results"`
+}
+
+// Failed returns whether any sweeper failed.
+func (r *Report) Failed() bool {
+	return r.Summary[SweeperStatusFailed] > 0
+}
+
+// SweeperResult is the result of running a sweeper in a Region.
+type SweeperResult struct {
+	Region     string           `json:"region"`
+	Sweeper    string           `json:"sweeper"`
+	Status     SweeperStatus    `json:"status"`
+	Reason     string           `json:"reason,omitempty"`
+	Resources  []ResourceResult `json:"resources,omitempty"`
+	DurationMS int64            `json:"duration_ms"`
+}
+
+// ResourceResult identifies a resource listed or swept by a sweeper.
+type ResourceResult struct {
+	ID   string            `json:"id,omitempty"`
+	Name string            `json:"name,omitempty"`
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// Run runs the registered sweepers in dependency order, concurrently across Regions.
+// Within a Region, a sweeper runs as soon as all of its dependencies have run,
+// and is skipped if any of its dependencies failed.
+func Run(ctx context.Context, opts RunOptions) (*Report, error) {
+	sweepersMu.Lock()
+	registered := maps.Clone(sweepers)
+	sweepersMu.Unlock()
+
+	return run(ctx, registered, opts)
+}
+
+func run(ctx context.Context, sweepers map[string]*sweeper, opts RunOptions) (*Report, error) {
+	g, err := sweeperGraph(ctx, sweepers, opts.Sweepers)
+
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{
+		DryRun:  opts.DryRun,
+		Regions: opts.Regions,
+		Summary: make(map[SweeperStatus]int),
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	for _, region := range opts.Regions {
+		wg.Add(1)
+
+		go func(region string) {
+			defer wg.Done()
+
+			ctx := logger(tfsdklog.RegisterStdlogSink(ctx), "sweeper", region)
+			results := runRegion(ctx, g, sweepers, region, opts)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			report.Results = append(report.Results, results...)
+		}(region)
+	}
+
+	wg.Wait()
+
+	slices.SortFunc(report.Results, func(a, b *SweeperResult) int {
+		if v := strings.Compare(a.Region, b.Region); v != 0 {
+			return v
+		}
+		return strings.Compare(a.Sweeper, b.Sweeper)
+	})
+
+	for _, v := range report.Results {
+		report.Summary[v.Status]++
+		report.Resources += len(v.Resources)
+	}
+
+	return report, nil
+}
+
+// sweeperGraph returns the dependency graph of the specified sweepers and their dependencies.
+// All sweepers are included if none are specified.
+func sweeperGraph(ctx context.Context, sweepers map[string]*sweeper, names []string) (*depgraph.Graph, error) {
+	g := depgraph.New()
+
+	keys := tfmaps.Keys(sweepers)
+	slices.Sort(keys)
+
+	for _, name := range keys {
+		g.AddNode(name)
+	}
+
+	for _, name := range keys {
+		for _, dependency := range sweepers[name].dependencies {
+			if !g.HasNode(dependency) {
+				tflog.Warn(ctx, "Sweeper dependency not found", map[string]any{
+					"sweeper":    name,
+					"dependency": dependency,
+				})
+				continue
+			}
+
+			if err := g.AddDependency(name, dependency); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if len(names) > 0 {
+		include := make(map[string]struct{})
+
+		for _, name := range names {
+			if !g.HasNode(name) {
+				return nil, fmt.Errorf("sweeper (%s) not found", name)
+			}
+
+			dependencies, err := g.DependenciesOf(name)
+			if err != nil {
+				return nil, err
+			}
+
+			include[name] = struct{}{}
+			for _, dependency := range dependencies {
+				include[dependency] = struct{}{}
+			}
+		}
+
+		for _, name := range keys {
+			if _, ok := include[name]; !ok {
+				g.RemoveNode(name)
+			}
+		}
+	}
+
+	// Detect dependency cycles.
+	if _, err := g.OverallOrder(); err != nil {
+		return nil, err
+	}
+
+	return g, nil
+}
+
+// runRegion runs the sweepers in the dependency graph in a single Region.
+func runRegion(ctx context.Context, g *depgraph.Graph, sweepers map[string]*sweeper, region string, opts RunOptions) []*SweeperResult {
+	order, _ := g.OverallOrder()
+
+	done := make(map[string]chan struct{}, len(order))
+	dependencies := make(map[string][]string, len(order))
+	for _, name := range order {
+		done[name] = make(chan struct{})
+		dependencies[name], _ = g.DirectDependenciesOf(name)
+	}
+
+	var mu sync.Mutex
+	results := make(map[string]*SweeperResult, len(order))
+	blocked := make(map[string]bool, len(order)) // Failed, or skipped because a dependency failed.
+	sem := make(chan struct{}, max(opts.Parallelism, 1))
+
+	for _, name := range order {
+		go func(name string) {
+			defer close(done[name])
+
+			var failedDependency string
+			for _, dependency := range dependencies[name] {
+				<-done[dependency]
+
+				mu.Lock()
+				if blocked[dependency] && failedDependency == "" {
+					failedDependency = dependency
+				}
+				mu.Unlock()
+			}
+
+			var result *SweeperResult
+			if failedDependency != "" {
+				result = &SweeperResult{
+					Region:  region,
+					Sweeper: name,
+					Status:  SweeperStatusSkipped,
+					Reason:  fmt.Sprintf("dependency (%s) failed", failedDependency),
+				}
+			} else {
+				sem <- struct{}{}
+				result = runSweeper(ctx, sweepers[name], region, opts)
+				<-sem
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			results[name] = result
+			blocked[name] = result.Status == SweeperStatusFailed || failedDependency != ""
+		}(name)
+	}
+
+	for _, name := range order {
+		<-done[name]
+	}
+
+	return tfmaps.Values(results)
+}
+
+// runSweeper runs a single sweeper in a Region.
+func runSweeper(ctx context.Context, s *sweeper, region string, opts RunOptions) *SweeperResult {
+	ctx = logWithResourceType(ctx, s.name)
+	start := time.Now()
+
+	result := &SweeperResult{
+		Region:  region,
+		Sweeper: s.name,
+	}
+	setStatus := func(status SweeperStatus, reason string) *SweeperResult {
+		result.Status = status
+		result.Reason = reason
+		result.DurationMS = time.Since(start).Milliseconds()
+
+		tflog.Info(ctx, "Sweeper finished", map[string]any{
+			"status": status,
+			"reason": reason,
+		})
+
+		return result
+	}
+
+	// Sweepers that don't list resources sweep all resources themselves.
+	// On a dry run or when filters are set, the resources that they pass to SweepOrchestrator are collected instead.
+	if s.list == nil {
+		if !opts.DryRun && !opts.filtered() {
+			if err := s.f(region); err != nil {
+				return setStatus(SweeperStatusFailed, err.Error())
+			}
+
+			return setStatus(SweeperStatusSwept, "")
+		}
+
+		if !s.collectable {
+			// Sweeping all resources would ignore the filters.
+			if opts.filtered() {
+				return setStatus(SweeperStatusFailed, "filters not supported by sweeper")
+			}
+
+			return setStatus(SweeperStatusSkipped, "dry run not supported by sweeper")
+		}
+
+		batches, err := collect(s, region)
+		if err != nil {
+			return setStatus(SweeperStatusFailed, err.Error())
+		}
+
+		for i, batch := range batches {
+			var resources []ResourceResult
+			batches[i].sweepables, resources = filterSweepables(ctx, batch.sweepables, opts)
+			result.Resources = append(result.Resources, resources...)
+		}
+
+		if opts.DryRun {
+			return setStatus(SweeperStatusListed, "")
+		}
+
+		// Batches are swept in order, as a sweeper may depend on the resources of one being deleted before the next.
+		for _, batch := range batches {
+			if err := sweepOrchestrator(ctx, batch.sweepables, batch.optFns...); err != nil {
+				return setStatus(SweeperStatusFailed, fmt.Sprintf("sweeping %q (%s): %s", s.name, region, err))
+			}
+		}
+
+		return setStatus(SweeperStatusSwept, "")
+	}
+
+	client, err := SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return setStatus(SweeperStatusFailed, fmt.Sprintf("getting client: %s", err))
+	}
+
+	sweepables, err := s.list(ctx, client)
+
+	if SkipSweepError(err) {
+		return setStatus(SweeperStatusSkipped, err.Error())
+	}
+	if err != nil {
+		return setStatus(SweeperStatusFailed, fmt.Sprintf("listing %q (%s): %s", s.name, region, err))
+	}
+
+	sweepables, result.Resources = filterSweepables(ctx, sweepables, opts)
+
+	if opts.DryRun {
+		return setStatus(SweeperStatusListed, "")
+	}
+
+	if err := sweepOrchestrator(ctx, sweepables); err != nil {
+		return setStatus(SweeperStatusFailed, fmt.Sprintf("sweeping %q (%s): %s", s.name, region, err))
+	}
+
+	return setStatus(SweeperStatusSwept, "")
+}
+
+// filterSweepables returns the Sweepables that match the run's filters, and the resources that they sweep.
+// A resource that can't be identified or whose tags can't be read doesn't match a filter.
+func filterSweepables(ctx context.Context, sweepables []Sweepable, opts RunOptions) ([]Sweepable, []ResourceResult) {
+	var matched []Sweepable
+	var resources []ResourceResult
+
+	for _, sweepable := range sweepables {
+		var resource ResourceResult
+
+		if v, ok := sweepable.(Identifiable); ok {
+			resource.ID, resource.Name = v.ID(), v.Name()
+		}
+
+		if len(opts.Prefixes) > 0 && !slices.ContainsFunc(opts.Prefixes, func(prefix string) bool {
+			return (resource.Name != "" && strings.HasPrefix(resource.Name, prefix)) || (resource.ID != "" && strings.HasPrefix(resource.ID, prefix))
+		}) {
+			continue
+		}
+
+		if len(opts.Tags) > 0 {
+			v, ok := sweepable.(Taggable)
+			if !ok {
+				continue
+			}
+
+			tags, err := v.Tags(ctx)
+			if err != nil {
+				tflog.Warn(ctx, "Reading resource tags", map[string]any{
+					"id":    resource.ID,
+					"error": err.Error(),
+				})
+				continue
+			}
+
+			if !tagsMatch(tags, opts.Tags) {
+				continue
+			}
+
+			resource.Tags = tags
+		}
+
+		matched = append(matched, sweepable)
+		resources = append(resources, resource)
+	}
+
+	return matched, resources
+}
+
+func tagsMatch(tags, filter map[string]string) bool {
+	for k, v := range filter {
+		if tag, ok := tags[k]; !ok || (v != "" && tag != v) {
+			return false
+		}
+	}
+
+	return true
+}
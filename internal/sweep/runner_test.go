@@ -0,0 +1,424 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestSweeperGraph(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	sweepers := map[string]*sweeper{
+		"aws_a": {name: "aws_a", dependencies: []string{"aws_b", "aws_missing"}},
+		"aws_b": {name: "aws_b", dependencies: []string{"aws_c"}},
+		"aws_c": {name: "aws_c"},
+		"aws_d": {name: "aws_d"},
+	}
+
+	testCases := map[string]struct {
+		names         []string
+		expectedOrder []string
+		expectError   bool
+	}{
+		"all": {
+			expectedOrder: []string{"aws_c", "aws_b", "aws_a", "aws_d"},
+		},
+		"with dependencies": {
+			names:         []string{"aws_b"},
+			expectedOrder: []string{"aws_c", "aws_b"},
+		},
+		"not found": {
+			names:       []string{"aws_e"},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			g, err := sweeperGraph(ctx, sweepers, testCase.names)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("error: got %v, want error %t", err, want)
+			}
+
+			if err != nil {
+				return
+			}
+
+			order, err := g.OverallOrder()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(order, testCase.expectedOrder); diff != "" {
+				t.Errorf("unexpected order (-got +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSweeperGraphCycle(t *testing.T) {
+	t.Parallel()
+
+	sweepers := map[string]*sweeper{
+		"aws_a": {name: "aws_a", dependencies: []string{"aws_b"}},
+		"aws_b": {name: "aws_b", dependencies: []string{"aws_a"}},
+	}
+
+	if _, err := sweeperGraph(context.Background(), sweepers, nil); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	var swept []string
+
+	f := func(name string, err error) func(string) error {
+		return func(region string) error {
+			mu.Lock()
+			defer mu.Unlock()
+
+			swept = append(swept, region+"/"+name)
+
+			return err
+		}
+	}
+	sweepers := map[string]*sweeper{
+		"aws_a": {name: "aws_a", dependencies: []string{"aws_b"}, f: f("aws_a", nil)},
+		"aws_b": {name: "aws_b", f: f("aws_b", nil)},
+		"aws_c": {name: "aws_c", dependencies: []string{"aws_d"}, f: f("aws_c", nil)},
+		"aws_d": {name: "aws_d", f: f("aws_d", errors.New("failed"))},
+	}
+
+	report, err := run(context.Background(), sweepers, RunOptions{
+		Regions:     []string{"us-west-2", "us-east-1"},
+		Parallelism: 2,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, region := range []string{"us-west-2", "us-east-1"} {
+		a, b := slices.Index(swept, region+"/aws_a"), slices.Index(swept, region+"/aws_b")
+		if a == -1 || b == -1 || a < b {
+			t.Errorf("%s: expected aws_b to be swept before aws_a, got %v", region, swept)
+		}
+		if slices.Contains(swept, region+"/aws_c") {
+			t.Errorf("%s: expected aws_c not to be swept, got %v", region, swept)
+		}
+	}
+
+	if !report.Failed() {
+		t.Error("expected report to fail")
+	}
+
+	type result struct {
+		Region, Sweeper string
+		Status          SweeperStatus
+		Reason          string
+	}
+	var got []result
+	for _, v := range report.Results {
+		got = append(got, result{v.Region, v.Sweeper, v.Status, v.Reason})
+	}
+	want := []result{
+		{"us-east-1", "aws_a", SweeperStatusSwept, ""},
+		{"us-east-1", "aws_b", SweeperStatusSwept, ""},
+		{"us-east-1", "aws_c", SweeperStatusSkipped, "dependency (aws_d) failed"},
+		{"us-east-1", "aws_d", SweeperStatusFailed, "failed"},
+		{"us-west-2", "aws_a", SweeperStatusSwept, ""},
+		{"us-west-2", "aws_b", SweeperStatusSwept, ""},
+		{"us-west-2", "aws_c", SweeperStatusSkipped, "dependency (aws_d) failed"},
+		{"us-west-2", "aws_d", SweeperStatusFailed, "failed"},
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected results (-got +want):\n%s", diff)
+	}
+
+	if diff := cmp.Diff(report.Summary, map[SweeperStatus]int{
+		SweeperStatusFailed:  2,
+		SweeperStatusSkipped: 2,
+		SweeperStatusSwept:   4,
+	}); diff != "" {
+		t.Errorf("unexpected summary (-got +want):\n%s", diff)
+	}
+}
+
+func TestRunDryRun(t *testing.T) {
+	t.Parallel()
+
+	sweepers := map[string]*sweeper{
+		"aws_a": {name: "aws_a", f: func(string) error {
+			t.Error("unexpected sweep")
+			return nil
+		}},
+	}
+
+	report, err := run(context.Background(), sweepers, RunOptions{
+		Regions: []string{"us-west-2"},
+		DryRun:  true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := report.Results[0].Status, SweeperStatusSkipped; got != want {
+		t.Errorf("status: got %s, want %s", got, want)
+	}
+}
+
+func TestRunCollected(t *testing.T) {
+	t.Parallel()
+
+	f := func(region string) error {
+		return SweepOrchestrator(Context(region), []Sweepable{
+			testSweepable{id: "tf-acc-test-1"},
+			testSweepable{id: "production"},
+		})
+	}
+	sweepers := map[string]*sweeper{
+		"aws_a": {name: "aws_a", f: f, collectable: true},
+		"aws_b": {name: "aws_b", f: func(string) error {
+			t.Error("unexpected sweep")
+			return nil
+		}},
+	}
+
+	testCases := map[string]struct {
+		opts     RunOptions
+		expected map[string]SweeperStatus
+	}{
+		"dry run": {
+			opts: RunOptions{DryRun: true},
+			expected: map[string]SweeperStatus{
+				"aws_a": SweeperStatusListed,
+				"aws_b": SweeperStatusSkipped,
+			},
+		},
+		"filtered": {
+			opts: RunOptions{Prefixes: []string{"tf-acc-test"}},
+			expected: map[string]SweeperStatus{
+				"aws_a": SweeperStatusSwept,
+				"aws_b": SweeperStatusFailed,
+			},
+		},
+		"filtered dry run": {
+			opts: RunOptions{DryRun: true, Prefixes: []string{"tf-acc-test"}},
+			expected: map[string]SweeperStatus{
+				"aws_a": SweeperStatusListed,
+				"aws_b": SweeperStatusFailed,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			opts := testCase.opts
+			opts.Regions = []string{"us-west-2", "us-east-1"}
+
+			report, err := run(context.Background(), sweepers, opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, v := range report.Results {
+				if got, want := v.Status, testCase.expected[v.Sweeper]; got != want {
+					t.Errorf("%s/%s status: got %s, want %s (%s)", v.Region, v.Sweeper, got, want, v.Reason)
+				}
+
+				if v.Sweeper != "aws_a" {
+					continue
+				}
+
+				var got []string
+				for _, resource := range v.Resources {
+					got = append(got, resource.ID)
+				}
+				want := []string{"tf-acc-test-1", "production"}
+				if opts.filtered() {
+					want = want[:1]
+				}
+
+				if diff := cmp.Diff(got, want); diff != "" {
+					t.Errorf("%s/%s unexpected resources (-got +want):\n%s", v.Region, v.Sweeper, diff)
+				}
+			}
+
+			if got, want := report.Failed(), opts.filtered(); got != want {
+				t.Errorf("report failed: got %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestRunCollectedChildGoroutines(t *testing.T) {
+	t.Parallel()
+
+	// Each sweeper lists its resources from child goroutines, each with its own context.
+	f := func(prefix string) func(string) error {
+		return func(region string) error {
+			var wg sync.WaitGroup
+			errs := make(chan error, 2)
+
+			for i := range 2 {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+
+					errs <- SweepOrchestrator(Context(region), []Sweepable{testSweepable{id: fmt.Sprintf("%s-%d", prefix, i)}})
+				}()
+			}
+
+			wg.Wait()
+			close(errs)
+
+			for err := range errs {
+				if err != nil {
+					return err
+				}
+			}
+
+			return nil
+		}
+	}
+	sweepers := map[string]*sweeper{
+		"aws_a": {name: "aws_a", f: f("a"), collectable: true},
+		"aws_b": {name: "aws_b", f: f("b"), collectable: true},
+	}
+
+	report, err := run(context.Background(), sweepers, RunOptions{
+		Regions:     []string{"us-west-2", "us-east-1"},
+		DryRun:      true,
+		Parallelism: 4,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := len(report.Results), 4; got != want {
+		t.Fatalf("results: got %d, want %d", got, want)
+	}
+
+	for _, v := range report.Results {
+		if got, want := v.Status, SweeperStatusListed; got != want {
+			t.Errorf("%s (%s) status: got %s, want %s (%s)", v.Sweeper, v.Region, got, want, v.Reason)
+		}
+
+		var got []string
+		for _, resource := range v.Resources {
+			got = append(got, resource.ID)
+		}
+		slices.Sort(got)
+		prefix := strings.TrimPrefix(v.Sweeper, "aws_")
+		want := []string{prefix + "-0", prefix + "-1"}
+
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Errorf("%s (%s) unexpected resources (-got +want):\n%s", v.Sweeper, v.Region, diff)
+		}
+	}
+}
+
+type testSweepable struct {
+	id, name string
+	tags     map[string]string
+}
+
+func (s testSweepable) Delete(context.Context, time.Duration, ...tfresource.OptionsFunc) error {
+	return nil
+}
+
+func (s testSweepable) ID() string {
+	return s.id
+}
+
+func (s testSweepable) Name() string {
+	return s.name
+}
+
+func (s testSweepable) Tags(context.Context) (map[string]string, error) {
+	return s.tags, nil
+}
+
+func TestFilterSweepables(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	sweepables := []Sweepable{
+		testSweepable{id: "id-1", name: "tf-acc-test-1", tags: map[string]string{"Owner": "test"}},
+		testSweepable{id: "tf-acc-test-2", tags: map[string]string{"Owner": "other"}},
+		testSweepable{id: "id-3", name: "production", tags: map[string]string{"Owner": "test"}},
+	}
+
+	testCases := map[string]struct {
+		opts     RunOptions
+		expected []string
+	}{
+		"no filters": {
+			expected: []string{"id-1", "tf-acc-test-2", "id-3"},
+		},
+		"prefix": {
+			opts:     RunOptions{Prefixes: []string{"tf-acc-test"}},
+			expected: []string{"id-1", "tf-acc-test-2"},
+		},
+		"tag value": {
+			opts:     RunOptions{Tags: map[string]string{"Owner": "test"}},
+			expected: []string{"id-1", "id-3"},
+		},
+		"tag key": {
+			opts:     RunOptions{Tags: map[string]string{"Owner": ""}},
+			expected: []string{"id-1", "tf-acc-test-2", "id-3"},
+		},
+		"prefix and tag": {
+			opts:     RunOptions{Prefixes: []string{"tf-acc-test"}, Tags: map[string]string{"Owner": "test"}},
+			expected: []string{"id-1"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			matched, resources := filterSweepables(ctx, sweepables, testCase.opts)
+
+			if got, want := len(matched), len(resources); got != want {
+				t.Fatalf("got %d sweepables and %d resources", got, want)
+			}
+
+			var got []string
+			for _, v := range resources {
+				got = append(got, v.ID)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected resources (-got +want):\n%s", diff)
+			}
+		})
+	}
+}
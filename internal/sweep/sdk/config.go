@@ -0,0 +1,215 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"slices"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// Importable returns whether the resource to be swept can be imported using its ID.
+func (sr *sweepResource) Importable(context.Context) bool {
+	return sr.resource.Importer != nil
+}
+
+// Config imports and reads the resource to be swept and writes its configurable arguments to the body of a resource block.
+// Returns false if the resource no longer exists.
+func (sr *sweepResource) Config(ctx context.Context, body *hclwrite.Body) (bool, error) {
+	ctx = tflog.SetField(ctx, "id", sr.d.Id())
+
+	d := sr.d
+	if importer := sr.resource.Importer; importer != nil && importer.StateContext != nil {
+		v, err := importer.StateContext(ctx, d, sr.meta)
+
+		if err != nil {
+			return false, err
+		}
+
+		if len(v) > 0 {
+			d = v[0]
+		}
+	}
+
+	if err := ReadResource(ctx, sr.resource, d, sr.meta); err != nil {
+		return false, err
+	}
+
+	if d.Id() == "" {
+		return false, nil
+	}
+
+	values := make(map[string]any)
+	for k := range sr.resource.SchemaMap() {
+		values[k] = d.Get(k)
+	}
+
+	WriteConfig(body, sr.resource.SchemaMap(), values)
+
+	return true, nil
+}
+
+// WriteConfig writes the configurable arguments with non-zero values to a block body.
+// Required arguments are always written. Sensitive and deprecated arguments, and arguments that conflict
+// with an argument already written, are not written.
+func WriteConfig(body *hclwrite.Body, schemaMap map[string]*schema.Schema, values map[string]any) {
+	keys := tfmaps.Keys(schemaMap)
+	slices.Sort(keys)
+
+	written := make(map[string]bool)
+
+	for _, k := range keys {
+		s := schemaMap[k]
+
+		if !s.Required && !s.Optional {
+			continue
+		}
+
+		if s.Sensitive || s.Deprecated != "" {
+			continue
+		}
+
+		if slices.ContainsFunc(s.ConflictsWith, func(k string) bool { return written[k] }) {
+			continue
+		}
+
+		v := values[k]
+
+		if !s.Required && isZero(v) {
+			continue
+		}
+
+		if r, ok := s.Elem.(*schema.Resource); ok && (s.Type == schema.TypeList || s.Type == schema.TypeSet) {
+			for _, v := range listValue(v) {
+				if v, ok := v.(map[string]any); ok {
+					WriteConfig(body.AppendNewBlock(k, nil).Body(), r.SchemaMap(), v)
+				}
+			}
+		} else {
+			v, ok := ctyValue(s, v)
+
+			if !ok {
+				continue
+			}
+
+			body.SetAttributeValue(k, v)
+		}
+
+		written[k] = true
+	}
+}
+
+// ctyValue returns the cty.Value of an attribute's value as returned by schema.ResourceData.Get.
+func ctyValue(s *schema.Schema, v any) (cty.Value, bool) {
+	switch s.Type {
+	case schema.TypeString:
+		if v, ok := v.(string); ok {
+			return cty.StringVal(v), true
+		}
+
+	case schema.TypeInt:
+		if v, ok := v.(int); ok {
+			return cty.NumberIntVal(int64(v)), true
+		}
+
+	case schema.TypeFloat:
+		if v, ok := v.(float64); ok {
+			return cty.NumberFloatVal(v), true
+		}
+
+	case schema.TypeBool:
+		if v, ok := v.(bool); ok {
+			return cty.BoolVal(v), true
+		}
+
+	case schema.TypeList, schema.TypeSet:
+		var elems []cty.Value
+
+		for _, v := range listValue(v) {
+			v, ok := ctyValue(elemSchema(s), v)
+
+			if !ok {
+				return cty.NilVal, false
+			}
+
+			elems = append(elems, v)
+		}
+
+		if len(elems) == 0 {
+			return cty.ListValEmpty(cty.DynamicPseudoType), true
+		}
+
+		return cty.TupleVal(elems), true
+
+	case schema.TypeMap:
+		m, ok := v.(map[string]any)
+		if !ok {
+			break
+		}
+
+		elems := make(map[string]cty.Value, len(m))
+
+		for k, v := range m {
+			v, ok := ctyValue(elemSchema(s), v)
+
+			if !ok {
+				return cty.NilVal, false
+			}
+
+			elems[k] = v
+		}
+
+		return cty.ObjectVal(elems), true
+	}
+
+	return cty.NilVal, false
+}
+
+// elemSchema returns the schema of a collection attribute's elements. Elements are strings by default.
+func elemSchema(s *schema.Schema) *schema.Schema {
+	if v, ok := s.Elem.(*schema.Schema); ok {
+		return v
+	}
+
+	return &schema.Schema{Type: schema.TypeString}
+}
+
+func listValue(v any) []any {
+	switch v := v.(type) {
+	case []any:
+		return v
+	case *schema.Set:
+		return v.List()
+	}
+
+	return nil
+}
+
+func isZero(v any) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case int:
+		return v == 0
+	case float64:
+		return v == 0
+	case bool:
+		return !v
+	case []any:
+		return len(v) == 0
+	case map[string]any:
+		return len(v) == 0
+	case *schema.Set:
+		return v.Len() == 0
+	}
+
+	return false
+}
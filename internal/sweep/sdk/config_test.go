@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/sdk"
+)
+
+func TestWriteConfig(t *testing.T) {
+	t.Parallel()
+
+	schemaMap := map[string]*schema.Schema{
+		"arn": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"delay": {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		"enabled": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		"name": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			Computed:      true,
+			ConflictsWith: []string{"name_prefix"},
+		},
+		"name_prefix": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			Computed:      true,
+			ConflictsWith: []string{"name"},
+		},
+		"password": {
+			Type:      schema.TypeString,
+			Optional:  true,
+			Sensitive: true,
+		},
+		"policy": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"rule": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"priority": {
+						Type:     schema.TypeInt,
+						Required: true,
+					},
+					"values": {
+						Type:     schema.TypeSet,
+						Optional: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+				},
+			},
+		},
+		"tags": {
+			Type:     schema.TypeMap,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+	}
+
+	values := map[string]any{
+		"arn":         "arn:aws:example:us-west-2:123456789012:widget/test",
+		"delay":       0,
+		"enabled":     true,
+		"name":        "test",
+		"name_prefix": "te",
+		"password":    "secret",
+		"policy":      "",
+		"rule": []any{
+			map[string]any{
+				"priority": 1,
+				"values":   schema.NewSet(schema.HashString, []any{"a"}),
+			},
+		},
+		"tags": map[string]any{
+			"Name": "test",
+		},
+	}
+
+	f := hclwrite.NewEmptyFile()
+	sdk.WriteConfig(f.Body(), schemaMap, values)
+
+	want := `enabled = true
+name    = "test"
+policy  = ""
+rule {
+  priority = 1
+  values   = ["a"]
+}
+tags = {
+  Name = "test"
+}
+`
+
+	if got := string(f.Bytes()); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
@@ -16,8 +16,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/maps"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type sweepResource struct {
@@ -89,6 +91,46 @@ func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optF
 	return err
 }
 
+// ID returns the ID of the resource to be swept.
+func (sr *sweepResource) ID() string {
+	return sr.d.Id()
+}
+
+// Name returns the name of the resource to be swept, if known.
+func (sr *sweepResource) Name() string {
+	if _, ok := sr.resource.SchemaMap()[names.AttrName]; !ok {
+		return ""
+	}
+
+	v, _ := sr.d.Get(names.AttrName).(string)
+
+	return v
+}
+
+// Tags reads the resource to be swept and returns its tags.
+// Returns nil if the resource does not support tags.
+func (sr *sweepResource) Tags(ctx context.Context) (map[string]string, error) {
+	schemaMap := sr.resource.SchemaMap()
+	if _, ok := schemaMap[names.AttrTags]; !ok {
+		return nil, nil
+	}
+
+	ctx = tflog.SetField(ctx, "id", sr.d.Id())
+
+	if err := ReadResource(ctx, sr.resource, sr.d, sr.meta); err != nil {
+		return nil, err
+	}
+
+	key := names.AttrTags
+	if _, ok := schemaMap[names.AttrTagsAll]; ok {
+		key = names.AttrTagsAll
+	}
+
+	v, _ := sr.d.Get(key).(map[string]interface{})
+
+	return flex.ExpandStringValueMap(v), nil
+}
+
 type readerSweepResource struct {
 	sweepResource
 }
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"sync"
 	"time"
 
 	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
@@ -29,15 +30,21 @@ const (
 
 const defaultSweeperAssumeRoleDurationSeconds = 3600
 
-// ServicePackages is set in TestMain in order to break an import cycle.
+// ServicePackages is set in TestMain, or by the sweep command, in order to break an import cycle.
 var ServicePackages []conns.ServicePackage
 
 // sweeperClients is a shared cache of regional conns.AWSClient
 // This prevents client re-initialization for every resource with no benefit.
-var sweeperClients map[string]*conns.AWSClient = make(map[string]*conns.AWSClient)
+var (
+	sweeperClients   map[string]*conns.AWSClient = make(map[string]*conns.AWSClient)
+	sweeperClientsMu sync.Mutex
+)
 
 // SharedRegionalSweepClient returns a common conns.AWSClient setup needed for the sweeper functions for a given Region.
 func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSClient, error) {
+	sweeperClientsMu.Lock()
+	defer sweeperClientsMu.Unlock()
+
 	if client, ok := sweeperClients[region]; ok {
 		return client, nil
 	}
@@ -105,7 +112,18 @@ type Sweepable interface {
 	Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error
 }
 
+// SweepOrchestrator sweeps the Sweepables concurrently.
+// If the sweeper that calls it is being listed, or its resources filtered, the Sweepables are collected instead.
 func SweepOrchestrator(ctx context.Context, sweepables []Sweepable, optFns ...tfresource.OptionsFunc) error {
+	if c := collectorFor(ctx); c != nil {
+		c.add(sweepables, optFns)
+		return nil
+	}
+
+	return sweepOrchestrator(ctx, sweepables, optFns...)
+}
+
+func sweepOrchestrator(ctx context.Context, sweepables []Sweepable, optFns ...tfresource.OptionsFunc) error {
 	if len(sweepables) == 0 {
 		tflog.Info(ctx, "No resources to sweep")
 	}
@@ -136,9 +154,17 @@ func PartitionDNSSuffix(region string) string {
 
 type SweeperFn func(ctx context.Context, client *conns.AWSClient) ([]Sweepable, error)
 
+// Register registers a sweeper that lists the resources to be swept.
 func Register(name string, f SweeperFn, dependencies ...string) {
+	registerSweeper(&sweeper{
+		name:         name,
+		dependencies: dependencies,
+		list:         f,
+	})
+
 	resource.AddTestSweepers(name, &resource.Sweeper{
-		Name: name,
+		Name:         name,
+		Dependencies: dependencies,
 		F: func(region string) error {
 			ctx := Context(region)
 			ctx = logWithResourceType(ctx, name)
@@ -168,3 +194,29 @@ func Register(name string, f SweeperFn, dependencies ...string) {
 		},
 	})
 }
+
+// AddTestSweepers registers a sweeper that sweeps all resources in a Region itself.
+// The sweeper must get its context from Context and pass the resources to SweepOrchestrator, without deleting any
+// resources directly, so that they can be listed and filtered.
+func AddTestSweepers(name string, s *resource.Sweeper) {
+	registerSweeper(&sweeper{
+		name:         name,
+		dependencies: s.Dependencies,
+		f:            s.F,
+		collectable:  true,
+	})
+
+	resource.AddTestSweepers(name, s)
+}
+
+// AddUnlistedTestSweepers registers a sweeper that deletes resources directly.
+// Its resources can't be listed or filtered.
+func AddUnlistedTestSweepers(name string, s *resource.Sweeper) {
+	registerSweeper(&sweeper{
+		name:         name,
+		dependencies: s.Dependencies,
+		f:            s.F,
+	})
+
+	resource.AddTestSweepers(name, s)
+}
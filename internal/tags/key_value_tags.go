@@ -33,6 +33,9 @@ const (
 // DefaultConfig contains tags to default across all resources.
 type DefaultConfig struct {
 	Tags KeyValueTags
+	// ReportConflicts enables a warning for each resource whose configured tags
+	// override default tags or whose merged tags exceed the service's limits.
+	ReportConflicts bool
 }
 
 // IgnoreConfig contains various options for removing resource tags.
@@ -0,0 +1,170 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-provider-aws/names/data"
+)
+
+// Limits contains the constraints that a service places on a resource's tags.
+type Limits struct {
+	MaxTags        int
+	MaxKeyLength   int
+	MaxValueLength int
+	// AllowedCharacters, if not nil, must match all tag keys and values.
+	AllowedCharacters *regexp.Regexp
+	// ReservedKeyPrefixes are case-insensitive tag key prefixes that cannot be configured.
+	ReservedKeyPrefixes []string
+	// CaseInsensitiveKeys indicates that tag keys that differ only in case conflict.
+	CaseInsensitiveKeys bool
+}
+
+// DefaultLimits are the tag limits common to most AWS services.
+var DefaultLimits = Limits{
+	MaxTags:             50,
+	MaxKeyLength:        128,
+	MaxValueLength:      256,
+	ReservedKeyPrefixes: []string{awsTagKeyPrefix},
+}
+
+// serviceLimits and resourceLimits are keyed by service package name and Terraform resource type name respectively.
+var (
+	serviceLimits  map[string]Limits
+	resourceLimits map[string]Limits
+)
+
+func init() {
+	serviceLimits = make(map[string]Limits)
+	resourceLimits = make(map[string]Limits)
+
+	// Data from tag_limits.csv
+	if err := readCSVIntoLimits(); err != nil {
+		log.Fatalf("reading CSV into tag limits: %s", err)
+	}
+}
+
+func readCSVIntoLimits() error {
+	d, err := data.ReadAllTagLimitsData()
+	if err != nil {
+		return fmt.Errorf("reading CSV into tag limits: %w", err)
+	}
+
+	for _, l := range d {
+		limits := DefaultLimits
+
+		for _, v := range []struct {
+			s string
+			n *int
+		}{
+			{l.MaxTags(), &limits.MaxTags},
+			{l.MaxKeyLength(), &limits.MaxKeyLength},
+			{l.MaxValueLength(), &limits.MaxValueLength},
+		} {
+			if v.s == "" {
+				continue
+			}
+
+			n, err := strconv.Atoi(v.s)
+			if err != nil {
+				return fmt.Errorf("%s: %w", l.ProviderPackage(), err)
+			}
+
+			*v.n = n
+		}
+
+		if v := l.AllowedCharacters(); v != "" {
+			re, err := regexp.Compile(`^(?:` + v + `)$`)
+			if err != nil {
+				return fmt.Errorf("%s: %w", l.ProviderPackage(), err)
+			}
+
+			limits.AllowedCharacters = re
+		}
+
+		if v := l.ReservedKeyPrefixes(); len(v) > 0 {
+			limits.ReservedKeyPrefixes = v
+		}
+
+		limits.CaseInsensitiveKeys = l.CaseInsensitiveKeys()
+
+		if v := l.ResourceType(); v != "" {
+			resourceLimits[v] = limits
+		} else {
+			serviceLimits[l.ProviderPackage()] = limits
+		}
+	}
+
+	return nil
+}
+
+// LimitsFor returns the tag limits for the specified Terraform resource type in the specified service package.
+// Limits for the resource type take precedence over those for the service package,
+// which take precedence over DefaultLimits.
+func LimitsFor(servicePackageName, typeName string) Limits {
+	if v, ok := resourceLimits[typeName]; ok {
+		return v
+	}
+
+	if v, ok := serviceLimits[servicePackageName]; ok {
+		return v
+	}
+
+	return DefaultLimits
+}
+
+// LimitViolations returns descriptions of the ways in which the tags exceed the specified limits.
+func (tags KeyValueTags) LimitViolations(limits Limits) []string {
+	var violations []string
+
+	if limits.MaxTags > 0 && len(tags) > limits.MaxTags {
+		violations = append(violations, fmt.Sprintf("%d tags, maximum %d", len(tags), limits.MaxTags))
+	}
+
+	keys := make(map[string]string)
+
+	for _, k := range sortedKeys(tags) {
+		v := tags[k].ValueString()
+
+		if n := utf8.RuneCountInString(k); limits.MaxKeyLength > 0 && n > limits.MaxKeyLength {
+			violations = append(violations, fmt.Sprintf("key %q is %d characters, maximum %d", k, n, limits.MaxKeyLength))
+		}
+
+		if n := utf8.RuneCountInString(v); limits.MaxValueLength > 0 && n > limits.MaxValueLength {
+			violations = append(violations, fmt.Sprintf("value of key %q is %d characters, maximum %d", k, n, limits.MaxValueLength))
+		}
+
+		if re := limits.AllowedCharacters; re != nil {
+			if !re.MatchString(k) {
+				violations = append(violations, fmt.Sprintf("key %q contains characters not matching %s", k, re))
+			}
+
+			if !re.MatchString(v) {
+				violations = append(violations, fmt.Sprintf("value of key %q contains characters not matching %s", k, re))
+			}
+		}
+
+		for _, prefix := range limits.ReservedKeyPrefixes {
+			if strings.HasPrefix(strings.ToLower(k), strings.ToLower(prefix)) {
+				violations = append(violations, fmt.Sprintf("key %q has reserved prefix %q", k, prefix))
+			}
+		}
+
+		if limits.CaseInsensitiveKeys {
+			if other, ok := keys[strings.ToLower(k)]; ok {
+				violations = append(violations, fmt.Sprintf("keys %q and %q differ only in case", other, k))
+			} else {
+				keys[strings.ToLower(k)] = k
+			}
+		}
+	}
+
+	return violations
+}
@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestLimitsFor(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name                    string
+		servicePackageName      string
+		typeName                string
+		wantMaxTags             int
+		wantAllowedCharacters   bool
+		wantReservedKeyPrefixes []string
+		wantCaseInsensitiveKeys bool
+	}{
+		{
+			name:                    "default",
+			servicePackageName:      names.SQS,
+			typeName:                "aws_sqs_queue",
+			wantMaxTags:             50,
+			wantReservedKeyPrefixes: []string{"aws:"},
+		},
+		{
+			name:                    "service",
+			servicePackageName:      names.IAM,
+			typeName:                "aws_iam_role",
+			wantMaxTags:             50,
+			wantAllowedCharacters:   true,
+			wantReservedKeyPrefixes: []string{"aws:"},
+			wantCaseInsensitiveKeys: true,
+		},
+		{
+			name:                    "service reserved prefixes",
+			servicePackageName:      names.ElasticBeanstalk,
+			typeName:                "aws_elastic_beanstalk_application",
+			wantMaxTags:             50,
+			wantReservedKeyPrefixes: []string{"aws:", "elasticbeanstalk:"},
+		},
+		{
+			name:                    "resource type",
+			servicePackageName:      names.S3,
+			typeName:                "aws_s3_object",
+			wantMaxTags:             10,
+			wantReservedKeyPrefixes: []string{"aws:"},
+		},
+		{
+			name:                    "service without resource type",
+			servicePackageName:      names.S3,
+			typeName:                "aws_s3_bucket",
+			wantMaxTags:             50,
+			wantReservedKeyPrefixes: []string{"aws:"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := LimitsFor(testCase.servicePackageName, testCase.typeName)
+
+			if got, want := got.MaxTags, testCase.wantMaxTags; got != want {
+				t.Errorf("MaxTags = %d, want %d", got, want)
+			}
+			if got, want := got.AllowedCharacters != nil, testCase.wantAllowedCharacters; got != want {
+				t.Errorf("AllowedCharacters set = %t, want %t", got, want)
+			}
+			if diff := cmp.Diff(got.ReservedKeyPrefixes, testCase.wantReservedKeyPrefixes); diff != "" {
+				t.Errorf("unexpected ReservedKeyPrefixes diff (+wanted, -got): %s", diff)
+			}
+			if got, want := got.CaseInsensitiveKeys, testCase.wantCaseInsensitiveKeys; got != want {
+				t.Errorf("CaseInsensitiveKeys = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestKeyValueTagsLimitViolations(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := []struct {
+		name   string
+		tags   KeyValueTags
+		limits Limits
+		want   []string
+	}{
+		{
+			name:   "valid",
+			tags:   New(ctx, map[string]string{"Name": "test", "Environment": "Production"}),
+			limits: LimitsFor(names.IAM, "aws_iam_role"),
+		},
+		{
+			name:   "too many tags",
+			tags:   New(ctx, map[string]string{"key1": "value1", "key2": "value2", "key3": "value3"}),
+			limits: Limits{MaxTags: 2},
+			want:   []string{"3 tags, maximum 2"},
+		},
+		{
+			name:   "allowed characters",
+			tags:   New(ctx, map[string]string{"key#1": "value1", "key2": "value,2"}),
+			limits: LimitsFor(names.SSM, "aws_ssm_parameter"),
+			want: []string{
+				`key "key#1" contains characters not matching ^(?:[\p{L}\p{Z}\p{N}_.:/=+\-@]*)$`,
+				`value of key "key2" contains characters not matching ^(?:[\p{L}\p{Z}\p{N}_.:/=+\-@]*)$`,
+			},
+		},
+		{
+			name:   "reserved prefix",
+			tags:   New(ctx, map[string]string{"AWS:key1": "value1", "elasticbeanstalk:key2": "value2"}),
+			limits: LimitsFor(names.ElasticBeanstalk, "aws_elastic_beanstalk_environment"),
+			want: []string{
+				`key "AWS:key1" has reserved prefix "aws:"`,
+				`key "elasticbeanstalk:key2" has reserved prefix "elasticbeanstalk:"`,
+			},
+		},
+		{
+			name:   "case insensitive keys",
+			tags:   New(ctx, map[string]string{"CostCenter": "value1", "costcenter": "value2"}),
+			limits: LimitsFor(names.IAM, "aws_iam_user"),
+			want:   []string{`keys "CostCenter" and "costcenter" differ only in case`},
+		},
+		{
+			name:   "case sensitive keys",
+			tags:   New(ctx, map[string]string{"CostCenter": "value1", "costcenter": "value2"}),
+			limits: LimitsFor(names.SQS, "aws_sqs_queue"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.tags.LimitViolations(testCase.limits)
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Report describes where each of a resource's tags comes from.
+type Report struct {
+	// DefaultTags are tags that come only from the provider's default_tags.
+	DefaultTags KeyValueTags
+	// ResourceTags are tags that come from the resource's configuration,
+	// including those that duplicate a provider default_tags key and value.
+	ResourceTags KeyValueTags
+	// OverriddenTags are provider default_tags, with their default values,
+	// whose values are overridden by the resource's configuration.
+	OverriddenTags KeyValueTags
+	// Tags are the resource's configured tags merged with the provider's default_tags.
+	Tags KeyValueTags
+	// LimitViolations describe the ways in which Tags exceed the service's limits.
+	LimitViolations []string
+}
+
+// Report returns a Report of the given resource tags merged with the
+// DefaultConfig's Tags and checked against the given limits.
+func (dc *DefaultConfig) Report(tags KeyValueTags, limits Limits) *Report {
+	defaultTags := dc.GetTags()
+	report := &Report{
+		DefaultTags:    make(KeyValueTags),
+		ResourceTags:   make(KeyValueTags),
+		OverriddenTags: make(KeyValueTags),
+		Tags:           dc.MergeTags(tags),
+	}
+
+	for k, v := range defaultTags {
+		if resourceValue, ok := tags[k]; !ok {
+			report.DefaultTags[k] = v
+		} else if !v.Equal(resourceValue) {
+			report.OverriddenTags[k] = v
+		}
+	}
+
+	for k, v := range tags {
+		report.ResourceTags[k] = v
+	}
+
+	report.LimitViolations = report.Tags.LimitViolations(limits)
+
+	return report
+}
+
+// HasConflicts returns whether any provider default_tags are overridden or any limits are exceeded.
+func (r *Report) HasConflicts() bool {
+	return len(r.OverriddenTags) > 0 || len(r.LimitViolations) > 0
+}
+
+// String returns a human-readable summary of the report.
+func (r *Report) String() string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "from default_tags: %s\n", sortedKeys(r.DefaultTags))
+	fmt.Fprintf(&sb, "from resource configuration: %s\n", sortedKeys(r.ResourceTags))
+
+	overridden := make([]string, 0, len(r.OverriddenTags))
+	for _, k := range sortedKeys(r.OverriddenTags) {
+		overridden = append(overridden, fmt.Sprintf("%s (default %q, configured %q)", k, r.OverriddenTags[k].ValueString(), r.Tags[k].ValueString()))
+	}
+	fmt.Fprintf(&sb, "overridden: [%s]", strings.Join(overridden, ", "))
+
+	for _, v := range r.LimitViolations {
+		fmt.Fprintf(&sb, "\nexceeds limit: %s", v)
+	}
+
+	return sb.String()
+}
+
+func sortedKeys(tags KeyValueTags) []string {
+	keys := tags.Keys()
+	slices.Sort(keys)
+
+	return keys
+}
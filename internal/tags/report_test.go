@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDefaultConfigReport(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := []struct {
+		name               string
+		defaultConfig      *DefaultConfig
+		tags               KeyValueTags
+		limits             Limits
+		wantDefaultTags    map[string]string
+		wantResourceTags   map[string]string
+		wantOverriddenTags map[string]string
+		wantTags           map[string]string
+		wantViolations     []string
+		wantConflicts      bool
+	}{
+		{
+			name:               "nil config",
+			tags:               New(ctx, map[string]string{"key1": "value1"}),
+			limits:             DefaultLimits,
+			wantDefaultTags:    map[string]string{},
+			wantResourceTags:   map[string]string{"key1": "value1"},
+			wantOverriddenTags: map[string]string{},
+			wantTags:           map[string]string{"key1": "value1"},
+		},
+		{
+			name: "no overlap",
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{"key1": "value1"}),
+			},
+			tags:               New(ctx, map[string]string{"key2": "value2"}),
+			limits:             DefaultLimits,
+			wantDefaultTags:    map[string]string{"key1": "value1"},
+			wantResourceTags:   map[string]string{"key2": "value2"},
+			wantOverriddenTags: map[string]string{},
+			wantTags:           map[string]string{"key1": "value1", "key2": "value2"},
+		},
+		{
+			name: "duplicate",
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{"key1": "value1"}),
+			},
+			tags:               New(ctx, map[string]string{"key1": "value1"}),
+			limits:             DefaultLimits,
+			wantDefaultTags:    map[string]string{},
+			wantResourceTags:   map[string]string{"key1": "value1"},
+			wantOverriddenTags: map[string]string{},
+			wantTags:           map[string]string{"key1": "value1"},
+		},
+		{
+			name: "overridden",
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{"key1": "value1", "key2": "value2"}),
+			},
+			tags:               New(ctx, map[string]string{"key1": "override"}),
+			limits:             DefaultLimits,
+			wantDefaultTags:    map[string]string{"key2": "value2"},
+			wantResourceTags:   map[string]string{"key1": "override"},
+			wantOverriddenTags: map[string]string{"key1": "value1"},
+			wantTags:           map[string]string{"key1": "override", "key2": "value2"},
+			wantConflicts:      true,
+		},
+		{
+			name: "exceeds limits",
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{"key1": "value1"}),
+			},
+			tags:               New(ctx, map[string]string{"key2": "value2", "key3": "v"}),
+			limits:             Limits{MaxTags: 2, MaxKeyLength: 3, MaxValueLength: 5},
+			wantDefaultTags:    map[string]string{"key1": "value1"},
+			wantResourceTags:   map[string]string{"key2": "value2", "key3": "v"},
+			wantOverriddenTags: map[string]string{},
+			wantTags:           map[string]string{"key1": "value1", "key2": "value2", "key3": "v"},
+			wantViolations: []string{
+				"3 tags, maximum 2",
+				`key "key1" is 4 characters, maximum 3`,
+				`value of key "key1" is 6 characters, maximum 5`,
+				`key "key2" is 4 characters, maximum 3`,
+				`value of key "key2" is 6 characters, maximum 5`,
+				`key "key3" is 4 characters, maximum 3`,
+			},
+			wantConflicts: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.defaultConfig.Report(testCase.tags, testCase.limits)
+
+			if diff := cmp.Diff(got.DefaultTags.Map(), testCase.wantDefaultTags); diff != "" {
+				t.Errorf("unexpected DefaultTags diff (+wanted, -got): %s", diff)
+			}
+			if diff := cmp.Diff(got.ResourceTags.Map(), testCase.wantResourceTags); diff != "" {
+				t.Errorf("unexpected ResourceTags diff (+wanted, -got): %s", diff)
+			}
+			if diff := cmp.Diff(got.OverriddenTags.Map(), testCase.wantOverriddenTags); diff != "" {
+				t.Errorf("unexpected OverriddenTags diff (+wanted, -got): %s", diff)
+			}
+			if diff := cmp.Diff(got.Tags.Map(), testCase.wantTags); diff != "" {
+				t.Errorf("unexpected Tags diff (+wanted, -got): %s", diff)
+			}
+			if diff := cmp.Diff(got.LimitViolations, testCase.wantViolations); diff != "" {
+				t.Errorf("unexpected LimitViolations diff (+wanted, -got): %s", diff)
+			}
+			if got, want := got.HasConflicts(), testCase.wantConflicts; got != want {
+				t.Errorf("HasConflicts() = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestReportString(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	dc := &DefaultConfig{
+		Tags: New(ctx, map[string]string{"key1": "value1", "key2": "value2"}),
+	}
+	got := dc.Report(New(ctx, map[string]string{"key1": "override", "key3": "value3"}), DefaultLimits).String()
+	want := strings.Join([]string{
+		"from default_tags: [key2]",
+		"from resource configuration: [key1 key3]",
+		`overridden: [key1 (default "value1", configured "override")]`,
+	}, "\n")
+
+	if got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
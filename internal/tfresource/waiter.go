@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfresource
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+const (
+	defaultProgressInterval = 1 * time.Minute
+)
+
+// WaiterConfig holds provider-level overrides for StateWaiter.
+type WaiterConfig struct {
+	MinPollInterval  time.Duration // Overrides the smallest time to wait before refreshes
+	PollInterval     time.Duration // Overrides the interval between refreshes
+	ProgressInterval time.Duration // Interval between progress log lines; defaults to 1m
+}
+
+// NewWaiterContext returns a Context enhanced with provider-level waiter configuration.
+func NewWaiterContext(ctx context.Context, config WaiterConfig) context.Context {
+	return context.WithValue(ctx, waiterKey, &config)
+}
+
+// WaiterConfigFromContext returns the provider-level waiter configuration kept in Context.
+func WaiterConfigFromContext(ctx context.Context) (*WaiterConfig, bool) {
+	v, ok := ctx.Value(waiterKey).(*WaiterConfig)
+	return v, ok
+}
+
+type waiterKeyType int
+
+var waiterKey waiterKeyType
+
+// StateWaiter waits for the resource returned by a finder to reach one of a set of target statuses.
+// It replaces a hand-rolled retry.StateChangeConf and StateRefreshFunc pair with a typed equivalent
+// that logs periodic progress and honors provider-level waiter configuration.
+type StateWaiter[T any] struct {
+	Pending []string
+	Target  []string // An empty Target waits for the resource to be deleted.
+	// Find returns the resource, or a retry.NotFoundError if it doesn't exist.
+	Find func(context.Context) (T, error)
+	// Status returns the resource's current status.
+	Status func(T) string
+	// Percent optionally returns the resource's completion percentage, if known.
+	Percent func(T) (int, bool)
+	// Error optionally returns details of a failed resource, or nil. The details are set as the last error of a failed wait.
+	Error   func(T) error
+	Timeout time.Duration
+	Options Options
+}
+
+// Wait waits until the resource reaches a target status, or returns an error.
+// Provider-level waiter configuration overrides the waiter's and optFns' poll intervals.
+func (w StateWaiter[T]) Wait(ctx context.Context, optFns ...OptionsFunc) (T, error) {
+	options := w.Options
+	for _, fn := range optFns {
+		fn(&options)
+	}
+
+	progressInterval := defaultProgressInterval
+	if v, ok := WaiterConfigFromContext(ctx); ok {
+		if v.MinPollInterval > 0 {
+			options.MinPollInterval = v.MinPollInterval
+		}
+		if v.PollInterval > 0 {
+			options.PollInterval = v.PollInterval
+		}
+		if v.ProgressInterval > 0 {
+			progressInterval = v.ProgressInterval
+		}
+	}
+
+	ctx = tflog.SetField(ctx, "target", w.Target)
+	progress := &waiterProgress{
+		interval: progressInterval,
+		start:    time.Now(),
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending: w.Pending,
+		Target:  w.Target,
+		Refresh: func() (interface{}, string, error) {
+			output, err := w.Find(ctx)
+
+			if NotFound(err) {
+				progress.log(ctx, "", nil)
+				return nil, "", nil
+			}
+
+			if err != nil {
+				return nil, "", err
+			}
+
+			status := w.Status(output)
+
+			var percent *int
+			if w.Percent != nil {
+				if v, ok := w.Percent(output); ok {
+					percent = &v
+				}
+			}
+			progress.log(ctx, status, percent)
+
+			return output, status, nil
+		},
+		Timeout: w.Timeout,
+	}
+	options.Apply(stateConf)
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(T); ok {
+		if err != nil && w.Error != nil {
+			SetLastError(err, w.Error(output))
+		}
+
+		return output, err
+	}
+
+	var zero T
+	return zero, err
+}
+
+// waiterProgress logs a waiter's progress when its status changes and at regular intervals.
+type waiterProgress struct {
+	interval time.Duration
+	logged   time.Time
+	start    time.Time
+	status   *string
+}
+
+func (p *waiterProgress) log(ctx context.Context, status string, percent *int) {
+	now := time.Now()
+
+	if p.status != nil && *p.status == status && now.Sub(p.logged) < p.interval {
+		return
+	}
+
+	p.logged = now
+	p.status = &status
+
+	fields := map[string]any{
+		"elapsed": now.Sub(p.start).Round(time.Second).String(),
+		"status":  status,
+	}
+	if percent != nil {
+		fields["percent"] = *percent
+	}
+
+	tflog.Info(ctx, "Waiting for resource status", fields)
+}
@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfresource_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+type testWaiterResource struct {
+	status  string
+	percent int
+}
+
+func TestStateWaiter(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		statuses       []string // Status returned on each refresh; "" means not found.
+		pending        []string
+		target         []string
+		expectedStatus string
+		expectError    bool
+		expectLastErr  bool
+	}{
+		"target reached": {
+			statuses:       []string{"creating", "creating", "available"},
+			pending:        []string{"creating"},
+			target:         []string{"available"},
+			expectedStatus: "available",
+		},
+		"deleted": {
+			statuses: []string{"deleting", ""},
+			pending:  []string{"deleting"},
+			target:   []string{},
+		},
+		"unexpected status": {
+			statuses:       []string{"creating", "failed"},
+			pending:        []string{"creating"},
+			target:         []string{"available"},
+			expectedStatus: "failed",
+			expectError:    true,
+			expectLastErr:  true,
+		},
+		"find error": {
+			statuses:    []string{"creating", "error"},
+			pending:     []string{"creating"},
+			target:      []string{"available"},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := acctest.Context(t)
+			i := 0
+
+			waiter := tfresource.StateWaiter[*testWaiterResource]{
+				Pending: testCase.pending,
+				Target:  testCase.target,
+				Find: func(context.Context) (*testWaiterResource, error) {
+					status := testCase.statuses[min(i, len(testCase.statuses)-1)]
+					i++
+
+					switch status {
+					case "":
+						return nil, &retry.NotFoundError{}
+					case "error":
+						return nil, errors.New("find failed")
+					}
+
+					return &testWaiterResource{status: status, percent: 10 * i}, nil
+				},
+				Status: func(v *testWaiterResource) string {
+					return v.status
+				},
+				Percent: func(v *testWaiterResource) (int, bool) {
+					return v.percent, true
+				},
+				Error: func(v *testWaiterResource) error {
+					if v.status == "failed" {
+						return errors.New("creation failed")
+					}
+
+					return nil
+				},
+				Timeout: 5 * time.Second,
+				Options: tfresource.Options{
+					PollInterval: 1 * time.Millisecond,
+				},
+			}
+
+			output, err := waiter.Wait(ctx)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("Wait() err %t, want %t: %v", got, want, err)
+			}
+
+			var lastErr bool
+			if v := (*retry.UnexpectedStateError)(nil); errors.As(err, &v) {
+				lastErr = v.LastError != nil
+			}
+			if got, want := lastErr, testCase.expectLastErr; got != want {
+				t.Errorf("last error set %t, want %t", got, want)
+			}
+
+			var status string
+			if output != nil {
+				status = output.status
+			}
+			if got, want := status, testCase.expectedStatus; got != want {
+				t.Errorf("status %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestStateWaiterProviderConfig(t *testing.T) {
+	t.Parallel()
+
+	ctx := tfresource.NewWaiterContext(acctest.Context(t), tfresource.WaiterConfig{
+		PollInterval: 1 * time.Millisecond,
+	})
+	i := 0
+
+	waiter := tfresource.StateWaiter[*testWaiterResource]{
+		Pending: []string{"creating"},
+		Target:  []string{"available"},
+		Find: func(context.Context) (*testWaiterResource, error) {
+			i++
+			if i < 5 {
+				return &testWaiterResource{status: "creating"}, nil
+			}
+
+			return &testWaiterResource{status: "available"}, nil
+		},
+		Status: func(v *testWaiterResource) string {
+			return v.status
+		},
+		Timeout: 5 * time.Second,
+		Options: tfresource.Options{
+			// Would time out without the provider-level override.
+			PollInterval: 1 * time.Minute,
+		},
+	}
+
+	if _, err := waiter.Wait(ctx); err != nil {
+		t.Fatalf("Wait() err %v", err)
+	}
+}
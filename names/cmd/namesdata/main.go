@@ -0,0 +1,262 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/hashicorp/terraform-provider-aws/names/data"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	formatJSON = "json"
+	formatText = "text"
+	formatYAML = "yaml"
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tnamesdata query [-format text|json|yaml] [-columns <columns>] [-where <column>=<value>]... [<service>...]\n")
+	fmt.Fprintf(os.Stderr, "\tnamesdata export [-format json|yaml]\n")
+	fmt.Fprintf(os.Stderr, "\tnamesdata validate\n")
+	fmt.Fprintf(os.Stderr, "\tnamesdata report [-format text|json] [-root <provider-directory>] [-all]\n\n")
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+
+	if flag.NArg() < 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	services, err := data.ReadAllServiceData()
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error reading service data: %s\n", err)
+		os.Exit(1)
+	}
+
+	switch command, args := flag.Arg(0), flag.Args()[1:]; command {
+	case "query":
+		err = queryCommand(os.Stdout, services, args)
+	case "export":
+		err = exportCommand(os.Stdout, services, args)
+	case "validate":
+		err = validateCommand(os.Stdout, services)
+	case "report":
+		err = reportCommand(os.Stdout, services, args)
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+// whereFlag is a repeatable Column=value flag.
+type whereFlag []where
+
+func (f *whereFlag) String() string {
+	var s []string
+	for _, v := range *f {
+		s = append(s, v.column+"="+v.value)
+	}
+	return strings.Join(s, ",")
+}
+
+func (f *whereFlag) Set(value string) error {
+	v, err := parseWhere(value)
+	if err != nil {
+		return err
+	}
+	*f = append(*f, v)
+	return nil
+}
+
+func queryCommand(w io.Writer, services []data.ServiceRecord, args []string) error {
+	var conditions whereFlag
+
+	flags := flag.NewFlagSet("query", flag.ExitOnError)
+	format := flags.String("format", formatText, "Output format: text, json or yaml")
+	columns := flags.String("columns", "ProviderPackage,HumanFriendly,SDKVersion", "Comma-separated list of columns to output, or * for all")
+	flags.Var(&conditions, "where", "Condition (Column=value) that services must satisfy. May be repeated")
+	flags.Parse(args) //nolint:errcheck // ExitOnError
+
+	results, err := query(services, flags.Args(), conditions)
+
+	if err != nil {
+		return err
+	}
+
+	var selected []string
+	if *columns != "*" {
+		selected = strings.Split(*columns, ",")
+	}
+
+	return write(w, *format, results, selected)
+}
+
+func exportCommand(w io.Writer, services []data.ServiceRecord, args []string) error {
+	flags := flag.NewFlagSet("export", flag.ExitOnError)
+	format := flags.String("format", formatJSON, "Output format: json or yaml")
+	flags.Parse(args) //nolint:errcheck // ExitOnError
+
+	if *format == formatText {
+		return fmt.Errorf("unsupported export format: %s", *format)
+	}
+
+	results, err := query(services, nil, nil)
+
+	if err != nil {
+		return err
+	}
+
+	return write(w, *format, results, nil)
+}
+
+func validateCommand(w io.Writer, services []data.ServiceRecord) error {
+	errs := validate(services)
+
+	for _, v := range errs {
+		fmt.Fprintln(w, v)
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%d problems found in service data", len(errs))
+	}
+
+	return nil
+}
+
+// query returns the services matching any of the specified names and satisfying all the conditions.
+func query(services []data.ServiceRecord, names []string, conditions []where) ([]*service, error) {
+	var results []*service
+
+	for _, l := range services {
+		s := newService(l)
+
+		if len(names) > 0 && !slices.ContainsFunc(names, s.matches) {
+			continue
+		}
+
+		if len(conditions) > 0 {
+			fields, err := s.fields()
+
+			if err != nil {
+				return nil, err
+			}
+
+			if !slices.ContainsFunc(conditions, func(v where) bool { return !v.satisfiedBy(fields) }) {
+				results = append(results, s)
+			}
+
+			continue
+		}
+
+		results = append(results, s)
+	}
+
+	return results, nil
+}
+
+// write outputs the services in the specified format.
+// If columns is empty all fields are output.
+func write(w io.Writer, format string, services []*service, columns []string) error {
+	var v any = services
+
+	if len(columns) > 0 {
+		var results []yaml.MapSlice
+
+		for _, s := range services {
+			fields, err := s.fields()
+
+			if err != nil {
+				return err
+			}
+
+			result := make(yaml.MapSlice, 0, len(columns))
+			for _, column := range columns {
+				result = append(result, yaml.MapItem{Key: column, Value: fields[column]})
+			}
+			results = append(results, result)
+		}
+
+		v = results
+	}
+
+	switch format {
+	case formatJSON:
+		if results, ok := v.([]yaml.MapSlice); ok {
+			// JSON objects are output with their keys sorted.
+			maps := make([]map[string]any, 0, len(results))
+			for _, result := range results {
+				m := make(map[string]any, len(result))
+				for _, item := range result {
+					m[item.Key.(string)] = item.Value
+				}
+				maps = append(maps, m)
+			}
+			v = maps
+		}
+
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(v)
+
+	case formatYAML:
+		b, err := yaml.Marshal(v)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(b)
+		return err
+
+	case formatText:
+		results, ok := v.([]yaml.MapSlice)
+		if !ok {
+			return fmt.Errorf("columns must be specified for %s output", format)
+		}
+
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, strings.Join(columns, "\t"))
+		for _, result := range results {
+			values := make([]string, 0, len(result))
+			for _, item := range result {
+				values = append(values, textValue(item.Value))
+			}
+			fmt.Fprintln(tw, strings.Join(values, "\t"))
+		}
+		return tw.Flush()
+	}
+
+	return fmt.Errorf("unsupported format: %s", format)
+}
+
+func textValue(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case []any:
+		var values []string
+		for _, v := range v {
+			values = append(values, fmt.Sprint(v))
+		}
+		return strings.Join(values, ";")
+	default:
+		return fmt.Sprint(v)
+	}
+}
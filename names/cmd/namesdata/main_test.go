@@ -0,0 +1,260 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/names/data"
+)
+
+const header = "AWSCLIV2Command,AWSCLIV2CommandNoDashes,GoV1Package,GoV2Package,ProviderPackageActual,ProviderPackageCorrect,SplitPackageRealPackage,Aliases,ProviderNameUpper,GoV1ClientTypeName,SkipClientGenerate,ClientSDKV1,ClientSDKV2,ResourcePrefixActual,ResourcePrefixCorrect,FilePrefix,DocPrefix,HumanFriendly,Brand,Exclude,NotImplemented,EndpointOnly,AllowedSubcategory,DeprecatedEnvVar,TFAWSEnvVar,SDKID,EndpointAPICall,EndpointAPIParams,Note\n"
+
+func readServiceData(t *testing.T, lines ...string) []data.ServiceRecord {
+	t.Helper()
+
+	services, err := data.ReadServiceData(strings.NewReader(header + strings.Join(lines, "\n")))
+
+	if err != nil {
+		t.Fatalf("reading service data: %s", err)
+	}
+
+	return services
+}
+
+func TestValidateServiceData(t *testing.T) {
+	t.Parallel()
+
+	services, err := data.ReadAllServiceData()
+
+	if err != nil {
+		t.Fatalf("reading service data: %s", err)
+	}
+
+	for _, v := range validate(services) {
+		t.Error(v)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName string
+		Lines    []string
+		Expected []string
+	}{
+		{
+			TestName: "valid",
+			Lines: []string{
+				"widget,widget,widget,widget,,widget,,gadget,Widget,Widget,,,x,,aws_widget_,,widget_,Widget,AWS,,,,,,,Widget,ListWidgets,,",
+			},
+		},
+		{
+			TestName: "resource prefix",
+			Lines: []string{
+				"widget,widget,widget,widget,,widget,,,Widget,Widget,,,x,,aws_gadget_,,widget_,Widget,AWS,,,,,,,Widget,ListWidgets,,",
+			},
+			Expected: []string{
+				`line 2 (Widget): ResourcePrefixCorrect "aws_gadget_" must be "aws_widget_"`,
+			},
+		},
+		{
+			TestName: "client",
+			Lines: []string{
+				"widget,widget,widget,,,widget,,,Widget,,,x,x,,aws_widget_,,widget_,Widget,AWS,,,,,,,,ListWidgets,,",
+			},
+			Expected: []string{
+				"line 2 (Widget): ClientSDKV1 requires GoV1ClientTypeName",
+				"line 2 (Widget): ClientSDKV2 requires GoV2Package",
+				"line 2 (Widget): ClientSDKV2 requires SDKID",
+			},
+		},
+		{
+			TestName: "duplicates",
+			Lines: []string{
+				"widget,widget,widget,widget,,widget,,gadget,Widget,Widget,,,x,,aws_widget_,,widget_,Widget,AWS,,,,,,,Widget,ListWidgets,,",
+				"gadget,gadget,gadget,gadget,,gadget,,,Widget,Gadget,,,x,,aws_gadget_,,gadget_,Gadget,AWS,,,,,,,Gadget,ListGadgets,,",
+			},
+			Expected: []string{
+				`line 2 (Widget): alias "gadget" duplicates a provider package name or alias on line 3`,
+				`line 3 (Gadget): ProviderNameUpper "Widget" duplicates line 2`,
+			},
+		},
+		{
+			TestName: "excluded",
+			Lines: []string{
+				"widget,widget,,,,,,,,,,,,,,,,Widget,AWS,x,,,,,,,,,",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			got := validate(readServiceData(t, testCase.Lines...))
+
+			if got, want := strings.Join(got, "\n"), strings.Join(testCase.Expected, "\n"); got != want {
+				t.Errorf("got:\n%s\nwant:\n%s", got, want)
+			}
+		})
+	}
+}
+
+func TestQuery(t *testing.T) {
+	t.Parallel()
+
+	services := readServiceData(t,
+		"widget,widget,widget,widget,,widget,,gadget,Widget,Widget,,,x,,aws_widget_,,widget_,Widget,AWS,,,,,,,Widget,ListWidgets,,",
+		"doohickey,doohickey,doohickey,,,doohickey,,,Doohickey,Doohickey,,x,,,aws_doohickey_,,doohickey_,Doohickey,AWS,,,,,,,,ListDoohickeys,,",
+	)
+
+	testCases := []struct {
+		TestName   string
+		Names      []string
+		Conditions []string
+		Format     string
+		Columns    []string
+		Expected   string
+	}{
+		{
+			TestName: "alias",
+			Names:    []string{"GADGET"},
+			Format:   formatText,
+			Columns:  []string{"ProviderPackage", "Aliases", "SDKVersion"},
+			Expected: "ProviderPackage  Aliases  SDKVersion\nwidget           gadget   2\n",
+		},
+		{
+			TestName:   "where",
+			Conditions: []string{"ClientSDKV1=true", "Aliases="},
+			Format:     formatJSON,
+			Columns:    []string{"ProviderPackage", "ClientSDKV2"},
+			Expected:   "[\n  {\n    \"ClientSDKV2\": null,\n    \"ProviderPackage\": \"doohickey\"\n  }\n]\n",
+		},
+		{
+			TestName: "yaml",
+			Names:    []string{"widget"},
+			Format:   formatYAML,
+			Columns:  []string{"HumanFriendly", "DocPrefix", "AWSServiceEnvVar"},
+			Expected: "- HumanFriendly: Widget\n  DocPrefix:\n  - widget_\n  AWSServiceEnvVar: AWS_ENDPOINT_URL_WIDGET\n",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			var conditions []where
+			for _, v := range testCase.Conditions {
+				condition, err := parseWhere(v)
+				if err != nil {
+					t.Fatal(err)
+				}
+				conditions = append(conditions, condition)
+			}
+
+			results, err := query(services, testCase.Names, conditions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var b bytes.Buffer
+			if err := write(&b, testCase.Format, results, testCase.Columns); err != nil {
+				t.Fatal(err)
+			}
+
+			if got, want := b.String(), testCase.Expected; got != want {
+				t.Errorf("got:\n%s\nwant:\n%s", got, want)
+			}
+		})
+	}
+}
+
+func TestReport(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	for filename, contents := range map[string]string{
+		"service_package_gen.go": `package widget
+
+func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
+	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory: newWidgetResource,
+			Name:    "Widget",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+	}
+}
+
+func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
+	return []*types.ServicePackageSDKDataSource{
+		{
+			Factory:  dataSourceWidget,
+			TypeName: "aws_widget",
+		},
+	}
+}
+
+func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
+	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  resourceWidgetPolicy,
+			TypeName: "aws_widget_policy",
+		},
+	}
+}
+`,
+		"sweep.go": "package widget\n\nfunc RegisterSweepers() {}\n",
+	} {
+		if err := os.WriteFile(filepath.Join(dir, filename), []byte(contents), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	services := readServiceData(t,
+		"widget,widget,widget,widget,,widget,,,Widget,Widget,,,x,,aws_widget_,,widget_,Widget,AWS,,,,,,,Widget,ListWidgets,,",
+	)
+
+	c, err := report(dir, services[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := c.Resources, 2; got != want {
+		t.Errorf("Resources: got %d, want %d", got, want)
+	}
+	if got, want := c.DataSources, 1; got != want {
+		t.Errorf("DataSources: got %d, want %d", got, want)
+	}
+	if got, want := c.TaggedResources, 1; got != want {
+		t.Errorf("TaggedResources: got %d, want %d", got, want)
+	}
+	if !c.Sweepers {
+		t.Error("Sweepers: got false, want true")
+	}
+	if got, want := strings.Join(c.Missing, ","), missingEndpointTests; got != want {
+		t.Errorf("Missing: got %q, want %q", got, want)
+	}
+
+	c, err = report(filepath.Join(dir, "missing"), services[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c != nil {
+		t.Errorf("got %v, want nil for a service package that has not been generated", c)
+	}
+}
@@ -0,0 +1,228 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/hashicorp/terraform-provider-aws/names/data"
+)
+
+const (
+	missingEndpointTests = "endpoint tests"
+	missingSweepers      = "sweepers"
+	missingTags          = "tags"
+)
+
+// Service packages whose endpoint tests are not generated.
+// Keep in sync with internal/generate/serviceendpointtests.
+var endpointTestsSkipped = map[string]bool{
+	"cloudfrontkeyvaluestore": true,
+	"codecatalyst":            true,
+	"mwaa":                    true,
+	"neptunegraph":            true,
+	"paymentcryptography":     true,
+	"route53profiles":         true,
+	"s3control":               true,
+	"timestreamwrite":         true,
+}
+
+// coverage summarizes a service package's generated and hand-written support files.
+type coverage struct {
+	ProviderPackage string   `json:"ProviderPackage"`
+	HumanFriendly   string   `json:"HumanFriendly"`
+	Resources       int      `json:"Resources"`
+	DataSources     int      `json:"DataSources"`
+	TaggedResources int      `json:"TaggedResources"`
+	Sweepers        bool     `json:"Sweepers"`
+	EndpointTests   bool     `json:"EndpointTests"`
+	Missing         []string `json:"Missing,omitempty"`
+}
+
+func reportCommand(w io.Writer, services []data.ServiceRecord, args []string) error {
+	flags := flag.NewFlagSet("report", flag.ExitOnError)
+	format := flags.String("format", formatText, "Output format: text or json")
+	root := flags.String("root", ".", "Root directory of the provider source")
+	all := flags.Bool("all", false, "Include services that are not missing anything")
+	flags.Parse(args) //nolint:errcheck // ExitOnError
+
+	var results []*coverage
+
+	for _, l := range services {
+		if l.Exclude() || l.NotImplemented() || l.SplitPackageRealPackage() != "" {
+			continue
+		}
+
+		c, err := report(filepath.Join(*root, "internal", "service", l.ProviderPackage()), l)
+
+		if err != nil {
+			return err
+		}
+
+		if c == nil || (!*all && len(c.Missing) == 0) {
+			continue
+		}
+
+		results = append(results, c)
+	}
+
+	switch *format {
+	case formatJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(results)
+
+	case formatText:
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, "ProviderPackage\tResources\tDataSources\tTaggedResources\tMissing")
+		for _, c := range results {
+			fmt.Fprintf(tw, "%s\t%d\t%d\t%d\t%s\n", c.ProviderPackage, c.Resources, c.DataSources, c.TaggedResources, strings.Join(c.Missing, ", "))
+		}
+		return tw.Flush()
+	}
+
+	return fmt.Errorf("unsupported report format: %s", *format)
+}
+
+// report returns the coverage of the service package in the specified directory.
+// A nil result is returned if the service package has not been generated.
+func report(dir string, l data.ServiceRecord) (*coverage, error) {
+	c := &coverage{
+		ProviderPackage: l.ProviderPackage(),
+		HumanFriendly:   l.HumanFriendly(),
+	}
+
+	filename := filepath.Join(dir, "service_package_gen.go")
+	if ok, err := exists(filename); err != nil || !ok {
+		return nil, err
+	}
+
+	if err := c.servicePackage(filename); err != nil {
+		return nil, err
+	}
+
+	sweepers, err := containsString(filepath.Join(dir, "sweep.go"), "func RegisterSweepers()")
+	if err != nil {
+		return nil, err
+	}
+	c.Sweepers = sweepers
+
+	endpointTests, err := exists(filepath.Join(dir, "service_endpoints_gen_test.go"))
+	if err != nil {
+		return nil, err
+	}
+	c.EndpointTests = endpointTests
+
+	if c.Resources > 0 && !c.Sweepers {
+		c.Missing = append(c.Missing, missingSweepers)
+	}
+	if c.Resources > 0 && c.TaggedResources == 0 {
+		c.Missing = append(c.Missing, missingTags)
+	}
+	if !c.EndpointTests && !endpointTestsSkipped[c.ProviderPackage] {
+		c.Missing = append(c.Missing, missingEndpointTests)
+	}
+
+	return c, nil
+}
+
+// servicePackage counts the resources and data sources registered in a generated service_package_gen.go file.
+func (c *coverage) servicePackage(filename string) error {
+	file, err := parser.ParseFile(token.NewFileSet(), filename, nil, parser.SkipObjectResolution)
+
+	if err != nil {
+		return fmt.Errorf("parsing %s: %w", filename, err)
+	}
+
+	ast.Inspect(file, func(n ast.Node) bool {
+		lit, ok := n.(*ast.CompositeLit)
+		if !ok {
+			return true
+		}
+
+		typ, ok := lit.Type.(*ast.ArrayType)
+		if !ok {
+			return true
+		}
+
+		var name string
+		if star, ok := typ.Elt.(*ast.StarExpr); ok {
+			if sel, ok := star.X.(*ast.SelectorExpr); ok {
+				name = sel.Sel.Name
+			}
+		}
+
+		switch name {
+		case "ServicePackageFrameworkDataSource", "ServicePackageSDKDataSource":
+			c.DataSources += len(lit.Elts)
+		case "ServicePackageFrameworkResource", "ServicePackageSDKResource":
+			c.Resources += len(lit.Elts)
+			for _, v := range lit.Elts {
+				if hasKey(v, "Tags") {
+					c.TaggedResources++
+				}
+			}
+		default:
+			return true
+		}
+
+		return false
+	})
+
+	return nil
+}
+
+// hasKey returns whether the composite literal expression has the specified key.
+func hasKey(expr ast.Expr, key string) bool {
+	lit, ok := expr.(*ast.CompositeLit)
+	if !ok {
+		return false
+	}
+
+	for _, v := range lit.Elts {
+		if kv, ok := v.(*ast.KeyValueExpr); ok {
+			if ident, ok := kv.Key.(*ast.Ident); ok && ident.Name == key {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func exists(filename string) (bool, error) {
+	_, err := os.Stat(filename)
+
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+func containsString(filename, s string) (bool, error) {
+	b, err := os.ReadFile(filename)
+
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	return strings.Contains(string(b), s), nil
+}
@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/names/data"
+)
+
+// service is the machine-readable form of a names_data.csv record.
+// Fields are named after the CSV columns. Values derived from several columns follow the columns.
+type service struct {
+	AWSCLIV2Command         string   `json:"AWSCLIV2Command,omitempty" yaml:"AWSCLIV2Command,omitempty"`
+	AWSCLIV2CommandNoDashes string   `json:"AWSCLIV2CommandNoDashes,omitempty" yaml:"AWSCLIV2CommandNoDashes,omitempty"`
+	GoV1Package             string   `json:"GoV1Package,omitempty" yaml:"GoV1Package,omitempty"`
+	GoV2Package             string   `json:"GoV2Package,omitempty" yaml:"GoV2Package,omitempty"`
+	ProviderPackageActual   string   `json:"ProviderPackageActual,omitempty" yaml:"ProviderPackageActual,omitempty"`
+	ProviderPackageCorrect  string   `json:"ProviderPackageCorrect,omitempty" yaml:"ProviderPackageCorrect,omitempty"`
+	SplitPackageRealPackage string   `json:"SplitPackageRealPackage,omitempty" yaml:"SplitPackageRealPackage,omitempty"`
+	Aliases                 []string `json:"Aliases,omitempty" yaml:"Aliases,omitempty"`
+	ProviderNameUpper       string   `json:"ProviderNameUpper,omitempty" yaml:"ProviderNameUpper,omitempty"`
+	GoV1ClientTypeName      string   `json:"GoV1ClientTypeName,omitempty" yaml:"GoV1ClientTypeName,omitempty"`
+	SkipClientGenerate      bool     `json:"SkipClientGenerate,omitempty" yaml:"SkipClientGenerate,omitempty"`
+	ClientSDKV1             bool     `json:"ClientSDKV1,omitempty" yaml:"ClientSDKV1,omitempty"`
+	ClientSDKV2             bool     `json:"ClientSDKV2,omitempty" yaml:"ClientSDKV2,omitempty"`
+	ResourcePrefixActual    string   `json:"ResourcePrefixActual,omitempty" yaml:"ResourcePrefixActual,omitempty"`
+	ResourcePrefixCorrect   string   `json:"ResourcePrefixCorrect,omitempty" yaml:"ResourcePrefixCorrect,omitempty"`
+	FilePrefix              string   `json:"FilePrefix,omitempty" yaml:"FilePrefix,omitempty"`
+	DocPrefix               []string `json:"DocPrefix,omitempty" yaml:"DocPrefix,omitempty"`
+	HumanFriendly           string   `json:"HumanFriendly" yaml:"HumanFriendly"`
+	Brand                   string   `json:"Brand,omitempty" yaml:"Brand,omitempty"`
+	Exclude                 bool     `json:"Exclude,omitempty" yaml:"Exclude,omitempty"`
+	NotImplemented          bool     `json:"NotImplemented,omitempty" yaml:"NotImplemented,omitempty"`
+	EndpointOnly            bool     `json:"EndpointOnly,omitempty" yaml:"EndpointOnly,omitempty"`
+	AllowedSubcategory      string   `json:"AllowedSubcategory,omitempty" yaml:"AllowedSubcategory,omitempty"`
+	DeprecatedEnvVar        string   `json:"DeprecatedEnvVar,omitempty" yaml:"DeprecatedEnvVar,omitempty"`
+	TFAWSEnvVar             string   `json:"TFAWSEnvVar,omitempty" yaml:"TFAWSEnvVar,omitempty"`
+	SDKID                   string   `json:"SDKID,omitempty" yaml:"SDKID,omitempty"`
+	EndpointAPICall         string   `json:"EndpointAPICall,omitempty" yaml:"EndpointAPICall,omitempty"`
+	EndpointAPIParams       string   `json:"EndpointAPIParams,omitempty" yaml:"EndpointAPIParams,omitempty"`
+	Note                    string   `json:"Note,omitempty" yaml:"Note,omitempty"`
+
+	ProviderPackage    string `json:"ProviderPackage,omitempty" yaml:"ProviderPackage,omitempty"`
+	ResourcePrefix     string `json:"ResourcePrefix,omitempty" yaml:"ResourcePrefix,omitempty"`
+	SDKVersion         string `json:"SDKVersion,omitempty" yaml:"SDKVersion,omitempty"`
+	AWSServiceEnvVar   string `json:"AWSServiceEnvVar,omitempty" yaml:"AWSServiceEnvVar,omitempty"`
+	AWSConfigParameter string `json:"AWSConfigParameter,omitempty" yaml:"AWSConfigParameter,omitempty"`
+}
+
+func newService(l data.ServiceRecord) *service {
+	s := &service{
+		AWSCLIV2Command:         l.AWSCLIV2Command(),
+		AWSCLIV2CommandNoDashes: l.AWSCLIV2CommandNoDashes(),
+		GoV1Package:             l.GoV1Package(),
+		GoV2Package:             l.GoV2Package(),
+		ProviderPackageActual:   l.ProviderPackageActual(),
+		ProviderPackageCorrect:  l.ProviderPackageCorrect(),
+		SplitPackageRealPackage: l.SplitPackageRealPackage(),
+		Aliases:                 l.Aliases(),
+		ProviderNameUpper:       l.ProviderNameUpper(),
+		GoV1ClientTypeName:      l.GoV1ClientTypeName(),
+		SkipClientGenerate:      l.SkipClientGenerate(),
+		ClientSDKV1:             l.ClientSDKV1(),
+		ClientSDKV2:             l.ClientSDKV2(),
+		ResourcePrefixActual:    l.ResourcePrefixActual(),
+		ResourcePrefixCorrect:   l.ResourcePrefixCorrect(),
+		FilePrefix:              l.FilePrefix(),
+		DocPrefix:               l.DocPrefix(),
+		HumanFriendly:           l.HumanFriendly(),
+		Brand:                   l.Brand(),
+		Exclude:                 l.Exclude(),
+		NotImplemented:          l.NotImplemented(),
+		EndpointOnly:            l.EndpointOnly(),
+		AllowedSubcategory:      l.AllowedSubcategory(),
+		DeprecatedEnvVar:        l.DeprecatedEnvVar(),
+		TFAWSEnvVar:             l.TFAWSEnvVar(),
+		SDKID:                   l.SDKID(),
+		EndpointAPICall:         l.EndpointAPICall(),
+		EndpointAPIParams:       l.EndpointAPIParams(),
+		Note:                    l.Note(),
+
+		ProviderPackage: l.ProviderPackage(),
+		ResourcePrefix:  l.ResourcePrefix(),
+		SDKVersion:      l.SDKVersion(),
+	}
+
+	if l.SDKID() != "" {
+		s.AWSServiceEnvVar = l.AWSServiceEnvVar()
+		s.AWSConfigParameter = l.AWSConfigParameter()
+	}
+
+	return s
+}
+
+// fields returns the service's non-empty fields keyed by name.
+func (s *service) fields() (map[string]any, error) {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+
+	var m map[string]any
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// matches returns whether any of the service's names is the specified name, ignoring case.
+func (s *service) matches(name string) bool {
+	for _, v := range append([]string{
+		s.ProviderPackage,
+		s.ProviderPackageCorrect,
+		s.AWSCLIV2Command,
+		s.AWSCLIV2CommandNoDashes,
+		s.GoV1Package,
+		s.GoV2Package,
+		s.HumanFriendly,
+	}, s.Aliases...) {
+		if v != "" && strings.EqualFold(v, name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// where is a Column=value query condition.
+type where struct {
+	column, value string
+}
+
+func parseWhere(s string) (where, error) {
+	column, value, ok := strings.Cut(s, "=")
+	if !ok || column == "" {
+		return where{}, fmt.Errorf("invalid condition %q, expected Column=value", s)
+	}
+
+	return where{column: column, value: value}, nil
+}
+
+// satisfiedBy returns whether the specified service fields satisfy the condition.
+// Boolean columns compare against true or false, list columns are satisfied by any element
+// and a missing field compares equal to the empty string.
+func (w where) satisfiedBy(fields map[string]any) bool {
+	switch v := fields[w.column].(type) {
+	case nil:
+		return w.value == "" || w.value == "false"
+	case bool:
+		return fmt.Sprint(v) == w.value
+	case []any:
+		for _, v := range v {
+			if fmt.Sprint(v) == w.value {
+				return true
+			}
+		}
+		return false
+	default:
+		return fmt.Sprint(v) == w.value
+	}
+}
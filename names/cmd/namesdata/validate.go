@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-provider-aws/names/data"
+)
+
+const lineOffset = 2 // 1 for skipping header line + 1 to translate from 0-based to 1-based index
+
+// validate checks the cross-column invariants of the service data and returns a description of each violation.
+// Checks of individual columns are made by internal/generate/checknames.
+func validate(services []data.ServiceRecord) []string {
+	v := &validator{}
+
+	// Names that must be unique across services, keyed by column name.
+	unique := map[string]map[string]int{
+		"ProviderPackage":   {},
+		"ProviderNameUpper": {},
+		"HumanFriendly":     {},
+	}
+	// Provider package names and aliases, mapped to their line.
+	names := make(map[string]int)
+
+	for i, l := range services {
+		line := i + lineOffset
+
+		if l.Exclude() {
+			continue
+		}
+
+		pkg := l.ProviderPackage()
+
+		v.prefixes(line, l)
+		v.clients(line, l)
+
+		for column, value := range map[string]string{
+			"ProviderPackage":   pkg,
+			"ProviderNameUpper": l.ProviderNameUpper(),
+			"HumanFriendly":     l.HumanFriendly(),
+		} {
+			if value == "" {
+				continue
+			}
+			if other, ok := unique[column][value]; ok {
+				v.errorf(line, l, "%s %q duplicates line %d", column, value, other)
+				continue
+			}
+			unique[column][value] = line
+		}
+
+		if pkg != "" {
+			names[pkg] = line
+		}
+	}
+
+	for i, l := range services {
+		line := i + lineOffset
+
+		if l.Exclude() {
+			continue
+		}
+
+		for _, alias := range l.Aliases() {
+			switch other, ok := names[alias]; {
+			case alias == "":
+				v.errorf(line, l, "Aliases contains an empty alias")
+			case alias == l.ProviderPackage():
+				v.errorf(line, l, "Aliases must not include the provider package name %q", alias)
+			case ok:
+				v.errorf(line, l, "alias %q duplicates a provider package name or alias on line %d", alias, other)
+			default:
+				names[alias] = line
+			}
+		}
+	}
+
+	sort.Strings(v.errors)
+
+	return v.errors
+}
+
+type validator struct {
+	errors []string
+}
+
+func (v *validator) errorf(line int, l data.ServiceRecord, format string, a ...any) {
+	v.errors = append(v.errors, fmt.Sprintf("line %d (%s): %s", line, l.HumanFriendly(), fmt.Sprintf(format, a...)))
+}
+
+// prefixes checks that the resource, file and documentation prefixes are consistent with the package name.
+func (v *validator) prefixes(line int, l data.ServiceRecord) {
+	if pkg := l.ProviderPackageCorrect(); pkg != "" {
+		if want := "aws_" + pkg + "_"; l.ResourcePrefixCorrect() != want {
+			v.errorf(line, l, "ResourcePrefixCorrect %q must be %q", l.ResourcePrefixCorrect(), want)
+		}
+	}
+
+	if l.ResourcePrefixActual() != "" && l.ResourcePrefixActual() == l.ResourcePrefixCorrect() {
+		v.errorf(line, l, "ResourcePrefixActual must be blank when it is the same as ResourcePrefixCorrect")
+	}
+
+	if l.ProviderPackageActual() != "" && l.ProviderPackageActual() == l.ProviderPackageCorrect() {
+		v.errorf(line, l, "ProviderPackageActual must be blank when it is the same as ProviderPackageCorrect")
+	}
+
+	if l.NotImplemented() {
+		return
+	}
+
+	if len(l.DocPrefix()) == 0 {
+		v.errorf(line, l, "DocPrefix must be set for an implemented service")
+	}
+
+	if l.FilePrefix() != "" && l.SplitPackageRealPackage() == "" {
+		v.errorf(line, l, "FilePrefix requires SplitPackageRealPackage")
+	}
+}
+
+// clients checks that the AWS SDK for Go columns are consistent with how the service's clients are generated.
+func (v *validator) clients(line int, l data.ServiceRecord) {
+	if l.ClientSDKV1() {
+		if l.GoV1Package() == "" {
+			v.errorf(line, l, "ClientSDKV1 requires GoV1Package")
+		}
+		if l.GoV1ClientTypeName() == "" {
+			v.errorf(line, l, "ClientSDKV1 requires GoV1ClientTypeName")
+		}
+	}
+
+	if l.ClientSDKV2() && l.GoV2Package() == "" {
+		v.errorf(line, l, "ClientSDKV2 requires GoV2Package")
+	}
+
+	if l.NotImplemented() || l.SplitPackageRealPackage() != "" {
+		// A service that is not implemented may still have a client for endpoint resolution,
+		// and a split package's client, if any, belongs to the real package.
+		return
+	}
+
+	if !l.ClientSDKV1() && !l.ClientSDKV2() {
+		v.errorf(line, l, "one of ClientSDKV1 or ClientSDKV2 must be set for an implemented service")
+	}
+
+	if l.ClientSDKV2() && l.SDKID() == "" {
+		v.errorf(line, l, "ClientSDKV2 requires SDKID")
+	}
+}
@@ -176,7 +176,12 @@ func (sr ServiceRecord) Note() string {
 }
 
 func ReadAllServiceData() (results []ServiceRecord, err error) {
-	reader := csv.NewReader(bytes.NewReader(namesData))
+	return ReadServiceData(bytes.NewReader(namesData))
+}
+
+// ReadServiceData reads service data in the format of names_data.csv.
+func ReadServiceData(r io.Reader) (results []ServiceRecord, err error) {
+	reader := csv.NewReader(r)
 	// reader.ReuseRecord = true
 
 	// Skip the header
@@ -233,3 +238,82 @@ const (
 	colEndpointAPIParams // Any needed parameters for endpoint tests
 	colNote
 )
+
+type TagLimitsRecord []string
+
+func (tr TagLimitsRecord) ProviderPackage() string {
+	return tr[colTagLimitsProviderPackage]
+}
+
+func (tr TagLimitsRecord) ResourceType() string {
+	return tr[colTagLimitsResourceType]
+}
+
+func (tr TagLimitsRecord) MaxTags() string {
+	return tr[colTagLimitsMaxTags]
+}
+
+func (tr TagLimitsRecord) MaxKeyLength() string {
+	return tr[colTagLimitsMaxKeyLength]
+}
+
+func (tr TagLimitsRecord) MaxValueLength() string {
+	return tr[colTagLimitsMaxValueLength]
+}
+
+func (tr TagLimitsRecord) AllowedCharacters() string {
+	return tr[colTagLimitsAllowedCharacters]
+}
+
+func (tr TagLimitsRecord) ReservedKeyPrefixes() []string {
+	if tr[colTagLimitsReservedKeyPrefixes] == "" {
+		return nil
+	}
+	return strings.Split(tr[colTagLimitsReservedKeyPrefixes], ";")
+}
+
+func (tr TagLimitsRecord) CaseInsensitiveKeys() bool {
+	return tr[colTagLimitsCaseInsensitiveKeys] != ""
+}
+
+func (tr TagLimitsRecord) Note() string {
+	return tr[colTagLimitsNote]
+}
+
+func ReadAllTagLimitsData() (results []TagLimitsRecord, err error) {
+	reader := csv.NewReader(bytes.NewReader(tagLimitsData))
+
+	// Skip the header
+	_, err = reader.Read()
+	if err != nil {
+		return
+	}
+
+	for {
+		r, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, TagLimitsRecord(r))
+	}
+
+	return
+}
+
+//go:embed tag_limits.csv
+var tagLimitsData []byte
+
+const (
+	colTagLimitsProviderPackage     = iota
+	colTagLimitsResourceType        // If set, the limits apply only to this Terraform resource type
+	colTagLimitsMaxTags             // Empty for the AWS default
+	colTagLimitsMaxKeyLength        // Empty for the AWS default
+	colTagLimitsMaxValueLength      // Empty for the AWS default
+	colTagLimitsAllowedCharacters   // Regular expression that tag keys and values must match
+	colTagLimitsReservedKeyPrefixes // Semicolon-separated; replaces the default `aws:`
+	colTagLimitsCaseInsensitiveKeys // If set, tag keys that differ only in case conflict
+	colTagLimitsNote
+)
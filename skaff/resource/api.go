@@ -0,0 +1,491 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"reflect"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// The AWS SDK for Go v2 is introspected by reflection on the client returned by a service's AWSClient accessor.
+// Whether a member is required isn't visible in its type, so each operation is called with empty inputs
+// and its validation errors are collected before the request is sent.
+
+type apiKind int
+
+const (
+	apiUnknown apiKind = iota
+	apiString
+	apiBool
+	apiInt32
+	apiInt64
+	apiFloat64
+	apiTime
+	apiEnum
+	apiStruct
+	apiUnion
+	apiDocument
+	apiBlob
+	apiList
+	apiMap
+)
+
+// apiType is the type of an AWS API structure member.
+type apiType struct {
+	Kind apiKind
+	Name string   // Type name in the SDK's types package for enums, structures and unions.
+	Elem *apiType // Element type for lists and maps.
+}
+
+func (t *apiType) String() string {
+	switch t.Kind {
+	case apiString:
+		return "string"
+	case apiBool:
+		return "bool"
+	case apiInt32:
+		return "int32"
+	case apiInt64:
+		return "int64"
+	case apiFloat64:
+		return "float64"
+	case apiTime:
+		return "time.Time"
+	case apiEnum, apiStruct, apiUnion:
+		return "types." + t.Name
+	case apiDocument:
+		return "document.Interface"
+	case apiBlob:
+		return "[]byte"
+	case apiList:
+		return "[]" + t.Elem.String()
+	case apiMap:
+		return "map[string]" + t.Elem.String()
+	default:
+		return "unknown"
+	}
+}
+
+// apiField is a member of an AWS API structure.
+type apiField struct {
+	Name     string
+	Required bool
+	Type     *apiType
+}
+
+// apiShape is an AWS API structure, either an operation's input or output or a shape in the types package.
+type apiShape struct {
+	Name   string
+	Fields []*apiField
+}
+
+func (s *apiShape) field(name string) *apiField {
+	if s == nil {
+		return nil
+	}
+
+	for _, f := range s.Fields {
+		if f.Name == name {
+			return f
+		}
+	}
+
+	return nil
+}
+
+// apiEnumValue is a value of an AWS API enumeration.
+type apiEnumValue struct {
+	Const string // e.g. PipelineStatusCreating
+	Value string // e.g. CREATING
+}
+
+// apiPackage is an AWS SDK for Go v2 service package and its types package, as reachable from the service's client.
+type apiPackage struct {
+	Name       string
+	operations map[string]bool      // Names of the client's operations.
+	paginators map[string]bool      // Operation names with paginators.
+	structs    map[string]*apiShape // Operation inputs and outputs.
+	shapes     map[string]*apiShape // Structures in the types package.
+	enums      map[string][]apiEnumValue
+	unions     map[string]bool
+
+	typesPath string
+}
+
+func (p *apiPackage) operation(name string) (input, output *apiShape, ok bool) {
+	if !p.operations[name] {
+		return nil, nil, false
+	}
+
+	return p.structs[name+"Input"], p.structs[name+"Output"], true
+}
+
+// apiPaginationTokens are the input members paginated operations take the next page's token in.
+// The SDK's paginator constructors are package functions, which aren't visible to reflection.
+var apiPaginationTokens = []string{"NextToken", "Marker", "ContinuationToken", "PageToken"}
+
+var (
+	errAPIStop = errors.New("stop")
+	timeType   = reflect.TypeOf(time.Time{})
+)
+
+// sdkClientType returns the type of the AWS SDK for Go v2 client registered for the specified service,
+// e.g. *osis.Client for OpenSearchIngestion.
+func sdkClientType(service string) (reflect.Type, error) {
+	m, ok := reflect.TypeOf((*conns.AWSClient)(nil)).MethodByName(service + "Client")
+	if !ok {
+		return nil, fmt.Errorf("service %s has no AWS SDK for Go v2 client", service)
+	}
+
+	if m.Type.NumOut() != 1 || m.Type.Out(0).Kind() != reflect.Pointer {
+		return nil, fmt.Errorf("AWSClient.%s doesn't return an AWS SDK for Go v2 client", m.Name)
+	}
+
+	return m.Type.Out(0), nil
+}
+
+// newAPIPackage introspects the operations of the specified AWS SDK for Go v2 client type.
+func newAPIPackage(client reflect.Type) (*apiPackage, error) {
+	pkgPath := client.Elem().PkgPath()
+	p := &apiPackage{
+		Name:       path.Base(pkgPath),
+		operations: make(map[string]bool),
+		paginators: make(map[string]bool),
+		structs:    make(map[string]*apiShape),
+		shapes:     make(map[string]*apiShape),
+		enums:      make(map[string][]apiEnumValue),
+		unions:     make(map[string]bool),
+		typesPath:  pkgPath + "/types",
+	}
+
+	for i := range client.NumMethod() {
+		m := client.Method(i)
+
+		// Operations are func(ctx context.Context, params *XInput, optFns ...func(*Options)) (*XOutput, error).
+		t := m.Type
+		if t.NumIn() != 4 || !t.IsVariadic() || t.NumOut() != 2 {
+			continue
+		}
+		in, out := t.In(2), t.Out(0)
+		if in.Kind() != reflect.Pointer || in.Elem().Name() != m.Name+"Input" || out.Kind() != reflect.Pointer || out.Elem().Name() != m.Name+"Output" {
+			continue
+		}
+
+		p.operations[m.Name] = true
+		p.structs[in.Elem().Name()] = p.newShape(in.Elem())
+		p.structs[out.Elem().Name()] = p.newShape(out.Elem())
+
+		if err := p.requiredMembers(m, in.Elem()); err != nil {
+			return nil, fmt.Errorf("reading %s required members: %w", m.Name, err)
+		}
+	}
+
+	if len(p.operations) == 0 {
+		return nil, fmt.Errorf("%s has no operations", client)
+	}
+
+	for name := range p.operations {
+		in, out, _ := p.operation(name)
+		for _, v := range apiPaginationTokens {
+			if f, g := in.field(v), out.field(v); f != nil && g != nil && f.Type.Kind == apiString && g.Type.Kind == apiString {
+				p.paginators[name] = true
+			}
+		}
+	}
+
+	return p, nil
+}
+
+func (p *apiPackage) newShape(t reflect.Type) *apiShape {
+	s := &apiShape{Name: t.Name()}
+
+	for i := range t.NumField() {
+		f := t.Field(i)
+
+		// Skip embedded fields (noSmithyDocumentSerde).
+		if f.Anonymous || !f.IsExported() || f.Name == "ResultMetadata" {
+			continue
+		}
+
+		s.Fields = append(s.Fields, &apiField{
+			Name: f.Name,
+			Type: p.resolveType(f.Type),
+		})
+	}
+
+	return s
+}
+
+func (p *apiPackage) resolveType(t reflect.Type) *apiType {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	if t == timeType {
+		return &apiType{Kind: apiTime}
+	}
+
+	if t.PkgPath() == p.typesPath {
+		return p.resolveNamedType(t)
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return &apiType{Kind: apiString}
+	case reflect.Bool:
+		return &apiType{Kind: apiBool}
+	case reflect.Int32:
+		return &apiType{Kind: apiInt32}
+	case reflect.Int, reflect.Int64:
+		return &apiType{Kind: apiInt64}
+	case reflect.Float32, reflect.Float64:
+		return &apiType{Kind: apiFloat64}
+	case reflect.Interface:
+		if t.Name() == "Interface" && path.Base(t.PkgPath()) == "document" {
+			return &apiType{Kind: apiDocument}
+		}
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &apiType{Kind: apiBlob}
+		}
+
+		return &apiType{Kind: apiList, Elem: p.resolveType(t.Elem())}
+	case reflect.Map:
+		return &apiType{Kind: apiMap, Elem: p.resolveType(t.Elem())}
+	}
+
+	return &apiType{Kind: apiUnknown, Name: t.Name()}
+}
+
+// resolveNamedType resolves a type declared in the types package, recording it on first use.
+func (p *apiPackage) resolveNamedType(t reflect.Type) *apiType {
+	name := t.Name()
+
+	switch t.Kind() {
+	case reflect.String:
+		if _, ok := p.enums[name]; !ok {
+			p.enums[name] = apiEnumValues(t)
+		}
+
+		return &apiType{Kind: apiEnum, Name: name}
+
+	case reflect.Struct:
+		if _, ok := p.shapes[name]; !ok {
+			// Record the shape before resolving its members, which may refer to it.
+			p.shapes[name] = &apiShape{Name: name}
+			*p.shapes[name] = *p.newShape(t)
+		}
+
+		return &apiType{Kind: apiStruct, Name: name}
+
+	case reflect.Interface:
+		p.unions[name] = true
+
+		return &apiType{Kind: apiUnion, Name: name}
+	}
+
+	return &apiType{Kind: apiUnknown, Name: name}
+}
+
+// apiEnumValues returns the values of an SDK enumeration type from its Values method.
+// Constant names aren't visible to reflection and are derived from the values as the SDK's code generator does.
+func apiEnumValues(t reflect.Type) []apiEnumValue {
+	m := reflect.Zero(t).MethodByName("Values")
+	if !m.IsValid() || m.Type().NumIn() != 0 || m.Type().NumOut() != 1 {
+		return nil
+	}
+
+	var values []apiEnumValue
+	v := m.Call(nil)[0]
+	for i := range v.Len() {
+		value := v.Index(i).String()
+		values = append(values, apiEnumValue{
+			Const: t.Name() + apiEnumConstName(value),
+			Value: value,
+		})
+	}
+
+	return values
+}
+
+// apiEnumConstName returns the suffix of an enumeration value's constant name, e.g. "ArchiveAccess" for ARCHIVE_ACCESS.
+func apiEnumConstName(value string) string {
+	var sb strings.Builder
+
+	for _, word := range regexache.MustCompile(`[^0-9A-Za-z]+`).Split(value, -1) {
+		if word == "" {
+			continue
+		}
+
+		if word == strings.ToUpper(word) || word == strings.ToLower(word) {
+			word = strings.ToLower(word)
+		}
+		r := []rune(word)
+		r[0] = unicode.ToUpper(r[0])
+		sb.WriteString(string(r))
+	}
+
+	return sb.String()
+}
+
+// requiredMembers marks the members an operation's input requires, at any depth.
+// The operation is called with inputs whose structures are present but empty to successively greater depths,
+// and each request is stopped after the SDK's input validation.
+func (p *apiPackage) requiredMembers(m reflect.Method, input reflect.Type) error {
+	optFn := reflect.MakeFunc(m.Type.In(3).Elem(), func(args []reflect.Value) []reflect.Value {
+		apiOptions := args[0].Elem().FieldByName("APIOptions")
+		apiOptions.Set(reflect.Append(apiOptions, reflect.ValueOf(func(stack *middleware.Stack) error {
+			return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("skaffStop", func(context.Context, middleware.InitializeInput, middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+				return middleware.InitializeOutput{}, middleware.Metadata{}, errAPIStop
+			}), middleware.After)
+		})))
+
+		return nil
+	})
+	client := reflect.New(m.Type.In(0).Elem())
+
+	for depth, truncated := 0, true; truncated; depth++ {
+		in := reflect.New(input)
+		truncated = apiPopulate(in.Elem(), depth, make(map[reflect.Type]bool))
+
+		results := m.Func.Call([]reflect.Value{client, reflect.ValueOf(context.Background()), in, optFn})
+		err, _ := results[1].Interface().(error)
+
+		if errors.Is(err, errAPIStop) {
+			continue
+		}
+
+		var ipe smithy.InvalidParamsError
+		if !errors.As(err, &ipe) {
+			return err
+		}
+
+		for _, v := range ipe.Errs() {
+			if v, ok := v.(smithy.InvalidParamError); ok {
+				p.markRequired(input, v.Field())
+			}
+		}
+	}
+
+	return nil
+}
+
+// markRequired marks the member at the specified validation error path, e.g. CreateWidgetInput.Settings.Enabled
+// or CreateWidgetInput.Rules[0].Name, as required.
+func (p *apiPackage) markRequired(input reflect.Type, field string) {
+	names := strings.Split(regexache.MustCompile(`\[[^\]]*\]`).ReplaceAllString(field, ""), ".")
+	if len(names) < 2 || names[0] != input.Name() {
+		return
+	}
+
+	t := input
+	for _, name := range names[1 : len(names)-1] {
+		f, ok := t.FieldByName(name)
+		if !ok {
+			return
+		}
+
+		t = f.Type
+		for t.Kind() == reflect.Pointer || t.Kind() == reflect.Slice || t.Kind() == reflect.Map {
+			t = t.Elem()
+		}
+	}
+
+	s := p.structs[t.Name()]
+	if t != input {
+		s = p.shapes[t.Name()]
+	}
+
+	if f := s.field(names[len(names)-1]); f != nil {
+		f.Required = true
+	}
+}
+
+// apiPopulate sets the structure members of v to empty structures, to the specified depth, so that the members
+// nested structures require are validated. Lists and maps of structures get a single empty element.
+// It reports whether structures beyond the depth were left unset.
+func apiPopulate(v reflect.Value, depth int, seen map[reflect.Type]bool) bool {
+	t := v.Type()
+	seen[t] = true
+	defer delete(seen, t)
+
+	var truncated bool
+	for i := range t.NumField() {
+		if !t.Field(i).IsExported() {
+			continue
+		}
+
+		e, ok := apiValue(t.Field(i).Type, depth, seen)
+		if e.IsValid() {
+			v.Field(i).Set(e)
+		}
+		truncated = truncated || ok
+	}
+
+	return truncated
+}
+
+// apiValue returns a populated value of the specified type, or the zero Value if the type contains no structures
+// or they are beyond the specified depth. It reports whether structures beyond the depth were left unset.
+func apiValue(t reflect.Type, depth int, seen map[reflect.Type]bool) (reflect.Value, bool) {
+	switch t.Kind() {
+	case reflect.Pointer:
+		if t.Elem().Kind() != reflect.Struct {
+			break
+		}
+
+		e, truncated := apiValue(t.Elem(), depth, seen)
+		if !e.IsValid() {
+			return e, truncated
+		}
+
+		v := reflect.New(t.Elem())
+		v.Elem().Set(e)
+		return v, truncated
+
+	case reflect.Struct:
+		if t == timeType || seen[t] {
+			break
+		}
+		if depth == 0 {
+			return reflect.Value{}, true
+		}
+
+		v := reflect.New(t).Elem()
+		return v, apiPopulate(v, depth-1, seen)
+
+	case reflect.Slice:
+		e, truncated := apiValue(t.Elem(), depth, seen)
+		if !e.IsValid() {
+			return e, truncated
+		}
+
+		v := reflect.MakeSlice(t, 1, 1)
+		v.Index(0).Set(e)
+		return v, truncated
+
+	case reflect.Map:
+		e, truncated := apiValue(t.Elem(), depth, seen)
+		if !e.IsValid() {
+			return e, truncated
+		}
+
+		v := reflect.MakeMap(t)
+		v.SetMapIndex(reflect.Zero(t.Key()), e)
+		return v, truncated
+	}
+
+	return reflect.Value{}, false
+}
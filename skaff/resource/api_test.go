@@ -0,0 +1,210 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"bytes"
+	"go/format"
+	"reflect"
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/hashicorp/terraform-provider-aws/skaff/resource/testdata/widget"
+)
+
+func TestNewAPIPackage(t *testing.T) {
+	t.Parallel()
+
+	p, err := newAPIPackage(reflect.TypeOf((*widget.Client)(nil)))
+	if err != nil {
+		t.Fatalf("introspecting API client: %s", err)
+	}
+
+	if got, want := p.Name, "widget"; got != want {
+		t.Errorf("package name = %q, want %q", got, want)
+	}
+
+	in, out, ok := p.operation("CreateWidget")
+	if !ok {
+		t.Fatal("CreateWidget operation not found")
+	}
+
+	testCases := []struct {
+		Field    string
+		Required bool
+		Kind     apiKind
+	}{
+		{Field: "WidgetName", Required: true, Kind: apiString},
+		{Field: "Size", Required: true, Kind: apiInt32},
+		{Field: "Description", Kind: apiString},
+		{Field: "Settings", Kind: apiStruct},
+		{Field: "Tags", Kind: apiMap},
+	}
+
+	for _, testCase := range testCases {
+		f := in.field(testCase.Field)
+		if f == nil {
+			t.Errorf("CreateWidgetInput.%s not found", testCase.Field)
+			continue
+		}
+
+		if f.Required != testCase.Required {
+			t.Errorf("CreateWidgetInput.%s required = %t, want %t", testCase.Field, f.Required, testCase.Required)
+		}
+		if f.Type.Kind != testCase.Kind {
+			t.Errorf("CreateWidgetInput.%s type = %s", testCase.Field, f.Type)
+		}
+	}
+
+	if out.field("ResultMetadata") != nil {
+		t.Error("CreateWidgetOutput.ResultMetadata should be ignored")
+	}
+
+	// Members required by nested structures are found by validating inputs with the structures present.
+	if f := p.shapes["WidgetSettings"].field("Enabled"); f == nil || !f.Required {
+		t.Error("WidgetSettings.Enabled should be required")
+	}
+	if f := p.shapes["WidgetSettings"].field("KmsKeyArn"); f == nil || f.Required {
+		t.Error("WidgetSettings.KmsKeyArn should be optional")
+	}
+
+	if got, want := len(p.enums["WidgetStatus"]), 5; got != want {
+		t.Errorf("WidgetStatus values = %d, want %d", got, want)
+	}
+	if got, want := p.enums["WidgetStatus"][0], (apiEnumValue{Const: "WidgetStatusCreating", Value: "CREATING"}); got != want {
+		t.Errorf("WidgetStatus value = %+v, want %+v", got, want)
+	}
+	if !p.paginators["ListWidgets"] {
+		t.Error("ListWidgets paginator not found")
+	}
+	if p.paginators["GetWidget"] {
+		t.Error("GetWidget should not be paginated")
+	}
+}
+
+func TestNewAPIData(t *testing.T) {
+	t.Parallel()
+
+	p, err := newAPIPackage(reflect.TypeOf((*widget.Client)(nil)))
+	if err != nil {
+		t.Fatalf("introspecting API client: %s", err)
+	}
+
+	td := widgetTemplateData()
+	d, err := newAPIData(p, "CreateWidget", &td, map[string]string{"description": "Description", "id": "ID", "tags": "Tags", "tags_all": "TagsAll", "timeouts": "Timeouts"})
+	if err != nil {
+		t.Fatalf("deriving API data: %s", err)
+	}
+
+	for name, testCase := range map[string]struct {
+		got, want string
+	}{
+		"read operation":   {d.ReadOperation, "GetWidget"},
+		"update operation": {d.UpdateOperation, "UpdateWidget"},
+		"delete operation": {d.DeleteOperation, "DeleteWidget"},
+		"object type":      {d.ObjectType, "awstypes.Widget"},
+		"not found error":  {d.NotFoundError, "ResourceNotFoundException"},
+		"finder":           {d.FinderName, "findWidgetByName"},
+		"client token":     {d.ClientToken, "ClientToken"},
+		"tags attribute":   {d.TagsAttribute, "widget_arn"},
+	} {
+		if testCase.got != testCase.want {
+			t.Errorf("%s = %q, want %q", name, testCase.got, testCase.want)
+		}
+	}
+
+	if d.Status == nil || d.Status.Creating != "awstypes.WidgetStatusCreating" || d.Status.Active != "awstypes.WidgetStatusActive" {
+		t.Errorf("status = %+v", d.Status)
+	}
+
+	if d.Sweep == nil || d.Sweep.ListOperation != "ListWidgets" || d.Sweep.ItemsField != "Widgets" {
+		t.Errorf("sweep = %+v", d.Sweep)
+	}
+
+	// Updatable arguments are those in both the create and update inputs.
+	if got, want := d.UpdateChanges, "!new.Description.Equal(old.Description) ||\n!new.Size.Equal(old.Size)"; got != want {
+		t.Errorf("update changes = %q, want %q", got, want)
+	}
+
+	for _, want := range []string{`names.AttrDescription: schema.StringAttribute{`, `"widget_arn": framework.ARNAttributeComputedOnly(),`, `"size": schema.Int64Attribute{`} {
+		if !strings.Contains(d.Attributes, want) {
+			t.Errorf("attributes don't contain %q", want)
+		}
+	}
+	if !strings.Contains(d.Blocks, `fwtypes.NewListNestedObjectTypeOf[widgetSettingsModel](ctx)`) {
+		t.Error("blocks don't contain the settings block")
+	}
+}
+
+func TestAPITemplates(t *testing.T) {
+	t.Parallel()
+
+	p, err := newAPIPackage(reflect.TypeOf((*widget.Client)(nil)))
+	if err != nil {
+		t.Fatalf("introspecting API client: %s", err)
+	}
+
+	td := widgetTemplateData()
+	if td.API, err = newAPIData(p, "CreateWidget", &td, nil); err != nil {
+		t.Fatalf("deriving API data: %s", err)
+	}
+	td.IncludeTags = td.API.TagsAttribute != ""
+
+	for name, tmpl := range map[string]string{
+		"resource": resourceAPITmpl,
+		"test":     resourceAPITestTmpl,
+		"sweeper":  "package widget\n\n" + sweepAPITmpl,
+	} {
+		tplate, err := template.New(name).Parse(tmpl)
+		if err != nil {
+			t.Fatalf("parsing %s template: %s", name, err)
+		}
+
+		var buffer bytes.Buffer
+		if err := tplate.Execute(&buffer, td); err != nil {
+			t.Fatalf("executing %s template: %s", name, err)
+		}
+
+		if _, err := format.Source(buffer.Bytes()); err != nil {
+			t.Errorf("%s template generated invalid Go: %s\n%s", name, err, buffer.String())
+		}
+	}
+}
+
+func widgetTemplateData() TemplateData {
+	return TemplateData{
+		Resource:             "Widget",
+		ResourceLower:        "widget",
+		ResourceSnake:        "widget",
+		HumanFriendlyService: "Widget",
+		IncludeComments:      true,
+		ServicePackage:       "widget",
+		Service:              "Widget",
+		ServiceLower:         "widget",
+		AWSServiceName:       "Amazon Widget",
+		AWSGoSDKV2:           true,
+		PluginFramework:      true,
+		HumanResourceName:    "Widget",
+		ProviderResourceName: "aws_widget_widget",
+	}
+}
+
+func TestAPIEnumConstName(t *testing.T) {
+	t.Parallel()
+
+	for value, want := range map[string]string{
+		"ACTIVE":         "Active",
+		"ARCHIVE_ACCESS": "ArchiveAccess",
+		"Enabled":        "Enabled",
+		"public-read":    "PublicRead",
+		"af-south-1":     "AfSouth1",
+		"aws:kms":        "AwsKms",
+		"EU":             "Eu",
+	} {
+		if got := apiEnumConstName(value); got != want {
+			t.Errorf("apiEnumConstName(%q) = %q, want %q", value, got, want)
+		}
+	}
+}
@@ -0,0 +1,193 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+// writeExports adds the resource and its finder to the service package's test exports.
+func writeExports(td TemplateData) error {
+	const filename = "exports_test.go"
+
+	resource := fmt.Sprintf("Resource%[1]s = new%[1]sResource", td.Resource)
+	finder := fmt.Sprintf("%s = %s", td.API.FinderExport, td.API.FinderName)
+
+	b, err := os.ReadFile(filename)
+
+	if errors.Is(err, fs.ErrNotExist) {
+		contents := fmt.Sprintf(`// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package %s
+
+// Exports for use in tests only.
+var (
+	%s
+
+	%s
+)
+`, td.ServicePackage, resource, finder)
+
+		return writeGoSource(filename, []byte(contents))
+	}
+
+	if err != nil {
+		return err
+	}
+
+	contents := string(b)
+	if strings.Contains(contents, resource) {
+		return nil
+	}
+
+	contents, ok := insertAfter(contents, "var (\n", fmt.Sprintf("\t%s\n\t%s\n\n", resource, finder))
+	if !ok {
+		fmt.Printf("TODO: add %q and %q to %s\n", resource, finder, filename)
+		return nil
+	}
+
+	return writeGoSource(filename, []byte(contents))
+}
+
+// writeSweeper adds the resource's sweeper to the service package's sweep.go, creating the file if necessary.
+func writeSweeper(td TemplateData) error {
+	const filename = "sweep.go"
+
+	tplate, err := template.New("sweeper").Parse(sweepAPITmpl)
+	if err != nil {
+		return fmt.Errorf("error parsing template: %s", err)
+	}
+
+	var buffer bytes.Buffer
+	if err := tplate.Execute(&buffer, td); err != nil {
+		return fmt.Errorf("error executing template: %s", err)
+	}
+
+	sweeper := buffer.String()
+	registration := fmt.Sprintf(`	sweep.AddTestSweepers("%[1]s", &resource.Sweeper{
+		Name: "%[1]s",
+		F:    sweep%[2]ss,
+	})
+`, td.ProviderResourceName, td.Resource)
+
+	b, err := os.ReadFile(filename)
+
+	if errors.Is(err, fs.ErrNotExist) {
+		contents := fmt.Sprintf(`// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package %s
+
+import (
+%s
+)
+
+func RegisterSweepers() {
+%s}
+
+%s`, td.ServicePackage, strings.Join(td.API.Sweep.Imports, "\n"), registration, sweeper)
+
+		if err := writeGoSource(filename, []byte(contents)); err != nil {
+			return err
+		}
+
+		fmt.Printf("Run `make gen` to register %s's sweepers with internal/sweep.\n", td.ServicePackage)
+
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	contents := string(b)
+	if strings.Contains(contents, fmt.Sprintf("func sweep%ss(", td.Resource)) {
+		fmt.Printf("%s already contains sweep%ss; sweeper not added\n", filename, td.Resource)
+		return nil
+	}
+
+	contents, ok := insertAfter(contents, "func RegisterSweepers() {\n", registration)
+	if !ok {
+		fmt.Printf("TODO: register sweep%ss in %s's RegisterSweepers\n", td.Resource, filename)
+	}
+
+	contents, err = addImports(contents, td.API.Sweep.Imports)
+	if err != nil {
+		return fmt.Errorf("parsing %s: %w", filename, err)
+	}
+
+	return writeGoSource(filename, []byte(strings.TrimRight(contents, "\n")+"\n\n"+sweeper))
+}
+
+// addImports adds any of the specified import specs missing from the Go source's import declaration.
+func addImports(contents string, imports []string) (string, error) {
+	f, err := parser.ParseFile(token.NewFileSet(), "", contents, parser.ImportsOnly)
+	if err != nil {
+		return "", err
+	}
+
+	existing := make(map[string]bool)
+	for _, spec := range f.Imports {
+		if path, err := strconv.Unquote(spec.Path.Value); err == nil {
+			existing[path] = true
+		}
+	}
+
+	var missing []string
+	for _, spec := range imports {
+		// Import specs are of the form `"path"` or `alias "path"`.
+		v := strings.Fields(spec)
+		if len(v) == 0 {
+			continue
+		}
+		if path, err := strconv.Unquote(v[len(v)-1]); err == nil && !existing[path] {
+			missing = append(missing, "\t"+spec+"\n")
+		}
+	}
+
+	if len(missing) == 0 {
+		return contents, nil
+	}
+
+	contents, ok := insertAfter(contents, "import (\n", strings.Join(missing, ""))
+	if !ok {
+		return "", fmt.Errorf("no import declaration")
+	}
+
+	return contents, nil
+}
+
+func insertAfter(contents, marker, s string) (string, bool) {
+	before, after, ok := strings.Cut(contents, marker)
+	if !ok {
+		return contents, false
+	}
+
+	return before + marker + s + after, true
+}
+
+// writeGoSource formats and writes Go source, writing the unformatted source if it can't be formatted.
+func writeGoSource(filename string, contents []byte) error {
+	formatted, err := format.Source(contents)
+	if err != nil {
+		if err := os.WriteFile(filename, contents, 0644); err != nil {
+			return err
+		}
+
+		return fmt.Errorf("error formatting generated file (%s): %s", filename, err)
+	}
+
+	return os.WriteFile(filename, formatted, 0644)
+}
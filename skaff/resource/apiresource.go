@@ -0,0 +1,694 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"encoding/csv"
+	"fmt"
+	"go/token"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-provider-aws/skaff/convert"
+)
+
+// APIData is the part of a resource's template data derived from the AWS SDK for Go v2 API model.
+type APIData struct {
+	// Name is the lower camel case resource name used for its Go types, e.g. pipeline.
+	Name string
+	// Var is the Go variable name for the API object.
+	Var             string
+	SDKPackage      string
+	Noun            string
+	CreateOperation string
+	ReadOperation   string
+	UpdateOperation string
+	DeleteOperation string
+
+	// ObjectType is the Go type returned by the finder.
+	ObjectType string
+	// ObjectField is the read operation's output member holding the object, or empty if it is the whole output.
+	ObjectField string
+	// NotFoundError is the name of the types package error returned when the resource does not exist.
+	NotFoundError string
+
+	FinderName       string
+	FinderParams     string
+	FinderParamNames string
+	FinderInput      string
+	FinderExport     string
+	IDPartCount      int
+	InitFromID       string
+	SetID            string
+	DeleteInput      string
+	ClientToken      string
+	CreateOutput     bool
+	SetFromOutput    string
+	UpdateChanges    string
+	TagsAttribute    string
+	Status           *APIStatus
+	TimeoutsBlock    string
+	Attributes       string
+	Blocks           string
+	Model            string
+	NestedModels     string
+	Imports          []string
+	TestImports      []string
+	TestObjectType   string
+	TestConfig       string
+	// TestConfigUsesName reports whether the test configuration is formatted with the random resource name.
+	TestConfigUsesName bool
+	TestChecks         string
+	TestFinderArgs     string
+	Sweep              *APISweep
+	DocExample         string
+	DocRequired        string
+	DocOptional        string
+	DocBlocks          string
+	DocAttributes      string
+	DocImportID        string
+	DocImportAttrs     string
+	// Ambiguities are the parts of the resource that could not be generated from the API model.
+	Ambiguities []string
+
+	finderArgs string
+}
+
+// APIStatus describes the waiters generated for a resource whose API object has a status member.
+type APIStatus struct {
+	Field    string
+	Reason   string
+	Creating string
+	Updating string
+	Deleting string
+	Active   string
+}
+
+// FinderArgs returns the finder's arguments taken from the resource model variable named v.
+func (d *APIData) FinderArgs(v string) string {
+	return strings.ReplaceAll(d.finderArgs, "data.", v+".")
+}
+
+// HasWaiter reports whether a waiter is generated for the specified operation ("Created", "Updated" or "Deleted").
+func (d *APIData) HasWaiter(op string) bool {
+	return d.Status.HasWaiter(op)
+}
+
+// HasWaiters reports whether any waiters are generated.
+func (d *APIData) HasWaiters() bool {
+	return d.HasWaiter("Created") || d.HasWaiter("Updated") || d.HasWaiter("Deleted")
+}
+
+// Waiters returns the operations ("Created", "Updated" or "Deleted") for which waiters are generated.
+func (d *APIData) Waiters() []string {
+	return slices.DeleteFunc([]string{"Created", "Updated", "Deleted"}, func(v string) bool { return !d.HasWaiter(v) })
+}
+
+// APISweep describes the sweeper generated for a resource whose API has a paginated list operation.
+type APISweep struct {
+	ListOperation string
+	ItemsField    string
+	Attributes    string
+	Imports       []string
+}
+
+// HasWaiter reports whether a waiter is generated for the specified operation ("Created", "Updated" or "Deleted").
+func (s *APIStatus) HasWaiter(op string) bool {
+	if s == nil {
+		return false
+	}
+
+	switch op {
+	case "Created":
+		return s.Creating != ""
+	case "Updated":
+		return s.Updating != ""
+	case "Deleted":
+		return s.Deleting != ""
+	}
+
+	return false
+}
+
+var (
+	apiCreateVerbs   = []string{"Create", "Put", "Register", "Add"}
+	apiReadVerbs     = []string{"Get", "Describe"}
+	apiUpdateVerbs   = []string{"Update", "Modify", "Put"}
+	apiDeleteVerbs   = []string{"Delete", "Deregister", "Remove"}
+	apiListVerbs     = []string{"List", "Describe"}
+	apiIgnoredFields = []string{"ClientRequestToken", "ClientToken", "DryRun", "IdempotencyToken"}
+
+	// apiInitialisms are the words in AWS API member names that this provider's Go code capitalizes.
+	apiInitialisms = map[string]string{
+		"Acl":  "ACL",
+		"Api":  "API",
+		"Arn":  "ARN",
+		"Arns": "ARNs",
+		"Cidr": "CIDR",
+		"Dns":  "DNS",
+		"Http": "HTTP",
+		"Iam":  "IAM",
+		"Id":   "ID",
+		"Ids":  "IDs",
+		"Ip":   "IP",
+		"Json": "JSON",
+		"Kms":  "KMS",
+		"Sns":  "SNS",
+		"Sqs":  "SQS",
+		"Ssl":  "SSL",
+		"Tls":  "TLS",
+		"Ttl":  "TTL",
+		"Uri":  "URI",
+		"Url":  "URL",
+		"Urls": "URLs",
+		"Vpc":  "VPC",
+		"Vpcs": "VPCs",
+	}
+
+	apiActiveStatuses = []string{
+		"ACTIVE", "AVAILABLE", "COMPLETE", "COMPLETED", "CREATED", "CREATE_COMPLETE", "DEPLOYED", "ENABLED",
+		"HEALTHY", "INSERVICE", "IN_SERVICE", "ONLINE", "READY", "RUNNING", "SUCCEEDED", "SUCCESS", "UPDATE_COMPLETE",
+	}
+	apiCreatingStatuses = []string{"CREATING", "CREATE_IN_PROGRESS", "IN_PROGRESS", "INITIALIZING", "PENDING", "PROVISIONING", "STARTING"}
+)
+
+// apiAttribute is a Terraform attribute or block mapped from an AWS API structure member.
+type apiAttribute struct {
+	Field           *apiField
+	Name            string // Terraform attribute name.
+	Key             string // Go expression for the attribute name.
+	GoName          string // Model struct field name.
+	Required        bool
+	Optional        bool
+	Computed        bool
+	RequiresReplace bool
+	Nested          bool // Member of a nested structure.
+}
+
+func (a *apiAttribute) configurable() bool {
+	return a.Required || a.Optional
+}
+
+// apiModel is a generated model struct for a nested AWS API structure.
+type apiModel struct {
+	Name   string
+	Shape  string
+	Fields []string
+}
+
+// apiGenerator generates the API-derived parts of a resource.
+type apiGenerator struct {
+	pkg           *apiPackage
+	td            *TemplateData
+	resourceLower string // e.g. pipeline
+	attrConsts    map[string]string
+	imports       map[string]string // Import path to alias.
+	models        []*apiModel
+	modelsByShape map[string]*apiModel
+	ambiguities   []string
+}
+
+// newAPIData derives the API-specific template data for the resource created by the specified operation.
+func newAPIData(p *apiPackage, createOperation string, td *TemplateData, attrConsts map[string]string) (*APIData, error) {
+	createIn, createOut, ok := p.operation(createOperation)
+	if !ok || createIn == nil {
+		return nil, fmt.Errorf("operation %s not found in package %s", createOperation, p.Name)
+	}
+
+	var noun string
+	for _, verb := range apiCreateVerbs {
+		if v, ok := strings.CutPrefix(createOperation, verb); ok && v != "" {
+			noun = v
+			break
+		}
+	}
+	if noun == "" {
+		return nil, fmt.Errorf("operation %s does not begin with one of %s", createOperation, strings.Join(apiCreateVerbs, ", "))
+	}
+
+	g := &apiGenerator{
+		pkg:           p,
+		td:            td,
+		resourceLower: convert.ToLowercasePrefix(td.Resource),
+		attrConsts:    attrConsts,
+		imports:       make(map[string]string),
+		modelsByShape: make(map[string]*apiModel),
+	}
+	d := &APIData{
+		Name:            g.resourceLower,
+		Var:             g.resourceLower,
+		SDKPackage:      p.Name,
+		Noun:            noun,
+		CreateOperation: createOperation,
+		ReadOperation:   p.firstOperation(apiReadVerbs, noun),
+		UpdateOperation: p.firstOperation(slices.DeleteFunc(slices.Clone(apiUpdateVerbs), func(v string) bool { return v+noun == createOperation }), noun),
+		DeleteOperation: p.firstOperation(apiDeleteVerbs, noun),
+	}
+
+	if d.ReadOperation == "" {
+		return nil, fmt.Errorf("no %s operation found for %s", strings.Join(apiReadVerbs, " or "), noun)
+	}
+	if d.DeleteOperation == "" {
+		return nil, fmt.Errorf("no %s operation found for %s", strings.Join(apiDeleteVerbs, " or "), noun)
+	}
+
+	readIn, readOut, _ := p.operation(d.ReadOperation)
+	deleteIn, _, _ := p.operation(d.DeleteOperation)
+	var updateIn *apiShape
+	if d.UpdateOperation != "" {
+		updateIn, _, _ = p.operation(d.UpdateOperation)
+	}
+
+	// The API object is the read operation's output member of the resource's type, or its only structure member.
+	object := readOut
+	d.ObjectType = fmt.Sprintf("%s.%s", p.Name, readOut.Name)
+	if f := objectField(readOut, noun); f != nil {
+		object = p.shapes[f.Type.Name]
+		d.ObjectType = "awstypes." + f.Type.Name
+		d.ObjectField = f.Name
+		g.use(awsTypesImport(p.Name), "awstypes")
+	}
+
+	// Error types aren't referred to by the client, so aren't visible to reflection.
+	d.NotFoundError = "ResourceNotFoundException"
+	g.ambiguity(fmt.Sprintf("check that %s returns awstypes.%s when the %s does not exist", d.ReadOperation, d.NotFoundError, noun))
+
+	// Identifiers are the members required by the read operation.
+	var identifiers []*apiField
+	for _, f := range readIn.Fields {
+		if f.Required {
+			identifiers = append(identifiers, f)
+		}
+	}
+	if len(identifiers) == 0 {
+		return nil, fmt.Errorf("operation %s has no required input members to identify a %s", d.ReadOperation, noun)
+	}
+	for _, f := range identifiers {
+		if f.Type.Kind != apiString {
+			return nil, fmt.Errorf("operation %s input member %s is %s, not string", d.ReadOperation, f.Name, f.Type)
+		}
+	}
+
+	// Top-level attributes are the create operation's input members followed by the API object's other members.
+	var attributes []*apiAttribute
+	byField := make(map[string]*apiAttribute)
+	add := func(f *apiField, a *apiAttribute) {
+		if slices.Contains(apiIgnoredFields, f.Name) || f.Name == "Tags" || byField[f.Name] != nil {
+			return
+		}
+
+		a.Field = f
+		a.Name = convert.ToSnakeCase(f.Name, "")
+		a.Key = g.attrKey(a.Name)
+		a.GoName = apiGoName(f.Name)
+		attributes = append(attributes, a)
+		byField[f.Name] = a
+	}
+	for _, f := range createIn.Fields {
+		a := &apiAttribute{Required: f.Required, Optional: !f.Required}
+		if o := object.field(f.Name); o != nil && !f.Required {
+			a.Computed = true
+		}
+		a.RequiresReplace = updateIn == nil || updateIn.field(f.Name) == nil || slices.Contains(identifiers, readIn.field(f.Name))
+		add(f, a)
+	}
+	for _, f := range object.Fields {
+		if f.Type.Kind == apiStruct && f.Type.Name == noun {
+			continue
+		}
+		add(f, &apiAttribute{Computed: true})
+	}
+	for _, f := range identifiers {
+		if byField[f.Name] == nil {
+			add(f, &apiAttribute{Computed: true})
+		}
+	}
+
+	for _, v := range []string{"ClientToken", "ClientRequestToken", "IdempotencyToken"} {
+		if createIn.field(v) != nil {
+			d.ClientToken = v
+			g.use("github.com/aws/aws-sdk-go-v2/aws", "")
+			g.use("github.com/hashicorp/terraform-plugin-sdk/v2/helper/id", "sdkid")
+			break
+		}
+	}
+
+	if createIn.field("Tags") != nil {
+		for _, a := range attributes {
+			if a.Computed && !a.configurable() && a.Field.Type.Kind == apiString && (a.Field.Name == "Arn" || a.Field.Name == noun+"Arn") {
+				d.TagsAttribute = a.Name
+				break
+			}
+		}
+		if d.TagsAttribute == "" {
+			d.TagsAttribute = "TODO"
+			g.ambiguity("no ARN member was found for the @Tags identifierAttribute annotation")
+		}
+	}
+
+	// Status and waiters.
+	for _, v := range []string{"Status", "State", noun + "Status", noun + "State"} {
+		if f := object.field(v); f != nil && f.Type.Kind == apiEnum && d.ObjectField != "" {
+			d.Status = g.status(f, updateIn != nil)
+			for _, v := range []string{"StatusReason", "StateReason", "StatusMessage", "FailureReason"} {
+				if f := object.field(v); f != nil && f.Type.Kind == apiString {
+					d.Status.Reason = f.Name
+					break
+				}
+			}
+			break
+		}
+	}
+	if d.Status == nil {
+		g.ambiguity("no status member was found on the API object; no waiters are generated")
+	}
+
+	g.identifiers(d, identifiers, byField, createOut, deleteIn)
+
+	// Update.
+	if updateIn != nil {
+		var changes []string
+		for _, a := range attributes {
+			if a.configurable() && !a.RequiresReplace {
+				changes = append(changes, fmt.Sprintf("!new.%[1]s.Equal(old.%[1]s)", a.GoName))
+			}
+		}
+		slices.Sort(changes)
+		d.UpdateChanges = strings.Join(changes, " ||\n")
+	}
+	if d.UpdateChanges == "" {
+		d.UpdateOperation = ""
+		if d.Status != nil {
+			d.Status.Updating = ""
+		}
+	}
+
+	if token.IsKeyword(d.Var) || d.Var == p.Name || d.Var == "output" || d.Var == "input" {
+		d.Var = "v"
+	}
+
+	g.schema(d, attributes)
+	g.sweep(d, noun, identifiers, byField)
+	g.test(d, attributes)
+	g.doc(d, attributes, identifiers, byField)
+
+	g.use("context", "")
+	g.use("fmt", "")
+	g.use("github.com/aws/aws-sdk-go-v2/service/"+p.Name, "")
+	g.use(frameworkImport+"/resource", "")
+	g.use(frameworkImport+"/resource/schema", "")
+	g.use("github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag", "")
+	g.use("github.com/hashicorp/terraform-provider-aws/internal/framework/flex", "fwflex")
+	g.use("github.com/hashicorp/terraform-provider-aws/internal/tfresource", "")
+	if d.NotFoundError != "" {
+		g.use("github.com/hashicorp/terraform-provider-aws/internal/errs", "")
+		g.use(awsTypesImport(p.Name), "awstypes")
+	}
+	if d.HasWaiters() {
+		g.use("time", "")
+		g.use("github.com/hashicorp/terraform-provider-aws/internal/enum", "")
+		g.use("github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts", "")
+	}
+	if d.NotFoundError != "" || d.HasWaiters() {
+		g.use("github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry", "")
+	}
+	if d.HasWaiters() && d.Status.Reason != "" {
+		g.use("errors", "")
+	}
+	d.Imports = g.importList()
+	slices.Sort(g.ambiguities)
+	d.Ambiguities = slices.Compact(g.ambiguities)
+
+	return d, nil
+}
+
+func (p *apiPackage) firstOperation(verbs []string, noun string) string {
+	for _, verb := range verbs {
+		if p.operations[verb+noun] {
+			return verb + noun
+		}
+	}
+
+	return ""
+}
+
+// objectField returns the read output member holding the API object.
+func objectField(output *apiShape, noun string) *apiField {
+	var structs []*apiField
+	for _, f := range output.Fields {
+		if f.Type.Kind == apiStruct {
+			if f.Type.Name == noun {
+				return f
+			}
+			structs = append(structs, f)
+		}
+	}
+
+	if len(structs) == 1 {
+		return structs[0]
+	}
+
+	return nil
+}
+
+func awsTypesImport(sdkPackage string) string {
+	return fmt.Sprintf("github.com/aws/aws-sdk-go-v2/service/%s/types", sdkPackage)
+}
+
+func (g *apiGenerator) use(path, alias string) {
+	g.imports[path] = alias
+}
+
+func (g *apiGenerator) ambiguity(format string, a ...any) {
+	g.ambiguities = append(g.ambiguities, fmt.Sprintf(format, a...))
+}
+
+// importList returns the import specs in goimports order.
+func (g *apiGenerator) importList() []string {
+	return importSpecs(g.imports)
+}
+
+func importSpecs(imports map[string]string) []string {
+	var std, other []string
+	for path, alias := range imports {
+		spec := strconv.Quote(path)
+		if alias != "" {
+			spec = alias + " " + spec
+		}
+
+		if strings.Contains(strings.Split(path, "/")[0], ".") {
+			other = append(other, spec)
+		} else {
+			std = append(std, spec)
+		}
+	}
+
+	byPath := func(a, b string) int {
+		return strings.Compare(a[strings.Index(a, `"`):], b[strings.Index(b, `"`):])
+	}
+	slices.SortFunc(std, byPath)
+	slices.SortFunc(other, byPath)
+
+	if len(std) > 0 && len(other) > 0 {
+		std = append(std, "")
+	}
+
+	return append(std, other...)
+}
+
+// attrKey returns the Go expression for a Terraform attribute name, using a names.Attr constant where one exists.
+func (g *apiGenerator) attrKey(name string) string {
+	if v, ok := g.attrConsts[name]; ok {
+		return "names.Attr" + v
+	}
+
+	return strconv.Quote(name)
+}
+
+// apiGoName returns the Go name for an AWS API member name, with this provider's initialisms.
+func apiGoName(name string) string {
+	return regexache.MustCompile(`[A-Z][a-z0-9]*`).ReplaceAllStringFunc(name, func(w string) string {
+		if v, ok := apiInitialisms[w]; ok {
+			return v
+		}
+		return w
+	})
+}
+
+func (g *apiGenerator) status(f *apiField, update bool) *APIStatus {
+	s := &APIStatus{Field: f.Name}
+
+	var creating, updating, deleting, active []string
+	for _, v := range g.pkg.enums[f.Type.Name] {
+		c := "awstypes." + v.Const
+		value := strings.ToUpper(v.Value)
+
+		switch {
+		case strings.Contains(value, "FAIL") || strings.Contains(value, "ERROR"):
+		case slices.Contains(apiActiveStatuses, value):
+			active = append(active, c)
+		case strings.HasPrefix(value, "DELET") && value != "DELETED":
+			deleting = append(deleting, c)
+		case strings.Contains(value, "UPDAT") || strings.Contains(value, "MODIF"):
+			updating = append(updating, c)
+		case slices.Contains(apiCreatingStatuses, value):
+			creating = append(creating, c)
+		}
+	}
+
+	if len(active) > 0 {
+		s.Active = strings.Join(active, ", ")
+		s.Creating = strings.Join(creating, ", ")
+		if update {
+			s.Updating = strings.Join(updating, ", ")
+		}
+	} else {
+		g.ambiguity("no active value of %s was recognized; no create or update waiters are generated", f.Type.Name)
+	}
+	s.Deleting = strings.Join(deleting, ", ")
+
+	return s
+}
+
+// identifiers generates the finder and the code that maps the resource ID to and from the identifying attributes.
+func (g *apiGenerator) identifiers(d *APIData, identifiers []*apiField, byField map[string]*apiAttribute, createOut, deleteIn *apiShape) {
+	var params, input, args, testArgs, initFromID, setID, parts, setFromOutput []string
+
+	for i, f := range identifiers {
+		a := byField[f.Name]
+		param := a.GoName
+		if v := strings.TrimPrefix(param, d.Noun); len(identifiers) == 1 && v != "" {
+			param = v
+		}
+		param = convert.ToLowercasePrefix(param)
+		if token.IsKeyword(param) {
+			param += "_"
+		}
+
+		params = append(params, param)
+		input = append(input, fmt.Sprintf("%s: aws.String(%s),", f.Name, param))
+		args = append(args, fmt.Sprintf("data.%s.ValueString()", a.GoName))
+		testArgs = append(testArgs, fmt.Sprintf("rs.Primary.Attributes[%s]", a.Key))
+		parts = append(parts, fmt.Sprintf("data.%s.ValueString()", a.GoName))
+
+		value := "data.ID"
+		if len(identifiers) > 1 {
+			value = fmt.Sprintf("types.StringValue(parts[%d])", i)
+		}
+		if a.arnType() {
+			value = strings.Replace(strings.Replace(value, "types.StringValue(", "fwtypes.ARNValue(", 1), "data.ID", "fwtypes.ARNValue(data.ID.ValueString())", 1)
+			g.use("github.com/hashicorp/terraform-provider-aws/internal/framework/types", "fwtypes")
+		}
+		initFromID = append(initFromID, fmt.Sprintf("data.%s = %s", a.GoName, value))
+
+		if a.configurable() {
+			continue
+		}
+
+		// The identifier is assigned by AWS.
+		toFramework := "fwflex.StringToFramework"
+		if createOut.field(f.Name) != nil {
+			setFromOutput = append(setFromOutput, fmt.Sprintf("data.%s = %s(ctx, output.%s)", a.GoName, toFramework, f.Name))
+		} else if o := objectField(createOut, d.Noun); o != nil && g.pkg.shapes[o.Type.Name].field(f.Name) != nil {
+			setFromOutput = append(setFromOutput, fmt.Sprintf("data.%s = %s(ctx, output.%s.%s)", a.GoName, toFramework, o.Name, f.Name))
+		} else {
+			setFromOutput = append(setFromOutput, fmt.Sprintf("// TODO: Set data.%s from the %s output.", a.GoName, d.CreateOperation))
+			g.ambiguity("identifier %s was not found in the %s output", f.Name, d.CreateOperation)
+		}
+	}
+
+	g.use("github.com/aws/aws-sdk-go-v2/aws", "")
+
+	switch n := len(identifiers); n {
+	case 1:
+		a := byField[identifiers[0].Name]
+		d.FinderName = fmt.Sprintf("find%sBy%s", g.td.Resource, strings.ToUpper(params[0][:1])+params[0][1:])
+		if a.arnType() {
+			setID = append(setID, fmt.Sprintf("data.ID = types.StringValue(data.%s.ValueString())", a.GoName))
+		} else {
+			setID = append(setID, fmt.Sprintf("data.ID = data.%s", a.GoName))
+		}
+	default:
+		d.FinderName = fmt.Sprintf("find%sBy%sPartKey", g.td.Resource, apiPartCount(n))
+		d.IDPartCount = n
+		initFromID = append([]string{
+			fmt.Sprintf("parts, err := flex.ExpandResourceId(data.ID.ValueString(), %sResourceIDPartCount, false)", g.resourceLower),
+			"if err != nil {\nreturn err\n}\n",
+		}, initFromID...)
+		setID = append(setID, fmt.Sprintf("data.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{%s}, %sResourceIDPartCount, false)))", strings.Join(parts, ", "), g.resourceLower))
+		g.use("github.com/hashicorp/terraform-provider-aws/internal/flex", "")
+		g.use("github.com/hashicorp/terraform-provider-aws/internal/errs", "")
+	}
+
+	d.FinderParams = strings.Join(params, ", ") + " string"
+	d.FinderParamNames = strings.Join(params, ", ")
+	d.FinderInput = strings.Join(input, "\n")
+	d.FinderExport = strings.ToUpper(d.FinderName[:1]) + d.FinderName[1:]
+	d.finderArgs = strings.Join(args, ", ")
+	d.TestFinderArgs = strings.Join(testArgs, ", ")
+	d.InitFromID = strings.Join(initFromID, "\n")
+	d.SetID = strings.Join(setID, "\n")
+	d.SetFromOutput = strings.Join(setFromOutput, "\n")
+	d.CreateOutput = slices.ContainsFunc(setFromOutput, func(v string) bool { return !strings.HasPrefix(v, "//") })
+
+	var deleteInput []string
+	for _, f := range deleteIn.Fields {
+		if !f.Required {
+			continue
+		}
+
+		if a := byField[f.Name]; a != nil && f.Type.Kind == apiString {
+			deleteInput = append(deleteInput, fmt.Sprintf("%s: aws.String(data.%s.ValueString()),", f.Name, a.GoName))
+		} else {
+			deleteInput = append(deleteInput, fmt.Sprintf("// TODO: %s: ,", f.Name))
+			g.ambiguity("required %s input member %s has no corresponding attribute", d.DeleteOperation, f.Name)
+		}
+	}
+	d.DeleteInput = strings.Join(deleteInput, "\n")
+}
+
+func apiIsARN(f *apiField) bool {
+	return f.Type.Kind == apiString && strings.HasSuffix(f.Name, "Arn")
+}
+
+func apiPartCount(n int) string {
+	switch n {
+	case 2:
+		return "Two"
+	case 3:
+		return "Three"
+	case 4:
+		return "Four"
+	}
+
+	return strconv.Itoa(n)
+}
+
+// readAttrConsts returns the names.Attr constant names keyed by Terraform attribute name.
+// The constants are read from the provider's names/attr_constants.csv, relative to the service package directory.
+func readAttrConsts() (map[string]string, error) {
+	f, err := os.Open(filepath.Join("..", "..", "..", "names", "attr_constants.csv"))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	records, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	consts := make(map[string]string)
+	for _, record := range records {
+		if len(record) == 2 {
+			consts[record[0]] = record[1]
+		}
+	}
+
+	return consts, nil
+}
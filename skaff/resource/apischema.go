@@ -0,0 +1,370 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/skaff/convert"
+)
+
+const (
+	frameworkImport     = "github.com/hashicorp/terraform-plugin-framework"
+	frameworkTypesAlias = "fwtypes"
+	frameworkTypes      = "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+// apiScalar is the Plugin Framework mapping of an AWS API scalar or list or map of strings.
+type apiScalar struct {
+	attribute    string // e.g. schema.StringAttribute
+	customType   string
+	elementType  string
+	model        string // Model struct field type.
+	planModifier string // e.g. string
+}
+
+// apiSchemaEntry is a generated schema attribute or block and its model struct field.
+type apiSchemaEntry struct {
+	name  string
+	code  string
+	block bool
+	field string
+}
+
+// arnType reports whether the attribute's model type is fwtypes.ARN.
+// Top-level computed-only ARNs use framework.ARNAttributeComputedOnly and are plain strings.
+// A nested structure's model is shared by its computed and configurable uses, so nested ARNs are always fwtypes.ARN.
+func (a *apiAttribute) arnType() bool {
+	return (a.configurable() || a.Nested) && apiIsARN(a.Field)
+}
+
+func (g *apiGenerator) scalar(a *apiAttribute) (apiScalar, bool) {
+	t := a.Field.Type
+
+	switch t.Kind {
+	case apiString:
+		if a.arnType() {
+			g.use(frameworkTypes, frameworkTypesAlias)
+			return apiScalar{attribute: "schema.StringAttribute", customType: "fwtypes.ARNType", model: "fwtypes.ARN", planModifier: "string"}, true
+		}
+		return apiScalar{attribute: "schema.StringAttribute", model: "types.String", planModifier: "string"}, true
+	case apiEnum:
+		g.use(frameworkTypes, frameworkTypesAlias)
+		g.use(awsTypesImport(g.pkg.Name), "awstypes")
+		return apiScalar{
+			attribute:    "schema.StringAttribute",
+			customType:   fmt.Sprintf("fwtypes.StringEnumType[awstypes.%s]()", t.Name),
+			model:        fmt.Sprintf("fwtypes.StringEnum[awstypes.%s]", t.Name),
+			planModifier: "string",
+		}, true
+	case apiTime:
+		g.use("github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes", "")
+		return apiScalar{attribute: "schema.StringAttribute", customType: "timetypes.RFC3339Type{}", model: "timetypes.RFC3339", planModifier: "string"}, true
+	case apiBool:
+		return apiScalar{attribute: "schema.BoolAttribute", model: "types.Bool", planModifier: "bool"}, true
+	case apiInt32, apiInt64:
+		return apiScalar{attribute: "schema.Int64Attribute", model: "types.Int64", planModifier: "int64"}, true
+	case apiFloat64:
+		return apiScalar{attribute: "schema.Float64Attribute", model: "types.Float64", planModifier: "float64"}, true
+	case apiList:
+		switch t.Elem.Kind {
+		case apiString, apiEnum:
+			g.use(frameworkTypes, frameworkTypesAlias)
+			if t.Elem.Kind == apiString && strings.HasSuffix(a.Field.Name, "Arns") {
+				return apiScalar{attribute: "schema.ListAttribute", customType: "fwtypes.ListOfARNType", elementType: "fwtypes.ARNType", model: "fwtypes.ListValueOf[fwtypes.ARN]", planModifier: "list"}, true
+			}
+			return apiScalar{attribute: "schema.ListAttribute", customType: "fwtypes.ListOfStringType", elementType: "types.StringType", model: "fwtypes.ListValueOf[types.String]", planModifier: "list"}, true
+		}
+	case apiMap:
+		if t.Elem.Kind == apiString {
+			g.use(frameworkTypes, frameworkTypesAlias)
+			return apiScalar{attribute: "schema.MapAttribute", customType: "fwtypes.MapOfStringType", elementType: "types.StringType", model: "fwtypes.MapValueOf[types.String]", planModifier: "map"}, true
+		}
+	}
+
+	return apiScalar{}, false
+}
+
+// nestedShape returns the name of the structure for a member that maps to a nested object.
+func nestedShape(t *apiType) (string, bool) {
+	switch {
+	case t.Kind == apiStruct:
+		return t.Name, true
+	case t.Kind == apiList && t.Elem.Kind == apiStruct:
+		return t.Elem.Name, false
+	}
+
+	return "", false
+}
+
+// schema generates the resource's schema attributes and blocks and its model structs.
+func (g *apiGenerator) schema(d *APIData, attributes []*apiAttribute) {
+	g.use("github.com/hashicorp/terraform-provider-aws/internal/framework", "")
+	g.use("github.com/hashicorp/terraform-provider-aws/names", "")
+	g.use(frameworkImport+"/types", "")
+
+	entries := []apiSchemaEntry{{
+		name:  "id",
+		code:  "names.AttrID: framework.IDAttribute(),",
+		field: "ID types.String `tfsdk:\"id\"`",
+	}}
+
+	for _, a := range attributes {
+		if e, ok := g.entry(a, nil, true); ok {
+			entries = append(entries, e)
+		}
+	}
+
+	if d.TagsAttribute != "" {
+		g.use("github.com/hashicorp/terraform-provider-aws/internal/tags", "tftags")
+		entries = append(entries,
+			apiSchemaEntry{name: "tags", code: "names.AttrTags: tftags.TagsAttribute(),", field: "Tags types.Map `tfsdk:\"tags\"`"},
+			apiSchemaEntry{name: "tags_all", code: "names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),", field: "TagsAll types.Map `tfsdk:\"tags_all\"`"},
+		)
+	}
+
+	if d.HasWaiters() {
+		var opts []string
+		for _, v := range []string{"Create", "Update", "Delete"} {
+			if d.HasWaiter(v + "d") {
+				opts = append(opts, v+": true,")
+			}
+		}
+		d.TimeoutsBlock = strings.Join(opts, "\n")
+
+		entries = append(entries, apiSchemaEntry{
+			name:  "timeouts",
+			code:  fmt.Sprintf("names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{\n%s\n}),", d.TimeoutsBlock),
+			block: true,
+			field: "Timeouts timeouts.Value `tfsdk:\"timeouts\"`",
+		})
+	}
+
+	attrs, blocks, fields := sortEntries(entries)
+	d.Attributes = attrs
+	d.Blocks = blocks
+	d.Model = fields
+
+	var models []string
+	for _, m := range g.models {
+		models = append(models, fmt.Sprintf("type %s struct {\n%s\n}", m.Name, strings.Join(m.Fields, "\n")))
+	}
+	d.NestedModels = strings.Join(models, "\n\n")
+}
+
+func sortEntries(entries []apiSchemaEntry) (attrs, blocks, fields string) {
+	slices.SortFunc(entries, func(a, b apiSchemaEntry) int { return strings.Compare(a.name, b.name) })
+
+	var a, b, f []string
+	for _, e := range entries {
+		if e.block {
+			b = append(b, e.code)
+		} else {
+			a = append(a, e.code)
+		}
+		if e.field != "" {
+			f = append(f, e.field)
+		}
+	}
+
+	return strings.Join(a, "\n"), strings.Join(b, "\n"), strings.Join(f, "\n")
+}
+
+// entry generates the schema attribute or block for an attribute.
+// stack holds the names of the structures enclosing a nested attribute.
+func (g *apiGenerator) entry(a *apiAttribute, stack []string, topLevel bool) (apiSchemaEntry, bool) {
+	e := apiSchemaEntry{name: a.Name}
+	field := func(model string) string {
+		return fmt.Sprintf("%s %s `tfsdk:%q`", a.GoName, model, a.Name)
+	}
+
+	if topLevel && a.Computed && !a.configurable() && apiIsARN(a.Field) {
+		e.code = fmt.Sprintf("%s: framework.ARNAttributeComputedOnly(),", a.Key)
+		e.field = field("types.String")
+		return e, true
+	}
+
+	if s, ok := g.scalar(a); ok {
+		var b strings.Builder
+		fmt.Fprintf(&b, "%s: %s{\n", a.Key, s.attribute)
+		if s.customType != "" {
+			fmt.Fprintf(&b, "CustomType: %s,\n", s.customType)
+		}
+		g.writeFlags(&b, a)
+		if s.elementType != "" {
+			fmt.Fprintf(&b, "ElementType: %s,\n", s.elementType)
+		}
+		if topLevel {
+			g.writePlanModifiers(&b, a, s.planModifier)
+		}
+		b.WriteString("},")
+
+		e.code = b.String()
+		e.field = field(s.model)
+		return e, true
+	}
+
+	shape, single := nestedShape(a.Field.Type)
+	if shape == "" {
+		g.ambiguity("%s (%s) has no Plugin Framework mapping", a.Name, a.Field.Type)
+		e.code = fmt.Sprintf("// TODO: %s (%s).", a.Name, a.Field.Type)
+		return e, true
+	}
+	if slices.Contains(stack, shape) {
+		g.ambiguity("%s (%s) is recursive", a.Name, shape)
+		e.code = fmt.Sprintf("// TODO: %s (recursive %s).", a.Name, shape)
+		return e, true
+	}
+
+	m := g.model(shape, append(stack, shape))
+	e.field = field(fmt.Sprintf("fwtypes.ListNestedObjectValueOf[%s]", m.Name))
+	g.use(frameworkTypes, frameworkTypesAlias)
+
+	var b strings.Builder
+	if !a.configurable() {
+		fmt.Fprintf(&b, "%s: schema.ListAttribute{\n", a.Key)
+		fmt.Fprintf(&b, "CustomType: fwtypes.NewListNestedObjectTypeOf[%s](ctx),\n", m.Name)
+		b.WriteString("Computed: true,\n")
+		fmt.Fprintf(&b, "ElementType: fwtypes.NewObjectTypeOf[%s](ctx),\n", m.Name)
+		if topLevel {
+			g.writePlanModifiers(&b, a, "list")
+		}
+		b.WriteString("},")
+
+		e.code = b.String()
+		return e, true
+	}
+
+	e.block = true
+	fmt.Fprintf(&b, "%s: schema.ListNestedBlock{\n", a.Key)
+	fmt.Fprintf(&b, "CustomType: fwtypes.NewListNestedObjectTypeOf[%s](ctx),\n", m.Name)
+	if topLevel {
+		g.writePlanModifiers(&b, a, "list")
+	}
+	var validators []string
+	if single {
+		validators = append(validators, "listvalidator.SizeAtMost(1),")
+	}
+	if a.Required {
+		validators = append(validators, "listvalidator.IsRequired(),")
+	}
+	if len(validators) > 0 {
+		g.use("github.com/hashicorp/terraform-plugin-framework-validators/listvalidator", "")
+		g.use(frameworkImport+"/schema/validator", "")
+		fmt.Fprintf(&b, "Validators: []validator.List{\n%s\n},\n", strings.Join(validators, "\n"))
+	}
+	attrs, blocks := g.nestedObject(shape, append(stack, shape))
+	b.WriteString("NestedObject: schema.NestedBlockObject{\n")
+	if attrs != "" {
+		fmt.Fprintf(&b, "Attributes: map[string]schema.Attribute{\n%s\n},\n", attrs)
+	}
+	if blocks != "" {
+		fmt.Fprintf(&b, "Blocks: map[string]schema.Block{\n%s\n},\n", blocks)
+	}
+	b.WriteString("},\n},")
+
+	e.code = b.String()
+	return e, true
+}
+
+func (g *apiGenerator) writeFlags(b *strings.Builder, a *apiAttribute) {
+	if a.Required {
+		b.WriteString("Required: true,\n")
+	}
+	if a.Optional {
+		b.WriteString("Optional: true,\n")
+	}
+	if a.Computed {
+		b.WriteString("Computed: true,\n")
+	}
+}
+
+func (g *apiGenerator) writePlanModifiers(b *strings.Builder, a *apiAttribute, planModifier string) {
+	var modifiers []string
+	if a.RequiresReplace && a.configurable() {
+		modifiers = append(modifiers, planModifier+"planmodifier.RequiresReplace(),")
+	}
+	if a.Computed {
+		modifiers = append(modifiers, planModifier+"planmodifier.UseStateForUnknown(),")
+	}
+	if len(modifiers) == 0 {
+		return
+	}
+
+	g.use(frameworkImport+"/resource/schema/planmodifier", "")
+	g.use(frameworkImport+"/resource/schema/"+planModifier+"planmodifier", "")
+	fmt.Fprintf(b, "PlanModifiers: []planmodifier.%s{\n%s\n},\n", strings.ToUpper(planModifier[:1])+planModifier[1:], strings.Join(modifiers, "\n"))
+}
+
+// nestedAttributes returns the attributes for a structure's members.
+// The attributes of a computed-only nested object are all computed-only.
+func (g *apiGenerator) nestedAttributes(shape string, computed bool) []*apiAttribute {
+	var attributes []*apiAttribute
+
+	for _, f := range g.pkg.shapes[shape].Fields {
+		name := convert.ToSnakeCase(f.Name, "")
+		a := &apiAttribute{
+			Field:    f,
+			Name:     name,
+			Key:      g.attrKey(name),
+			GoName:   apiGoName(f.Name),
+			Required: !computed && f.Required,
+			Optional: !computed && !f.Required,
+			Computed: computed,
+			Nested:   true,
+		}
+		attributes = append(attributes, a)
+	}
+
+	return attributes
+}
+
+// nestedObject returns the attributes and blocks of a configurable nested block.
+func (g *apiGenerator) nestedObject(shape string, stack []string) (attrs, blocks string) {
+	var entries []apiSchemaEntry
+	for _, a := range g.nestedAttributes(shape, false) {
+		if e, ok := g.entry(a, stack, false); ok {
+			entries = append(entries, e)
+		}
+	}
+
+	attrs, blocks, _ = sortEntries(entries)
+	return attrs, blocks
+}
+
+// model returns the model struct for a structure, generating it and the models for its nested structures if necessary.
+func (g *apiGenerator) model(shape string, stack []string) *apiModel {
+	if m, ok := g.modelsByShape[shape]; ok {
+		return m
+	}
+
+	m := &apiModel{Name: convert.ToLowercasePrefix(apiGoName(shape)) + "Model", Shape: shape}
+	g.modelsByShape[shape] = m
+	g.models = append(g.models, m)
+
+	var entries []apiSchemaEntry
+	for _, a := range g.nestedAttributes(shape, true) {
+		if e, ok := g.entry(a, stack, false); ok {
+			entries = append(entries, e)
+		}
+	}
+	m.Fields = entryFields(entries)
+
+	return m
+}
+
+func entryFields(entries []apiSchemaEntry) []string {
+	slices.SortFunc(entries, func(a, b apiSchemaEntry) int { return strings.Compare(a.name, b.name) })
+
+	var fields []string
+	for _, e := range entries {
+		if e.field != "" {
+			fields = append(fields, e.field)
+		}
+	}
+
+	return fields
+}
@@ -0,0 +1,294 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// sweep generates the sweeper for a resource whose API has a paginated list operation returning its identifiers.
+func (g *apiGenerator) sweep(d *APIData, noun string, identifiers []*apiField, byField map[string]*apiAttribute) {
+	var list string
+	for _, plural := range []string{noun + "s", noun + "es", strings.TrimSuffix(noun, "y") + "ies"} {
+		if v := g.pkg.firstOperation(apiListVerbs, plural); v != "" && g.pkg.paginators[v] {
+			list = v
+			break
+		}
+	}
+	if list == "" {
+		g.ambiguity("no paginated List or Describe operation was found; no sweeper is generated")
+		return
+	}
+
+	listIn, listOut, _ := g.pkg.operation(list)
+	if slices.ContainsFunc(listIn.Fields, func(f *apiField) bool { return f.Required }) {
+		g.ambiguity("%s has required input members; no sweeper is generated", list)
+		return
+	}
+
+	for _, f := range listOut.Fields {
+		if f.Type.Kind != apiList || f.Type.Elem.Kind != apiStruct {
+			continue
+		}
+
+		item := g.pkg.shapes[f.Type.Elem.Name]
+		var attributes []string
+		for _, id := range identifiers {
+			if v := item.field(id.Name); v == nil || v.Type.Kind != apiString {
+				attributes = nil
+				break
+			}
+
+			attributes = append(attributes, fmt.Sprintf("framework.NewAttribute(%s, aws.ToString(v.%s)),", byField[id.Name].Key, id.Name))
+		}
+		if attributes == nil {
+			continue
+		}
+
+		imports := map[string]string{
+			"fmt":                              "",
+			"log":                              "",
+			"github.com/aws/aws-sdk-go-v2/aws": "",
+			"github.com/aws/aws-sdk-go-v2/service/" + g.pkg.Name:                   "",
+			"github.com/hashicorp/terraform-plugin-testing/helper/resource":        "",
+			"github.com/hashicorp/terraform-provider-aws/internal/sweep":           "",
+			"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2":     "",
+			"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework": "",
+		}
+		if strings.Contains(strings.Join(attributes, ""), "names.") {
+			imports["github.com/hashicorp/terraform-provider-aws/names"] = ""
+		}
+
+		d.Sweep = &APISweep{
+			ListOperation: list,
+			ItemsField:    f.Name,
+			Attributes:    strings.Join(attributes, "\n"),
+			Imports:       importSpecs(imports),
+		}
+		return
+	}
+
+	g.ambiguity("%s output has no list of items containing the resource's identifiers; no sweeper is generated", list)
+}
+
+func isNameAttribute(a *apiAttribute) bool {
+	return a.Field.Type.Kind == apiString && (a.Name == "name" || strings.HasSuffix(a.Name, "_name"))
+}
+
+// hclValue returns a minimal Terraform configuration value for a required attribute.
+func (g *apiGenerator) hclValue(a *apiAttribute, name string) (string, bool) {
+	t := a.Field.Type
+
+	switch t.Kind {
+	case apiString:
+		if isNameAttribute(a) {
+			return name, true
+		}
+		return `"TODO"`, true
+	case apiEnum:
+		if v := g.pkg.enums[t.Name]; len(v) > 0 {
+			return strconv.Quote(v[0].Value), true
+		}
+		return `"TODO"`, true
+	case apiTime:
+		return `"2030-01-01T00:00:00Z"`, true
+	case apiBool:
+		return "true", true
+	case apiInt32, apiInt64, apiFloat64:
+		return "1", true
+	case apiList:
+		if k := t.Elem.Kind; k == apiString || k == apiEnum {
+			return `["TODO"]`, true
+		}
+	case apiMap:
+		if t.Elem.Kind == apiString {
+			return `{ key1 = "value1" }`, true
+		}
+	}
+
+	return "", false
+}
+
+// hclBody returns the lines of a minimal Terraform configuration body setting the required attributes.
+// name is the configuration value for name attributes.
+func (g *apiGenerator) hclBody(attributes []*apiAttribute, indent, name string, stack []string) []string {
+	var args [][2]string
+	var blocks []string
+
+	for _, a := range sortedAttributes(attributes) {
+		if !a.Required {
+			continue
+		}
+
+		if v, ok := g.hclValue(a, name); ok {
+			args = append(args, [2]string{a.Name, v})
+			continue
+		}
+
+		if shape, _ := nestedShape(a.Field.Type); shape != "" && !slices.Contains(stack, shape) {
+			body := g.hclBody(g.nestedAttributes(shape, false), indent+"  ", name, append(stack, shape))
+			if len(body) == 0 {
+				blocks = append(blocks, fmt.Sprintf("%s%s {}", indent, a.Name))
+			} else {
+				blocks = append(blocks, fmt.Sprintf("%s%s {\n%s\n%s}", indent, a.Name, strings.Join(body, "\n"), indent))
+			}
+		}
+	}
+
+	// Align consecutive arguments as terraform fmt does.
+	var width int
+	for _, v := range args {
+		width = max(width, len(v[0]))
+	}
+
+	var lines []string
+	for _, v := range args {
+		lines = append(lines, fmt.Sprintf("%s%-*s = %s", indent, width, v[0], v[1]))
+	}
+	for _, v := range blocks {
+		if len(lines) > 0 {
+			lines = append(lines, "")
+		}
+		lines = append(lines, v)
+	}
+
+	return lines
+}
+
+func sortedAttributes(attributes []*apiAttribute) []*apiAttribute {
+	attributes = slices.Clone(attributes)
+	slices.SortFunc(attributes, func(a, b *apiAttribute) int { return strings.Compare(a.Name, b.Name) })
+
+	return attributes
+}
+
+// test generates the acceptance test configuration and checks.
+func (g *apiGenerator) test(d *APIData, attributes []*apiAttribute) {
+	body := g.hclBody(attributes, "  ", "%[1]q", nil)
+	d.TestConfig = strings.Join(body, "\n")
+	d.TestConfigUsesName = strings.Contains(d.TestConfig, "%[1]q")
+	if strings.Contains(d.TestConfig, "TODO") {
+		g.ambiguity("test and example configuration values marked TODO need real values")
+	}
+
+	var checks []string
+	for _, a := range sortedAttributes(attributes) {
+		switch {
+		case a.Required && isNameAttribute(a):
+			checks = append(checks, fmt.Sprintf("resource.TestCheckResourceAttr(resourceName, %s, rName),", a.Key))
+		case a.Required && a.Field.Type.Kind == apiBool:
+			checks = append(checks, fmt.Sprintf("resource.TestCheckResourceAttr(resourceName, %s, acctest.CtTrue),", a.Key))
+		case a.Required && (a.Field.Type.Kind == apiInt32 || a.Field.Type.Kind == apiInt64):
+			checks = append(checks, fmt.Sprintf("resource.TestCheckResourceAttr(resourceName, %s, acctest.Ct1),", a.Key))
+		case !a.configurable() && apiIsARN(a.Field):
+			checks = append(checks, fmt.Sprintf("resource.TestCheckResourceAttrSet(resourceName, %s),", a.Key))
+		}
+	}
+	if d.TagsAttribute != "" {
+		checks = append(checks, "resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),")
+	}
+	d.TestChecks = strings.Join(checks, "\n")
+
+	imports := map[string]string{
+		"context": "",
+		"fmt":     "",
+		"testing": "",
+		"github.com/hashicorp/terraform-plugin-testing/helper/acctest":                        "sdkacctest",
+		"github.com/hashicorp/terraform-plugin-testing/helper/resource":                       "",
+		"github.com/hashicorp/terraform-plugin-testing/terraform":                             "",
+		"github.com/hashicorp/terraform-provider-aws/internal/acctest":                        "",
+		"github.com/hashicorp/terraform-provider-aws/internal/conns":                          "",
+		"github.com/hashicorp/terraform-provider-aws/internal/service/" + g.td.ServicePackage: "tf" + g.td.ServicePackage,
+		"github.com/hashicorp/terraform-provider-aws/internal/tfresource":                     "",
+		"github.com/hashicorp/terraform-provider-aws/names":                                   "",
+	}
+	if v, ok := strings.CutPrefix(d.ObjectType, "awstypes."); ok {
+		d.TestObjectType = "types." + v
+		imports[awsTypesImport(g.pkg.Name)] = ""
+	} else {
+		d.TestObjectType = d.ObjectType
+		imports["github.com/aws/aws-sdk-go-v2/service/"+g.pkg.Name] = ""
+	}
+	d.TestImports = importSpecs(imports)
+}
+
+// docTODO is the description of every argument and attribute, as API member documentation isn't visible to reflection.
+const docTODO = "TODO: Description."
+
+// docArguments returns the Markdown list items for the arguments and nested blocks of an object,
+// with any nested block sections appended to sections.
+func (g *apiGenerator) docArguments(attributes []*apiAttribute, stack []string, sections *[]string) (required, optional []string) {
+	for _, a := range sortedAttributes(attributes) {
+		if !a.configurable() {
+			continue
+		}
+
+		item := fmt.Sprintf("* `%s` - (%s) %s", a.Name, map[bool]string{true: "Required", false: "Optional"}[a.Required], docTODO)
+		if _, ok := g.scalar(a); !ok {
+			shape, _ := nestedShape(a.Field.Type)
+			if shape == "" || slices.Contains(stack, shape) {
+				continue
+			}
+
+			item += fmt.Sprintf(" See [`%[1]s`](#%[1]s) below.", a.Name)
+
+			var nested []string
+			r, o := g.docArguments(g.nestedAttributes(shape, false), append(stack, shape), &nested)
+			*sections = append(*sections, fmt.Sprintf("### %s\n\n%s", a.Name, strings.Join(append(r, o...), "\n")))
+			*sections = append(*sections, nested...)
+		}
+
+		if a.Required {
+			required = append(required, item)
+		} else {
+			optional = append(optional, item)
+		}
+	}
+
+	return required, optional
+}
+
+// doc generates the website documentation's example, argument, attribute and import sections.
+func (g *apiGenerator) doc(d *APIData, attributes []*apiAttribute, identifiers []*apiField, byField map[string]*apiAttribute) {
+	d.DocExample = strings.Join(g.hclBody(attributes, "  ", `"example"`, nil), "\n")
+
+	var sections []string
+	required, optional := g.docArguments(attributes, nil, &sections)
+	if d.TagsAttribute != "" {
+		optional = append(optional, "* `tags` - (Optional) Map of tags assigned to the resource. If configured with a provider [`default_tags` configuration block](https://registry.terraform.io/providers/hashicorp/aws/latest/docs#default_tags-configuration-block) present, tags with matching keys will overwrite those defined at the provider-level.")
+		slices.Sort(optional)
+	}
+	d.DocRequired = strings.Join(required, "\n")
+	d.DocOptional = strings.Join(optional, "\n")
+	d.DocBlocks = strings.Join(sections, "\n\n")
+
+	var computed []string
+	for _, a := range sortedAttributes(attributes) {
+		if !a.configurable() {
+			computed = append(computed, fmt.Sprintf("* `%s` - %s", a.Name, docTODO))
+		}
+	}
+	if d.TagsAttribute != "" {
+		computed = append(computed, "* `tags_all` - Map of tags assigned to the resource, including those inherited from the provider [`default_tags` configuration block](https://registry.terraform.io/providers/hashicorp/aws/latest/docs#default_tags-configuration-block).")
+	}
+	d.DocAttributes = strings.Join(computed, "\n")
+
+	var ids, attrs []string
+	for _, f := range identifiers {
+		a := byField[f.Name]
+		ids = append(ids, a.Name+"-12345678")
+		attrs = append(attrs, fmt.Sprintf("`%s`", a.Name))
+	}
+	d.DocImportID = strings.Join(ids, ",")
+	d.DocImportAttrs = strings.Join(attrs, " and ")
+	if len(attrs) > 2 {
+		d.DocImportAttrs = strings.Join(attrs[:len(attrs)-1], ", ") + ", and " + attrs[len(attrs)-1]
+	}
+	if len(attrs) > 1 {
+		d.DocImportAttrs += " separated by a comma (`,`)"
+	}
+}
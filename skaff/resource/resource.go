@@ -8,8 +8,10 @@ import (
 	_ "embed"
 	"errors"
 	"fmt"
+	"go/format"
 	"io/fs"
 	"os"
+	"path"
 	"path/filepath"
 	"strings"
 	"text/template"
@@ -30,6 +32,18 @@ var resourceTestTmpl string
 //go:embed websitedoc.tmpl
 var websiteTmpl string
 
+//go:embed resourceapi.tmpl
+var resourceAPITmpl string
+
+//go:embed resourceapitest.tmpl
+var resourceAPITestTmpl string
+
+//go:embed websitedocapi.tmpl
+var websiteAPITmpl string
+
+//go:embed sweepapi.tmpl
+var sweepAPITmpl string
+
 type TemplateData struct {
 	Resource             string
 	ResourceLower        string
@@ -45,9 +59,10 @@ type TemplateData struct {
 	PluginFramework      bool
 	HumanResourceName    string
 	ProviderResourceName string
+	API                  *APIData
 }
 
-func Create(resName, snakeName string, comments, force, v2, pluginFramework, tags bool) error {
+func Create(resName, snakeName string, comments, force, v2, pluginFramework, tags bool, fromAPI string) error {
 	wd, err := os.Getwd() // os.Getenv("GOPACKAGE") not available since this is not run with go generate
 	if err != nil {
 		return fmt.Errorf("error reading working directory: %s", err)
@@ -55,6 +70,27 @@ func Create(resName, snakeName string, comments, force, v2, pluginFramework, tag
 
 	servicePackage := filepath.Base(wd)
 
+	var sdkPackage, createOperation string
+	if fromAPI != "" {
+		var ok bool
+		if sdkPackage, createOperation, ok = strings.Cut(fromAPI, "."); !ok || sdkPackage == "" || createOperation == "" {
+			return fmt.Errorf("error checking: API operation should be given as <Service>.<CreateOperation> (e.g., osis.CreatePipeline)")
+		}
+
+		if !v2 || !pluginFramework {
+			return fmt.Errorf("error checking: resources generated from the API model use AWS Go SDK v2 and Terraform Plugin Framework")
+		}
+
+		if resName == "" {
+			for _, verb := range apiCreateVerbs {
+				if v, ok := strings.CutPrefix(createOperation, verb); ok {
+					resName = v
+					break
+				}
+			}
+		}
+	}
+
 	if resName == "" {
 		return fmt.Errorf("error checking: no name given")
 	}
@@ -101,6 +137,10 @@ func Create(resName, snakeName string, comments, force, v2, pluginFramework, tag
 		ProviderResourceName: convert.ToProviderResourceName(servicePackage, snakeName),
 	}
 
+	if fromAPI != "" {
+		return createFromAPI(sdkPackage, createOperation, force, templateData)
+	}
+
 	tmpl := resourceTmpl
 	if pluginFramework {
 		tmpl = resourceFrameworkTmpl
@@ -124,6 +164,64 @@ func Create(resName, snakeName string, comments, force, v2, pluginFramework, tag
 	return nil
 }
 
+// createFromAPI generates a resource, its test, sweeper and website documentation from the AWS SDK for Go v2 API model.
+func createFromAPI(sdkPackage, createOperation string, force bool, templateData TemplateData) error {
+	client, err := sdkClientType(templateData.Service)
+	if err != nil {
+		return fmt.Errorf("error reading AWS API model: %w", err)
+	}
+
+	if v := path.Base(client.Elem().PkgPath()); v != sdkPackage {
+		return fmt.Errorf("error checking: the %s client is in AWS SDK for Go v2 package %s, not %s", templateData.Service, v, sdkPackage)
+	}
+
+	pkg, err := newAPIPackage(client)
+	if err != nil {
+		return fmt.Errorf("error reading AWS API model: %w", err)
+	}
+
+	// names.Attr constants are used where they exist; without them attribute names are string literals.
+	attrConsts, _ := readAttrConsts()
+
+	api, err := newAPIData(pkg, createOperation, &templateData, attrConsts)
+	if err != nil {
+		return fmt.Errorf("error reading AWS API model: %w", err)
+	}
+	templateData.API = api
+	templateData.IncludeTags = api.TagsAttribute != ""
+
+	snakeName := templateData.ResourceSnake
+	if err = writeTemplate("newres", fmt.Sprintf("%s.go", snakeName), resourceAPITmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing resource template: %w", err)
+	}
+
+	if err = writeTemplate("restest", fmt.Sprintf("%s_test.go", snakeName), resourceAPITestTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing resource test template: %w", err)
+	}
+
+	wf := fmt.Sprintf("%s_%s.html.markdown", templateData.ServicePackage, snakeName)
+	wf = filepath.Join("..", "..", "..", "website", "docs", "r", wf)
+	if err = writeTemplate("webdoc", wf, websiteAPITmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing resource website doc template: %w", err)
+	}
+
+	if err = writeExports(templateData); err != nil {
+		return fmt.Errorf("writing test exports: %w", err)
+	}
+
+	if api.Sweep != nil {
+		if err = writeSweeper(templateData); err != nil {
+			return fmt.Errorf("writing sweeper: %w", err)
+		}
+	}
+
+	for _, v := range api.Ambiguities {
+		fmt.Printf("TODO: %s\n", v)
+	}
+
+	return nil
+}
+
 func writeTemplate(templateName, filename, tmpl string, force bool, td TemplateData) error {
 	if _, err := os.Stat(filename); !errors.Is(err, fs.ErrNotExist) && !force {
 		return fmt.Errorf("file (%s) already exists and force is not set", filename)
@@ -145,6 +243,19 @@ func writeTemplate(templateName, filename, tmpl string, force bool, td TemplateD
 		return fmt.Errorf("error executing template: %s", err)
 	}
 
+	// Code generated from the API model has no instructional comments to preserve and is formatted.
+	if td.API != nil && filepath.Ext(filename) == ".go" {
+		contents, err := format.Source(buffer.Bytes())
+		if err != nil {
+			f.Write(buffer.Bytes()) // ignore error; formatting error takes precedence
+			f.Close()
+			return fmt.Errorf("error formatting generated file (%s): %s", filename, err)
+		}
+
+		buffer.Reset()
+		buffer.Write(contents)
+	}
+
 	//contents, err := format.Source(buffer.Bytes())
 	//if err != nil {
 	//	return fmt.Errorf("error formatting generated file: %s", err)
@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package widget is a minimal AWS SDK for Go v2 service client for testing API introspection.
+package widget
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+)
+
+// Client provides the API client to make operations call for the Widget service.
+type Client struct{}
+
+// Options are the client's operation options.
+type Options struct {
+	// Set of options to modify how an operation is invoked.
+	APIOptions []func(*middleware.Stack) error
+}
+
+// invokeOperation validates the operation's input and runs its middleware stack. No request is sent.
+func (c *Client) invokeOperation(ctx context.Context, opID string, params any, optFns []func(*Options), validate func(any) error) (any, error) {
+	var options Options
+	for _, fn := range optFns {
+		fn(&options)
+	}
+
+	stack := middleware.NewStack(opID, func() any { return nil })
+	err := stack.Initialize.Add(middleware.InitializeMiddlewareFunc("OperationInputValidation", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+		if validate != nil {
+			if err := validate(in.Parameters); err != nil {
+				return middleware.InitializeOutput{}, middleware.Metadata{}, err
+			}
+		}
+
+		return next.HandleInitialize(ctx, in)
+	}), middleware.After)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, fn := range options.APIOptions {
+		if err := fn(stack); err != nil {
+			return nil, err
+		}
+	}
+
+	handler := middleware.DecorateHandler(middleware.HandlerFunc(func(context.Context, any) (any, middleware.Metadata, error) {
+		return nil, middleware.Metadata{}, errors.New("not implemented")
+	}), stack)
+	result, _, err := handler.Handle(ctx, params)
+	if err != nil {
+		return nil, &smithy.OperationError{
+			ServiceID:     "Widget",
+			OperationName: opID,
+			Err:           err,
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package widget
+
+import (
+	"context"
+
+	"github.com/aws/smithy-go/document"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-provider-aws/skaff/resource/testdata/widget/types"
+)
+
+// Creates a widget.
+func (c *Client) CreateWidget(ctx context.Context, params *CreateWidgetInput, optFns ...func(*Options)) (*CreateWidgetOutput, error) {
+	result, err := c.invokeOperation(ctx, "CreateWidget", params, optFns, validateOpCreateWidgetInput)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(*CreateWidgetOutput), nil
+}
+
+type CreateWidgetInput struct {
+
+	// The name of the widget.
+	//
+	// This member is required.
+	WidgetName *string
+
+	// The size of the widget, in units.
+	//
+	// This member is required.
+	Size *int32
+
+	// Unique, case-sensitive identifier that ensures idempotency.
+	ClientToken *string
+
+	// A description of the widget.
+	Description *string
+
+	// The widget's settings.
+	Settings *types.WidgetSettings
+
+	// Tags to assign to the widget.
+	Tags map[string]string
+
+	document.NoSerde
+}
+
+type CreateWidgetOutput struct {
+
+	// The widget.
+	Widget *types.Widget
+
+	// Metadata pertaining to the operation's result.
+	ResultMetadata middleware.Metadata
+
+	document.NoSerde
+}
@@ -0,0 +1,34 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package widget
+
+import (
+	"context"
+
+	"github.com/aws/smithy-go/document"
+)
+
+// Deletes a widget.
+func (c *Client) DeleteWidget(ctx context.Context, params *DeleteWidgetInput, optFns ...func(*Options)) (*DeleteWidgetOutput, error) {
+	result, err := c.invokeOperation(ctx, "DeleteWidget", params, optFns, validateOpDeleteWidgetInput)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(*DeleteWidgetOutput), nil
+}
+
+type DeleteWidgetInput struct {
+
+	// The name of the widget.
+	//
+	// This member is required.
+	WidgetName *string
+
+	document.NoSerde
+}
+
+type DeleteWidgetOutput struct {
+	document.NoSerde
+}
@@ -0,0 +1,39 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package widget
+
+import (
+	"context"
+
+	"github.com/aws/smithy-go/document"
+	"github.com/hashicorp/terraform-provider-aws/skaff/resource/testdata/widget/types"
+)
+
+// Returns a widget.
+func (c *Client) GetWidget(ctx context.Context, params *GetWidgetInput, optFns ...func(*Options)) (*GetWidgetOutput, error) {
+	result, err := c.invokeOperation(ctx, "GetWidget", params, optFns, validateOpGetWidgetInput)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(*GetWidgetOutput), nil
+}
+
+type GetWidgetInput struct {
+
+	// The name of the widget.
+	//
+	// This member is required.
+	WidgetName *string
+
+	document.NoSerde
+}
+
+type GetWidgetOutput struct {
+
+	// The widget.
+	Widget *types.Widget
+
+	document.NoSerde
+}
@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package widget
+
+import (
+	"context"
+
+	"github.com/aws/smithy-go/document"
+	"github.com/hashicorp/terraform-provider-aws/skaff/resource/testdata/widget/types"
+)
+
+// Lists widgets.
+func (c *Client) ListWidgets(ctx context.Context, params *ListWidgetsInput, optFns ...func(*Options)) (*ListWidgetsOutput, error) {
+	result, err := c.invokeOperation(ctx, "ListWidgets", params, optFns, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(*ListWidgetsOutput), nil
+}
+
+type ListWidgetsInput struct {
+
+	// The maximum number of results to return.
+	MaxResults *int32
+
+	// The token for the next page of results.
+	NextToken *string
+
+	document.NoSerde
+}
+
+type ListWidgetsOutput struct {
+
+	// The token for the next page of results.
+	NextToken *string
+
+	// The widgets.
+	Widgets []types.WidgetSummary
+
+	document.NoSerde
+}
@@ -0,0 +1,40 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package widget
+
+import (
+	"context"
+
+	"github.com/aws/smithy-go/document"
+)
+
+// Updates a widget.
+func (c *Client) UpdateWidget(ctx context.Context, params *UpdateWidgetInput, optFns ...func(*Options)) (*UpdateWidgetOutput, error) {
+	result, err := c.invokeOperation(ctx, "UpdateWidget", params, optFns, validateOpUpdateWidgetInput)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(*UpdateWidgetOutput), nil
+}
+
+type UpdateWidgetInput struct {
+
+	// The name of the widget.
+	//
+	// This member is required.
+	WidgetName *string
+
+	// A description of the widget.
+	Description *string
+
+	// The size of the widget, in units.
+	Size *int32
+
+	document.NoSerde
+}
+
+type UpdateWidgetOutput struct {
+	document.NoSerde
+}
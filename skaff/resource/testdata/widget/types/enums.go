@@ -0,0 +1,26 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+type WidgetStatus string
+
+// Enum values for WidgetStatus
+const (
+	WidgetStatusCreating WidgetStatus = "CREATING"
+	WidgetStatusActive   WidgetStatus = "ACTIVE"
+	WidgetStatusUpdating WidgetStatus = "UPDATING"
+	WidgetStatusDeleting WidgetStatus = "DELETING"
+	WidgetStatusFailed   WidgetStatus = "FAILED"
+)
+
+// Values returns all known values for WidgetStatus.
+func (WidgetStatus) Values() []WidgetStatus {
+	return []WidgetStatus{
+		"CREATING",
+		"ACTIVE",
+		"UPDATING",
+		"DELETING",
+		"FAILED",
+	}
+}
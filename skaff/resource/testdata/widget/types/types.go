@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package types contains the Widget service's shapes.
+package types
+
+import (
+	"time"
+
+	"github.com/aws/smithy-go/document"
+)
+
+// A widget.
+type Widget struct {
+
+	// The time the widget was created.
+	CreatedAt *time.Time
+
+	// A description of the widget.
+	Description *string
+
+	// The settings of the widget.
+	Settings *WidgetSettings
+
+	// The size of the widget, in units.
+	Size *int32
+
+	// The status of the widget.
+	Status WidgetStatus
+
+	// The Amazon Resource Name (ARN) of the widget.
+	WidgetArn *string
+
+	// The name of the widget.
+	WidgetName *string
+
+	document.NoSerde
+}
+
+// Settings for a widget.
+type WidgetSettings struct {
+
+	// Whether the widget is enabled.
+	//
+	// This member is required.
+	Enabled *bool
+
+	// The ARN of the KMS key used to encrypt the widget.
+	KmsKeyArn *string
+
+	document.NoSerde
+}
+
+// Summary information about a widget.
+type WidgetSummary struct {
+
+	// The Amazon Resource Name (ARN) of the widget.
+	WidgetArn *string
+
+	// The name of the widget.
+	WidgetName *string
+
+	document.NoSerde
+}
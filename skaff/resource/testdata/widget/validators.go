@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package widget
+
+import (
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-provider-aws/skaff/resource/testdata/widget/types"
+)
+
+func validateWidgetSettings(v *types.WidgetSettings) error {
+	if v == nil {
+		return nil
+	}
+	invalidParams := smithy.InvalidParamsError{Context: "WidgetSettings"}
+	if v.Enabled == nil {
+		invalidParams.Add(smithy.NewErrParamRequired("Enabled"))
+	}
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+func validateOpCreateWidgetInput(params any) error {
+	v := params.(*CreateWidgetInput)
+	invalidParams := smithy.InvalidParamsError{Context: "CreateWidgetInput"}
+	if v.WidgetName == nil {
+		invalidParams.Add(smithy.NewErrParamRequired("WidgetName"))
+	}
+	if v.Size == nil {
+		invalidParams.Add(smithy.NewErrParamRequired("Size"))
+	}
+	if v.Settings != nil {
+		if err := validateWidgetSettings(v.Settings); err != nil {
+			invalidParams.AddNested("Settings", err.(smithy.InvalidParamsError))
+		}
+	}
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+func validateOpDeleteWidgetInput(params any) error {
+	v := params.(*DeleteWidgetInput)
+	invalidParams := smithy.InvalidParamsError{Context: "DeleteWidgetInput"}
+	if v.WidgetName == nil {
+		invalidParams.Add(smithy.NewErrParamRequired("WidgetName"))
+	}
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+func validateOpGetWidgetInput(params any) error {
+	v := params.(*GetWidgetInput)
+	invalidParams := smithy.InvalidParamsError{Context: "GetWidgetInput"}
+	if v.WidgetName == nil {
+		invalidParams.Add(smithy.NewErrParamRequired("WidgetName"))
+	}
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
+
+func validateOpUpdateWidgetInput(params any) error {
+	v := params.(*UpdateWidgetInput)
+	invalidParams := smithy.InvalidParamsError{Context: "UpdateWidgetInput"}
+	if v.WidgetName == nil {
+		invalidParams.Add(smithy.NewErrParamRequired("WidgetName"))
+	}
+	if invalidParams.Len() > 0 {
+		return invalidParams
+	}
+	return nil
+}
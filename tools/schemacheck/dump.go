@@ -0,0 +1,318 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/hashicorp/terraform-provider-aws/tools/schemacheck/snapshot"
+)
+
+// dump returns a snapshot of the provider's resource and data source schemas.
+//
+// Schemas are read through the muxed provider server so that Plugin SDK and Plugin Framework
+// implementations are described identically. ForceNew, which is not part of the protocol schema,
+// is read from the Plugin SDK schema or from the Plugin Framework RequiresReplace plan modifiers.
+func dump(ctx context.Context) (*snapshot.Snapshot, error) {
+	factory, primary, err := provider.ProtoV5ProviderServerFactory(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := factory().GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := diagnosticsError(response.Diagnostics); err != nil {
+		return nil, err
+	}
+
+	fwForceNew, err := frameworkForceNew(ctx, primary)
+
+	if err != nil {
+		return nil, err
+	}
+
+	s := snapshot.New()
+
+	for name, v := range response.DataSourceSchemas {
+		_, sdk := primary.DataSourcesMap[name]
+		s.DataSources[name] = &snapshot.Schema{
+			Framework: !sdk,
+			Version:   v.Version,
+			Block:     block(v.Block, nil),
+		}
+	}
+
+	for name, v := range response.ResourceSchemas {
+		var forceNew func([]string) bool
+		r, sdk := primary.ResourcesMap[name]
+
+		if sdk {
+			forceNew = func(path []string) bool {
+				return sdkForceNew(r.Schema, path)
+			}
+		} else {
+			paths := fwForceNew[name]
+			forceNew = func(path []string) bool {
+				return paths[strings.Join(path, ".")]
+			}
+		}
+
+		s.Resources[name] = &snapshot.Schema{
+			Framework: !sdk,
+			Version:   v.Version,
+			Block:     block(v.Block, forceNew),
+		}
+	}
+
+	return s, nil
+}
+
+// block returns the snapshot of a protocol schema block.
+// forceNew reports whether the attribute or nested block at the specified path forces replacement.
+func block(b *tfprotov5.SchemaBlock, forceNew func([]string) bool, path ...string) *snapshot.Block {
+	if forceNew == nil {
+		forceNew = func([]string) bool { return false }
+	}
+
+	result := &snapshot.Block{}
+
+	if b == nil {
+		return result
+	}
+
+	for _, v := range b.Attributes {
+		if result.Attributes == nil {
+			result.Attributes = make(map[string]*snapshot.Attribute)
+		}
+
+		path := append(path[:len(path):len(path)], v.Name)
+		result.Attributes[v.Name] = &snapshot.Attribute{
+			Type:       typeString(v.Type),
+			Required:   v.Required,
+			Optional:   v.Optional,
+			Computed:   v.Computed,
+			ForceNew:   forceNew(path),
+			Sensitive:  v.Sensitive,
+			Deprecated: v.Deprecated,
+		}
+	}
+
+	for _, v := range b.BlockTypes {
+		if result.Blocks == nil {
+			result.Blocks = make(map[string]*snapshot.NestedBlock)
+		}
+
+		path := append(path[:len(path):len(path)], v.TypeName)
+		result.Blocks[v.TypeName] = &snapshot.NestedBlock{
+			Block:    *block(v.Block, forceNew, path...),
+			Nesting:  nesting(v.Nesting),
+			MinItems: v.MinItems,
+			MaxItems: v.MaxItems,
+			ForceNew: forceNew(path),
+		}
+	}
+
+	return result
+}
+
+func nesting(mode tfprotov5.SchemaNestedBlockNestingMode) string {
+	switch mode {
+	case tfprotov5.SchemaNestedBlockNestingModeSingle:
+		return snapshot.NestingSingle
+	case tfprotov5.SchemaNestedBlockNestingModeList:
+		return snapshot.NestingList
+	case tfprotov5.SchemaNestedBlockNestingModeSet:
+		return snapshot.NestingSet
+	case tfprotov5.SchemaNestedBlockNestingModeMap:
+		return snapshot.NestingMap
+	case tfprotov5.SchemaNestedBlockNestingModeGroup:
+		return snapshot.NestingGroup
+	default:
+		return mode.String()
+	}
+}
+
+// typeString returns the Terraform type constraint syntax for a value type, e.g. list(string).
+func typeString(typ tftypes.Type) string {
+	switch typ := typ.(type) {
+	case tftypes.List:
+		return fmt.Sprintf("list(%s)", typeString(typ.ElementType))
+	case tftypes.Set:
+		return fmt.Sprintf("set(%s)", typeString(typ.ElementType))
+	case tftypes.Map:
+		return fmt.Sprintf("map(%s)", typeString(typ.ElementType))
+	case tftypes.Object:
+		names := make([]string, 0, len(typ.AttributeTypes))
+		for name := range typ.AttributeTypes {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		attributes := make([]string, 0, len(names))
+		for _, name := range names {
+			attributes = append(attributes, fmt.Sprintf("%s=%s", name, typeString(typ.AttributeTypes[name])))
+		}
+
+		return fmt.Sprintf("object({%s})", strings.Join(attributes, ","))
+	case tftypes.Tuple:
+		elements := make([]string, 0, len(typ.ElementTypes))
+		for _, v := range typ.ElementTypes {
+			elements = append(elements, typeString(v))
+		}
+
+		return fmt.Sprintf("tuple([%s])", strings.Join(elements, ","))
+	}
+
+	switch {
+	case typ.Is(tftypes.String):
+		return "string"
+	case typ.Is(tftypes.Number):
+		return "number"
+	case typ.Is(tftypes.Bool):
+		return "bool"
+	case typ.Is(tftypes.DynamicPseudoType):
+		return "any"
+	}
+
+	return typ.String()
+}
+
+// sdkForceNew returns whether the Plugin SDK attribute or block at the specified path is ForceNew.
+func sdkForceNew(schema map[string]*sdkschema.Schema, path []string) bool {
+	v, ok := schema[path[0]]
+
+	if !ok {
+		return false
+	}
+
+	if len(path) == 1 {
+		return v.ForceNew
+	}
+
+	if elem, ok := v.Elem.(*sdkschema.Resource); ok {
+		return sdkForceNew(elem.Schema, path[1:])
+	}
+
+	return false
+}
+
+// frameworkForceNew returns the paths of each Plugin Framework resource's attributes and blocks
+// that have a RequiresReplace plan modifier, keyed by resource type name.
+func frameworkForceNew(ctx context.Context, primary *sdkschema.Provider) (map[string]map[string]bool, error) {
+	meta, ok := primary.Meta().(*conns.AWSClient)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected provider meta: %T", primary.Meta())
+	}
+
+	result := make(map[string]map[string]bool)
+
+	for _, sp := range meta.ServicePackages {
+		for _, v := range sp.FrameworkResources(ctx) {
+			r, err := v.Factory(ctx)
+
+			if err != nil {
+				return nil, fmt.Errorf("creating resource: %w", err)
+			}
+
+			metadataResponse := resource.MetadataResponse{}
+			r.Metadata(ctx, resource.MetadataRequest{}, &metadataResponse)
+
+			schemaResponse := resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+
+			paths := make(map[string]bool)
+			requiresReplace(reflect.ValueOf(schemaResponse.Schema), "", paths)
+			result[metadataResponse.TypeName] = paths
+		}
+	}
+
+	return result, nil
+}
+
+// requiresReplace adds the paths of any attributes and blocks within the Plugin Framework schema, nested block
+// or nested object v that have a RequiresReplace plan modifier.
+// Reflection is used because the schema types do not share an interface for plan modifiers.
+func requiresReplace(v reflect.Value, prefix string, paths map[string]bool) {
+	if v.Kind() != reflect.Struct {
+		return
+	}
+
+	for _, field := range []string{"Attributes", "Blocks"} {
+		children := v.FieldByName(field)
+
+		if !children.IsValid() || children.Kind() != reflect.Map {
+			continue
+		}
+
+		iter := children.MapRange()
+		for iter.Next() {
+			path := prefix + iter.Key().String()
+			child := iter.Value().Elem()
+
+			if hasRequiresReplace(child) {
+				paths[path] = true
+			}
+
+			if nested := child.FieldByName("NestedObject"); nested.IsValid() {
+				requiresReplace(nested, path+".", paths)
+			} else {
+				requiresReplace(child, path+".", paths)
+			}
+		}
+	}
+}
+
+func hasRequiresReplace(v reflect.Value) bool {
+	if v.Kind() != reflect.Struct {
+		return false
+	}
+
+	planModifiers := v.FieldByName("PlanModifiers")
+
+	if !planModifiers.IsValid() || planModifiers.Kind() != reflect.Slice {
+		return false
+	}
+
+	for i := 0; i < planModifiers.Len(); i++ {
+		// The framework's RequiresReplace, RequiresReplaceIf and RequiresReplaceIfConfigured plan modifiers
+		// are all implemented by an unexported requiresReplaceIfModifier type.
+		if strings.Contains(fmt.Sprintf("%T", planModifiers.Index(i).Interface()), "requiresReplace") {
+			return true
+		}
+	}
+
+	return false
+}
+
+func diagnosticsError(diags []*tfprotov5.Diagnostic) error {
+	var errs []string
+
+	for _, v := range diags {
+		if v.Severity == tfprotov5.DiagnosticSeverityError {
+			errs = append(errs, fmt.Sprintf("%s: %s", v.Summary, v.Detail))
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("reading provider schema: %s", strings.Join(errs, "; "))
+	}
+
+	return nil
+}
@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/hashicorp/terraform-provider-aws/tools/schemacheck/snapshot"
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tschemacheck dump [-o <snapshot-file>]\n")
+	fmt.Fprintf(os.Stderr, "\tschemacheck diff [-allowlist <allowlist-file>] [-safe] <old-snapshot-file> <new-snapshot-file>\n\n")
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+
+	if flag.NArg() < 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	var err error
+
+	switch command, args := flag.Arg(0), flag.Args()[1:]; command {
+	case "dump":
+		err = dumpCommand(args)
+	case "diff":
+		err = diffCommand(args)
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+func dumpCommand(args []string) error {
+	flags := flag.NewFlagSet("dump", flag.ExitOnError)
+	output := flags.String("o", "-", "Path of the snapshot file, or - for standard output")
+	flags.Parse(args) //nolint:errcheck // ExitOnError
+
+	s, err := dump(context.Background())
+
+	if err != nil {
+		return err
+	}
+
+	var w io.Writer = os.Stdout
+
+	if *output != "-" {
+		f, err := os.Create(*output)
+
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		w = f
+	}
+
+	return s.Encode(w)
+}
+
+// errBreakingChanges is returned when there are breaking changes that are not allowlisted.
+var errBreakingChanges = errors.New("breaking schema changes found")
+
+func diffCommand(args []string) error {
+	flags := flag.NewFlagSet("diff", flag.ExitOnError)
+	allowlistFile := flags.String("allowlist", "", "Path of the allowlist of intentional breaking changes")
+	showSafe := flags.Bool("safe", false, "Also list changes that are not breaking")
+	flags.Parse(args) //nolint:errcheck // ExitOnError
+
+	if flags.NArg() != 2 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	old, err := snapshot.Read(flags.Arg(0))
+
+	if err != nil {
+		return err
+	}
+
+	new, err := snapshot.Read(flags.Arg(1))
+
+	if err != nil {
+		return err
+	}
+
+	var allowlist *snapshot.Allowlist
+
+	if *allowlistFile != "" {
+		allowlist, err = snapshot.ReadAllowlist(*allowlistFile)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	var breaking, allowed, safe int
+
+	for _, c := range snapshot.Diff(old, new) {
+		if !c.Breaking {
+			safe++
+			if *showSafe {
+				fmt.Printf("SAFE     %s\n", c)
+			}
+			continue
+		}
+
+		if reason, ok := allowlist.Allows(c); ok {
+			allowed++
+			if reason != "" {
+				fmt.Printf("ALLOWED  %s (%s)\n", c, reason)
+			} else {
+				fmt.Printf("ALLOWED  %s\n", c)
+			}
+			continue
+		}
+
+		breaking++
+		fmt.Printf("BREAKING %s\n", c)
+	}
+
+	for _, v := range allowlist.Unused() {
+		fmt.Fprintf(os.Stderr, "warning: allowlist entry %s matches no breaking change\n", v)
+	}
+
+	fmt.Fprintf(os.Stderr, "Changes: %d breaking, %d allowed, %d safe\n", breaking, allowed, safe)
+
+	if breaking > 0 {
+		return errBreakingChanges
+	}
+
+	return nil
+}
@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package snapshot
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+)
+
+// Allowlist is a set of intentional breaking changes.
+//
+// Each non-blank line of an allowlist file holds an address followed by an optional reason.
+// Lines starting with # are comments. An entry allows breaking changes to the addressed
+// resource, data source, attribute or block and to anything nested within it.
+//
+//	# Removed in v6.0.0.
+//	aws_example_widget.legacy_setting  See https://github.com/hashicorp/terraform-provider-aws/issues/12345
+type Allowlist struct {
+	entries map[string]string // Reason, keyed by address.
+	used    map[string]bool
+}
+
+// ReadAllowlist reads an allowlist from the named file.
+func ReadAllowlist(filename string) (*Allowlist, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	a, err := ParseAllowlist(f)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", filename, err)
+	}
+
+	return a, nil
+}
+
+// ParseAllowlist parses an allowlist.
+func ParseAllowlist(r io.Reader) (*Allowlist, error) {
+	a := &Allowlist{
+		entries: make(map[string]string),
+		used:    make(map[string]bool),
+	}
+
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		address, reason := text, ""
+		if i := strings.IndexAny(text, " \t"); i >= 0 {
+			address, reason = text[:i], text[i+1:]
+		}
+		if _, ok := a.entries[address]; ok {
+			return nil, fmt.Errorf("line %d: duplicate entry: %s", line, address)
+		}
+
+		a.entries[address] = strings.TrimSpace(reason)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// Allows returns whether the breaking change is allowed and, if so, the reason given.
+func (a *Allowlist) Allows(c Change) (string, bool) {
+	if a == nil {
+		return "", false
+	}
+
+	for address := c.Address; ; {
+		if reason, ok := a.entries[address]; ok {
+			a.used[address] = true
+			return reason, true
+		}
+
+		i := strings.LastIndex(address, ".")
+		if i < 0 {
+			return "", false
+		}
+		address = address[:i]
+	}
+}
+
+// Unused returns the addresses of entries that have allowed no change, in order.
+func (a *Allowlist) Unused() []string {
+	if a == nil {
+		return nil
+	}
+
+	var addresses []string
+	for address := range a.entries {
+		if !a.used[address] {
+			addresses = append(addresses, address)
+		}
+	}
+	sort.Strings(addresses)
+
+	return addresses
+}
@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package snapshot_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/tools/schemacheck/snapshot"
+)
+
+func TestAllowlist(t *testing.T) {
+	t.Parallel()
+
+	allowlist, err := snapshot.ParseAllowlist(strings.NewReader(`
+# Intentional breaking changes.
+aws_example_widget.name	Renamed upstream.
+aws_example_gadget
+data.aws_example_widget.size
+aws_example_unused
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		Address        string
+		ExpectedOK     bool
+		ExpectedReason string
+	}{
+		{Address: "aws_example_widget.name", ExpectedOK: true, ExpectedReason: "Renamed upstream."},
+		{Address: "aws_example_widget.description", ExpectedOK: false},
+		{Address: "aws_example_widget", ExpectedOK: false},
+		{Address: "aws_example_gadget", ExpectedOK: true},
+		{Address: "aws_example_gadget.rule.priority", ExpectedOK: true},
+		{Address: "aws_example_gadgets", ExpectedOK: false},
+		{Address: "data.aws_example_widget.size", ExpectedOK: true},
+		{Address: "aws_example_widget.size", ExpectedOK: false},
+	}
+
+	for _, testCase := range testCases {
+		reason, ok := allowlist.Allows(snapshot.Change{Address: testCase.Address, Breaking: true})
+
+		if ok != testCase.ExpectedOK {
+			t.Errorf("%s: got allowed %t, want %t", testCase.Address, ok, testCase.ExpectedOK)
+		}
+		if reason != testCase.ExpectedReason {
+			t.Errorf("%s: got reason %q, want %q", testCase.Address, reason, testCase.ExpectedReason)
+		}
+	}
+
+	if got, want := strings.Join(allowlist.Unused(), ","), "aws_example_unused"; got != want {
+		t.Errorf("unused: got %q, want %q", got, want)
+	}
+}
+
+func TestParseAllowlistDuplicate(t *testing.T) {
+	t.Parallel()
+
+	_, err := snapshot.ParseAllowlist(strings.NewReader("aws_example_widget\naws_example_widget\n"))
+
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
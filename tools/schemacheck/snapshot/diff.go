@@ -0,0 +1,235 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package snapshot
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Change is a single difference between two snapshots.
+type Change struct {
+	// Address identifies the changed resource, data source, attribute or block,
+	// e.g. aws_instance, aws_instance.ebs_block_device.volume_size or data.aws_ami.owners.
+	Address  string
+	Breaking bool
+	Message  string
+}
+
+func (c Change) String() string {
+	return fmt.Sprintf("%s: %s", c.Address, c.Message)
+}
+
+// Diff returns the changes from the old to the new snapshot, ordered by address.
+//
+// A change is breaking if a configuration or state that is valid under the old schema
+// may be rejected by, or cause resource replacement under, the new schema.
+func Diff(old, new *Snapshot) []Change {
+	d := &differ{}
+
+	d.schemas("data.", old.DataSources, new.DataSources)
+	d.schemas("", old.Resources, new.Resources)
+
+	sort.SliceStable(d.changes, func(i, j int) bool {
+		return d.changes[i].Address < d.changes[j].Address
+	})
+
+	return d.changes
+}
+
+type differ struct {
+	changes []Change
+}
+
+func (d *differ) breaking(address, format string, a ...any) {
+	d.changes = append(d.changes, Change{Address: address, Breaking: true, Message: fmt.Sprintf(format, a...)})
+}
+
+func (d *differ) safe(address, format string, a ...any) {
+	d.changes = append(d.changes, Change{Address: address, Message: fmt.Sprintf(format, a...)})
+}
+
+func (d *differ) schemas(prefix string, old, new map[string]*Schema) {
+	for _, name := range keys(old, new) {
+		address := prefix + name
+		o, n := old[name], new[name]
+
+		switch {
+		case n == nil:
+			d.breaking(address, "removed")
+		case o == nil:
+			d.safe(address, "added")
+		default:
+			if o.Framework != n.Framework {
+				d.safe(address, "migrated to %s", implementation(n.Framework))
+			}
+
+			switch {
+			case n.Version < o.Version:
+				d.breaking(address, "schema version decreased from %d to %d", o.Version, n.Version)
+			case n.Version > o.Version:
+				d.safe(address, "schema version increased from %d to %d", o.Version, n.Version)
+			}
+
+			d.block(address, o.Block, n.Block)
+		}
+	}
+}
+
+func (d *differ) block(address string, old, new *Block) {
+	if old == nil {
+		old = &Block{}
+	}
+	if new == nil {
+		new = &Block{}
+	}
+
+	for _, name := range keys(old.Attributes, new.Attributes) {
+		address := address + "." + name
+		o, n := old.Attributes[name], new.Attributes[name]
+
+		switch {
+		case n == nil:
+			if _, ok := new.Blocks[name]; ok {
+				d.breaking(address, "changed from an attribute to a block")
+				continue
+			}
+			d.breaking(address, "removed")
+		case o == nil:
+			if _, ok := old.Blocks[name]; ok {
+				d.breaking(address, "changed from a block to an attribute")
+				continue
+			}
+			if n.Required {
+				d.breaking(address, "added as a required attribute")
+				continue
+			}
+			d.safe(address, "added")
+		default:
+			d.attribute(address, o, n)
+		}
+	}
+
+	for _, name := range keys(old.Blocks, new.Blocks) {
+		address := address + "." + name
+		o, n := old.Blocks[name], new.Blocks[name]
+
+		switch {
+		case n == nil:
+			if _, ok := new.Attributes[name]; !ok {
+				d.breaking(address, "removed")
+			}
+		case o == nil:
+			if _, ok := old.Attributes[name]; ok {
+				continue
+			}
+			if n.MinItems > 0 {
+				d.breaking(address, "added as a required block")
+				continue
+			}
+			d.safe(address, "added")
+		default:
+			d.nestedBlock(address, o, n)
+		}
+	}
+}
+
+func (d *differ) attribute(address string, old, new *Attribute) {
+	if old.Type != new.Type {
+		d.breaking(address, "type changed from %s to %s", old.Type, new.Type)
+	}
+
+	switch oldConfigurable, newConfigurable := old.Required || old.Optional, new.Required || new.Optional; {
+	case oldConfigurable && !newConfigurable:
+		d.breaking(address, "no longer configurable")
+	case !oldConfigurable && newConfigurable:
+		d.safe(address, "now configurable")
+	case !old.Required && new.Required:
+		d.breaking(address, "changed from Optional to Required")
+	case old.Required && !new.Required:
+		d.safe(address, "changed from Required to Optional")
+	}
+
+	if old.Computed && !new.Computed && new.Optional {
+		d.breaking(address, "no longer Computed")
+	} else if !old.Computed && new.Computed && new.Optional {
+		d.safe(address, "now Computed")
+	}
+
+	d.forceNew(address, old.ForceNew, new.ForceNew)
+
+	if !old.Sensitive && new.Sensitive {
+		d.safe(address, "now Sensitive")
+	} else if old.Sensitive && !new.Sensitive {
+		d.safe(address, "no longer Sensitive")
+	}
+
+	if !old.Deprecated && new.Deprecated {
+		d.safe(address, "deprecated")
+	}
+}
+
+func (d *differ) nestedBlock(address string, old, new *NestedBlock) {
+	if old.Nesting != new.Nesting {
+		d.breaking(address, "nesting changed from %s to %s", old.Nesting, new.Nesting)
+	}
+
+	if new.MinItems > old.MinItems {
+		d.breaking(address, "minimum items increased from %d to %d", old.MinItems, new.MinItems)
+	} else if new.MinItems < old.MinItems {
+		d.safe(address, "minimum items decreased from %d to %d", old.MinItems, new.MinItems)
+	}
+
+	switch {
+	case new.MaxItems != 0 && (old.MaxItems == 0 || new.MaxItems < old.MaxItems):
+		d.breaking(address, "maximum items decreased from %s to %d", maxItems(old.MaxItems), new.MaxItems)
+	case new.MaxItems != old.MaxItems:
+		d.safe(address, "maximum items increased from %d to %s", old.MaxItems, maxItems(new.MaxItems))
+	}
+
+	d.forceNew(address, old.ForceNew, new.ForceNew)
+
+	d.block(address, &old.Block, &new.Block)
+}
+
+func (d *differ) forceNew(address string, old, new bool) {
+	if !old && new {
+		d.breaking(address, "now forces replacement")
+	} else if old && !new {
+		d.safe(address, "no longer forces replacement")
+	}
+}
+
+func implementation(framework bool) string {
+	if framework {
+		return "Terraform Plugin Framework"
+	}
+
+	return "Terraform Plugin SDK"
+}
+
+func maxItems(n int64) string {
+	if n == 0 {
+		return "unlimited"
+	}
+
+	return fmt.Sprint(n)
+}
+
+// keys returns the sorted union of the maps' keys.
+func keys[V any](old, new map[string]V) []string {
+	var keys []string
+
+	for k := range old {
+		keys = append(keys, k)
+	}
+	for k := range new {
+		if _, ok := old[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,236 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package snapshot_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/tools/schemacheck/snapshot"
+)
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName string
+		Old      *snapshot.Schema
+		New      *snapshot.Schema
+		Expected []string
+	}{
+		{
+			TestName: "no change",
+			Old:      testSchema(),
+			New:      testSchema(),
+		},
+		{
+			TestName: "attribute removed",
+			Old:      testSchema(),
+			New: testSchema(func(s *snapshot.Schema) {
+				delete(s.Block.Attributes, "description")
+			}),
+			Expected: []string{"BREAKING aws_example_widget.description: removed"},
+		},
+		{
+			TestName: "optional attribute added",
+			Old:      testSchema(),
+			New: testSchema(func(s *snapshot.Schema) {
+				s.Block.Attributes["color"] = &snapshot.Attribute{Type: "string", Optional: true}
+			}),
+			Expected: []string{"SAFE aws_example_widget.color: added"},
+		},
+		{
+			TestName: "required attribute added",
+			Old:      testSchema(),
+			New: testSchema(func(s *snapshot.Schema) {
+				s.Block.Attributes["color"] = &snapshot.Attribute{Type: "string", Required: true}
+			}),
+			Expected: []string{"BREAKING aws_example_widget.color: added as a required attribute"},
+		},
+		{
+			TestName: "optional to required",
+			Old:      testSchema(),
+			New: testSchema(func(s *snapshot.Schema) {
+				s.Block.Attributes["description"] = &snapshot.Attribute{Type: "string", Required: true}
+			}),
+			Expected: []string{"BREAKING aws_example_widget.description: changed from Optional to Required"},
+		},
+		{
+			TestName: "required to optional",
+			Old:      testSchema(),
+			New: testSchema(func(s *snapshot.Schema) {
+				s.Block.Attributes["name"].Required = false
+				s.Block.Attributes["name"].Optional = true
+			}),
+			Expected: []string{"SAFE aws_example_widget.name: changed from Required to Optional"},
+		},
+		{
+			TestName: "no longer configurable",
+			Old:      testSchema(),
+			New: testSchema(func(s *snapshot.Schema) {
+				s.Block.Attributes["description"] = &snapshot.Attribute{Type: "string", Computed: true}
+			}),
+			Expected: []string{"BREAKING aws_example_widget.description: no longer configurable"},
+		},
+		{
+			TestName: "type change",
+			Old:      testSchema(),
+			New: testSchema(func(s *snapshot.Schema) {
+				s.Block.Attributes["size"].Type = "string"
+			}),
+			Expected: []string{"BREAKING aws_example_widget.size: type changed from number to string"},
+		},
+		{
+			TestName: "ForceNew added",
+			Old:      testSchema(),
+			New: testSchema(func(s *snapshot.Schema) {
+				s.Block.Attributes["description"].ForceNew = true
+			}),
+			Expected: []string{"BREAKING aws_example_widget.description: now forces replacement"},
+		},
+		{
+			TestName: "ForceNew removed",
+			Old:      testSchema(),
+			New: testSchema(func(s *snapshot.Schema) {
+				s.Block.Attributes["name"].ForceNew = false
+			}),
+			Expected: []string{"SAFE aws_example_widget.name: no longer forces replacement"},
+		},
+		{
+			TestName: "nested attribute ForceNew added",
+			Old:      testSchema(),
+			New: testSchema(func(s *snapshot.Schema) {
+				s.Block.Blocks["rule"].Attributes["priority"].ForceNew = true
+			}),
+			Expected: []string{"BREAKING aws_example_widget.rule.priority: now forces replacement"},
+		},
+		{
+			TestName: "block nesting and max items",
+			Old:      testSchema(),
+			New: testSchema(func(s *snapshot.Schema) {
+				s.Block.Blocks["rule"].Nesting = snapshot.NestingSet
+				s.Block.Blocks["rule"].MaxItems = 1
+			}),
+			Expected: []string{
+				"BREAKING aws_example_widget.rule: nesting changed from list to set",
+				"BREAKING aws_example_widget.rule: maximum items decreased from unlimited to 1",
+			},
+		},
+		{
+			TestName: "attribute to block",
+			Old:      testSchema(),
+			New: testSchema(func(s *snapshot.Schema) {
+				delete(s.Block.Attributes, "description")
+				s.Block.Blocks["description"] = &snapshot.NestedBlock{Nesting: snapshot.NestingList}
+			}),
+			Expected: []string{"BREAKING aws_example_widget.description: changed from an attribute to a block"},
+		},
+		{
+			TestName: "deprecated and migrated",
+			Old:      testSchema(),
+			New: testSchema(func(s *snapshot.Schema) {
+				s.Framework = true
+				s.Version = 1
+				s.Block.Attributes["description"].Deprecated = true
+			}),
+			Expected: []string{
+				"SAFE aws_example_widget: migrated to Terraform Plugin Framework",
+				"SAFE aws_example_widget: schema version increased from 0 to 1",
+				"SAFE aws_example_widget.description: deprecated",
+			},
+		},
+		{
+			TestName: "resource removed",
+			Old:      testSchema(),
+			Expected: []string{"BREAKING aws_example_widget: removed"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			old, new := snapshot.New(), snapshot.New()
+			if testCase.Old != nil {
+				old.Resources["aws_example_widget"] = testCase.Old
+			}
+			if testCase.New != nil {
+				new.Resources["aws_example_widget"] = testCase.New
+			}
+
+			var got []string
+			for _, c := range snapshot.Diff(old, new) {
+				got = append(got, changeString(c))
+			}
+
+			if got, want := strings.Join(got, "\n"), strings.Join(testCase.Expected, "\n"); got != want {
+				t.Errorf("got:\n%s\nwant:\n%s", got, want)
+			}
+		})
+	}
+}
+
+func TestDiffDataSources(t *testing.T) {
+	t.Parallel()
+
+	old, new := snapshot.New(), snapshot.New()
+	old.DataSources["aws_example_widget"] = testSchema()
+	new.DataSources["aws_example_widget"] = testSchema(func(s *snapshot.Schema) {
+		delete(s.Block.Attributes, "size")
+	})
+	new.DataSources["aws_example_widgets"] = testSchema()
+
+	var got []string
+	for _, c := range snapshot.Diff(old, new) {
+		got = append(got, changeString(c))
+	}
+
+	want := []string{
+		"BREAKING data.aws_example_widget.size: removed",
+		"SAFE data.aws_example_widgets: added",
+	}
+
+	if got, want := strings.Join(got, "\n"), strings.Join(want, "\n"); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func testSchema(modifiers ...func(*snapshot.Schema)) *snapshot.Schema {
+	s := &snapshot.Schema{
+		Block: &snapshot.Block{
+			Attributes: map[string]*snapshot.Attribute{
+				"description": {Type: "string", Optional: true},
+				"id":          {Type: "string", Optional: true, Computed: true},
+				"name":        {Type: "string", Required: true, ForceNew: true},
+				"size":        {Type: "number", Optional: true, Computed: true},
+			},
+			Blocks: map[string]*snapshot.NestedBlock{
+				"rule": {
+					Block: snapshot.Block{
+						Attributes: map[string]*snapshot.Attribute{
+							"priority": {Type: "number", Required: true},
+						},
+					},
+					Nesting: snapshot.NestingList,
+				},
+			},
+		},
+	}
+
+	for _, f := range modifiers {
+		f(s)
+	}
+
+	return s
+}
+
+func changeString(c snapshot.Change) string {
+	if c.Breaking {
+		return "BREAKING " + c.String()
+	}
+
+	return "SAFE " + c.String()
+}
@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package snapshot defines a canonical JSON representation of the provider's resource and data source schemas
+// and classifies the differences between two such snapshots.
+package snapshot
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+)
+
+// FormatVersion is the version of the snapshot format written by this package.
+const FormatVersion = 1
+
+// Nesting modes of nested blocks.
+const (
+	NestingGroup  = "group"
+	NestingList   = "list"
+	NestingMap    = "map"
+	NestingSet    = "set"
+	NestingSingle = "single"
+)
+
+// Snapshot is the schema of every resource and data source in the provider.
+// Maps are used throughout so that the JSON encoding is canonical.
+type Snapshot struct {
+	FormatVersion int                `json:"format_version"`
+	DataSources   map[string]*Schema `json:"data_sources"`
+	Resources     map[string]*Schema `json:"resources"`
+}
+
+// Schema is the schema of a single resource or data source.
+type Schema struct {
+	Framework bool   `json:"framework,omitempty"` // Implemented using the Terraform Plugin Framework.
+	Version   int64  `json:"version"`
+	Block     *Block `json:"block"`
+}
+
+// Block is a schema's or nested block's attributes and nested blocks.
+type Block struct {
+	Attributes map[string]*Attribute   `json:"attributes,omitempty"`
+	Blocks     map[string]*NestedBlock `json:"blocks,omitempty"`
+}
+
+// Attribute is a single attribute.
+type Attribute struct {
+	Type       string `json:"type"` // e.g. string, list(number), object({name=string})
+	Required   bool   `json:"required,omitempty"`
+	Optional   bool   `json:"optional,omitempty"`
+	Computed   bool   `json:"computed,omitempty"`
+	ForceNew   bool   `json:"force_new,omitempty"`
+	Sensitive  bool   `json:"sensitive,omitempty"`
+	Deprecated bool   `json:"deprecated,omitempty"`
+}
+
+// NestedBlock is a single nested block.
+type NestedBlock struct {
+	Block
+	Nesting  string `json:"nesting"`
+	MinItems int64  `json:"min_items,omitempty"`
+	MaxItems int64  `json:"max_items,omitempty"`
+	ForceNew bool   `json:"force_new,omitempty"`
+}
+
+// New returns a new, empty snapshot.
+func New() *Snapshot {
+	return &Snapshot{
+		FormatVersion: FormatVersion,
+		DataSources:   make(map[string]*Schema),
+		Resources:     make(map[string]*Schema),
+	}
+}
+
+// Read reads a snapshot from the named file.
+func Read(filename string) (*Snapshot, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	s, err := Decode(f)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", filename, err)
+	}
+
+	return s, nil
+}
+
+// Decode decodes a JSON-encoded snapshot.
+func Decode(r io.Reader) (*Snapshot, error) {
+	s := New()
+
+	if err := json.NewDecoder(r).Decode(s); err != nil {
+		return nil, err
+	}
+
+	if s.FormatVersion != FormatVersion {
+		return nil, fmt.Errorf("unsupported snapshot format version: %d", s.FormatVersion)
+	}
+
+	return s, nil
+}
+
+// Encode writes the canonical JSON encoding of the snapshot.
+func (s *Snapshot) Encode(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(s)
+}
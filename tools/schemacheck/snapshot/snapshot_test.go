@@ -0,0 +1,32 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package snapshot_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/tools/schemacheck/snapshot"
+)
+
+func TestEncodeDecode(t *testing.T) {
+	t.Parallel()
+
+	s := snapshot.New()
+	s.Resources["aws_example_widget"] = testSchema()
+
+	var sb strings.Builder
+	if err := s.Encode(&sb); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := snapshot.Decode(strings.NewReader(sb.String()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if changes := snapshot.Diff(s, got); len(changes) != 0 {
+		t.Errorf("unexpected changes after round trip: %v", changes)
+	}
+}
@@ -3,6 +3,7 @@ module github.com/hashicorp/terraform-provider-aws/tools/tfsdk2fw
 go 1.22.2
 
 require (
+	github.com/hashicorp/go-cty v1.4.1-0.20200723130312-85980079f637
 	github.com/hashicorp/terraform-plugin-sdk/v2 v2.34.0
 	github.com/hashicorp/terraform-provider-aws v1.60.1-0.20220322001452-8f7a597d0c24
 	golang.org/x/exp v0.0.0-20240222234643-814bf88cf225
@@ -18,7 +19,7 @@ require (
 	github.com/agext/levenshtein v1.2.3 // indirect
 	github.com/apparentlymart/go-textseg/v15 v15.0.0 // indirect
 	github.com/armon/go-radix v1.0.0 // indirect
-	github.com/aws/aws-sdk-go v1.53.12 // indirect
+	github.com/aws/aws-sdk-go v1.53.13 // indirect
 	github.com/aws/aws-sdk-go-v2 v1.27.0 // indirect
 	github.com/aws/aws-sdk-go-v2/aws/protocol/eventstream v1.6.2 // indirect
 	github.com/aws/aws-sdk-go-v2/config v1.27.16 // indirect
@@ -31,7 +32,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/internal/v4a v1.3.7 // indirect
 	github.com/aws/aws-sdk-go-v2/service/accessanalyzer v1.29.6 // indirect
 	github.com/aws/aws-sdk-go-v2/service/account v1.16.8 // indirect
-	github.com/aws/aws-sdk-go-v2/service/acm v1.25.8 // indirect
+	github.com/aws/aws-sdk-go-v2/service/acm v1.26.0 // indirect
 	github.com/aws/aws-sdk-go-v2/service/acmpca v1.30.1 // indirect
 	github.com/aws/aws-sdk-go-v2/service/amp v1.25.8 // indirect
 	github.com/aws/aws-sdk-go-v2/service/amplify v1.21.9 // indirect
@@ -51,7 +52,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/batch v1.37.4 // indirect
 	github.com/aws/aws-sdk-go-v2/service/bcmdataexports v1.3.8 // indirect
 	github.com/aws/aws-sdk-go-v2/service/bedrock v1.8.5 // indirect
-	github.com/aws/aws-sdk-go-v2/service/bedrockagent v1.11.1 // indirect
+	github.com/aws/aws-sdk-go-v2/service/bedrockagent v1.12.0 // indirect
 	github.com/aws/aws-sdk-go-v2/service/budgets v1.23.4 // indirect
 	github.com/aws/aws-sdk-go-v2/service/chatbot v1.2.1 // indirect
 	github.com/aws/aws-sdk-go-v2/service/chimesdkmediapipelines v1.15.9 // indirect
@@ -64,7 +65,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/cloudfrontkeyvaluestore v1.4.8 // indirect
 	github.com/aws/aws-sdk-go-v2/service/cloudhsmv2 v1.21.8 // indirect
 	github.com/aws/aws-sdk-go-v2/service/cloudsearch v1.22.8 // indirect
-	github.com/aws/aws-sdk-go-v2/service/cloudtrail v1.39.6 // indirect
+	github.com/aws/aws-sdk-go-v2/service/cloudtrail v1.40.0 // indirect
 	github.com/aws/aws-sdk-go-v2/service/cloudwatch v1.38.4 // indirect
 	github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs v1.35.5 // indirect
 	github.com/aws/aws-sdk-go-v2/service/codeartifact v1.27.4 // indirect
@@ -105,7 +106,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk v1.23.8 // indirect
 	github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2 v1.31.1 // indirect
 	github.com/aws/aws-sdk-go-v2/service/emr v1.39.9 // indirect
-	github.com/aws/aws-sdk-go-v2/service/emrserverless v1.20.0 // indirect
+	github.com/aws/aws-sdk-go-v2/service/emrserverless v1.21.0 // indirect
 	github.com/aws/aws-sdk-go-v2/service/eventbridge v1.31.3 // indirect
 	github.com/aws/aws-sdk-go-v2/service/evidently v1.19.8 // indirect
 	github.com/aws/aws-sdk-go-v2/service/finspace v1.24.5 // indirect
@@ -160,7 +161,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/qldb v1.21.8 // indirect
 	github.com/aws/aws-sdk-go-v2/service/ram v1.25.8 // indirect
 	github.com/aws/aws-sdk-go-v2/service/rbin v1.16.8 // indirect
-	github.com/aws/aws-sdk-go-v2/service/rds v1.79.2 // indirect
+	github.com/aws/aws-sdk-go-v2/service/rds v1.79.3 // indirect
 	github.com/aws/aws-sdk-go-v2/service/redshift v1.44.4 // indirect
 	github.com/aws/aws-sdk-go-v2/service/redshiftdata v1.25.8 // indirect
 	github.com/aws/aws-sdk-go-v2/service/redshiftserverless v1.18.5 // indirect
@@ -229,7 +230,6 @@ require (
 	github.com/hashicorp/errwrap v1.1.0 // indirect
 	github.com/hashicorp/go-checkpoint v0.5.0 // indirect
 	github.com/hashicorp/go-cleanhttp v0.5.2 // indirect
-	github.com/hashicorp/go-cty v1.4.1-0.20200723130312-85980079f637 // indirect
 	github.com/hashicorp/go-hclog v1.6.3 // indirect
 	github.com/hashicorp/go-multierror v1.1.1 // indirect
 	github.com/hashicorp/go-plugin v1.6.0 // indirect
@@ -0,0 +1,182 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/tools/tfsdk2fw/translate"
+)
+
+// sdkResource returns the specified Plugin SDK v2 resource as registered by its service package.
+// Resources in the provider's ResourcesMap have their handlers wrapped by interceptors, hiding the service package's functions.
+func sdkResource(ctx context.Context, p *schema.Provider, typeName string) (*types.ServicePackageSDKResource, bool) {
+	meta, ok := p.Meta().(*conns.AWSClient)
+	if !ok {
+		return nil, false
+	}
+
+	for _, sp := range meta.ServicePackages {
+		for _, v := range sp.SDKResources(ctx) {
+			if v.TypeName == typeName {
+				return v, true
+			}
+		}
+	}
+
+	return nil, false
+}
+
+// function describes the source of a Go function.
+type function struct {
+	Package  string // e.g. github.com/hashicorp/terraform-provider-aws/internal/service/accessanalyzer
+	Name     string // e.g. resourceArchiveRuleCreate
+	Filename string
+	Line     int
+}
+
+// String returns the function's package-qualified name, e.g. accessanalyzer.resourceArchiveRuleCreate.
+func (f *function) String() string {
+	return f.Package[strings.LastIndex(f.Package, "/")+1:] + "." + f.Name
+}
+
+// functionOf returns the source of a function value.
+// Function literals (closures) are reported with the name of their enclosing function.
+func functionOf(f any) (*function, bool) {
+	v := reflect.ValueOf(f)
+	if v.Kind() != reflect.Func || v.IsNil() {
+		return nil, false
+	}
+
+	fn := runtime.FuncForPC(v.Pointer())
+	if fn == nil {
+		return nil, false
+	}
+
+	filename, line := fn.FileLine(fn.Entry())
+	name := fn.Name()
+	i := strings.LastIndex(name, "/") + 1
+	i += strings.Index(name[i:], ".")
+
+	return &function{
+		Package:  name[:i],
+		Name:     name[i+1:],
+		Filename: filename,
+		Line:     line,
+	}, true
+}
+
+// isClosure returns whether the function is a function literal.
+func (f *function) isClosure() bool {
+	return strings.Contains(f.Name, ".")
+}
+
+// translateHandlers translates the resource's Plugin SDK v2 CRUD handler bodies.
+func (m *migrator) translateHandlers(templateData *templateData, attributes map[string]translate.Attribute) {
+	for _, v := range []struct {
+		operation string
+		handler   any
+		body      *string
+	}{
+		{"Create", m.Resource.CreateWithoutTimeout, &templateData.CreateBody},
+		{"Read", m.Resource.ReadWithoutTimeout, &templateData.ReadBody},
+		{"Update", m.Resource.UpdateWithoutTimeout, &templateData.UpdateBody},
+		{"Delete", m.Resource.DeleteWithoutTimeout, &templateData.DeleteBody},
+	} {
+		body, err := m.translateHandler(v.operation, v.handler, attributes)
+
+		if err != nil {
+			m.Generator.Warnf("%s handler not translated: %s", v.operation, err)
+			continue
+		}
+
+		*v.body = body
+	}
+}
+
+func (m *migrator) translateHandler(operation string, handler any, attributes map[string]translate.Attribute) (string, error) {
+	f, ok := functionOf(handler)
+	if !ok {
+		return "", nil
+	}
+
+	if f.isClosure() {
+		return "", fmt.Errorf("%s is a function literal", f)
+	}
+
+	src, err := os.ReadFile(f.Filename)
+	if err != nil {
+		return "", err
+	}
+
+	result, err := translate.Func(f.Filename, src, f.Name, translate.Options{
+		Operation:  operation,
+		Attributes: attributes,
+		AttrConsts: m.attrConsts(f.Filename),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var sb strings.Builder
+	fprintf(&sb, "// Translated from %s (%s:%d).\n", f, filepath.Base(f.Filename), f.Line)
+	if len(result.TODOs) > 0 {
+		fprintf(&sb, "// TODO Review untranslated Plugin SDK v2 code:\n")
+		for _, v := range result.TODOs {
+			fprintf(&sb, "//   - %s\n", v)
+		}
+	}
+	sb.WriteString(result.Body)
+
+	return sb.String(), nil
+}
+
+// attrConsts returns the names package's attribute name constants, read from the source tree containing filename.
+func (m *migrator) attrConsts(filename string) map[string]string {
+	if m.AttrConsts != nil {
+		return m.AttrConsts
+	}
+
+	m.AttrConsts = make(map[string]string)
+
+	for dir := filepath.Dir(filename); dir != filepath.Dir(dir); dir = filepath.Dir(dir) {
+		src, err := os.ReadFile(filepath.Join(dir, "names", "attr_consts_gen.go"))
+		if err != nil {
+			continue
+		}
+
+		file, err := parser.ParseFile(token.NewFileSet(), "", src, 0)
+		if err != nil {
+			break
+		}
+
+		ast.Inspect(file, func(n ast.Node) bool {
+			if spec, ok := n.(*ast.ValueSpec); ok && len(spec.Names) == 1 && len(spec.Values) == 1 {
+				if lit, ok := spec.Values[0].(*ast.BasicLit); ok && lit.Kind == token.STRING {
+					if v, err := strconv.Unquote(lit.Value); err == nil {
+						m.AttrConsts[spec.Names[0].Name] = v
+					}
+				}
+			}
+			return true
+		})
+
+		break
+	}
+
+	return m.AttrConsts
+}
@@ -13,11 +13,14 @@ import (
 	"path"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/tools/tfsdk2fw/naming"
+	"github.com/hashicorp/terraform-provider-aws/tools/tfsdk2fw/translate"
 	"golang.org/x/exp/slices"
 )
 
@@ -58,7 +61,8 @@ func main() {
 		PackageName: packageName,
 	}
 
-	p, err := provider.New(context.Background())
+	ctx := context.Background()
+	p, err := provider.New(ctx)
 
 	if err != nil {
 		g.Fatalf(err.Error())
@@ -76,13 +80,14 @@ func main() {
 		migrator.Template = datasourceImpl
 		migrator.TFTypeName = v
 	} else if v := *resourceType; v != "" {
-		resource, ok := p.ResourcesMap[v]
+		resource, ok := sdkResource(ctx, p, v)
 
 		if !ok {
 			g.Fatalf("resource type %s not found", v)
 		}
 
-		migrator.Resource = resource
+		migrator.Resource = resource.Factory()
+		migrator.Tags = resource.Tags
 		migrator.Template = resourceImpl
 		migrator.TFTypeName = v
 	}
@@ -93,11 +98,13 @@ func main() {
 }
 
 type migrator struct {
+	AttrConsts   map[string]string // names package attribute name constants, keyed by constant name.
 	Generator    *common.Generator
 	IsDataSource bool
 	Name         string
 	PackageName  string
 	Resource     *schema.Resource
+	Tags         *types.ServicePackageResourceTags
 	Template     string
 	TFTypeName   string
 }
@@ -126,15 +133,36 @@ func (m *migrator) migrate(outputFilename string) error {
 		return err
 	}
 
+	if err := d.Write(); err != nil {
+		return err
+	}
+
+	if m.IsDataSource {
+		return nil
+	}
+
+	// Generate a test asserting that state written by the Plugin SDK implementation decodes under the new schema.
+	testFilename := strings.TrimSuffix(outputFilename, ".go") + "_state_test.go"
+	m.infof("generating into %[1]q", testFilename)
+
+	d = m.Generator.NewGoFileDestination(testFilename)
+
+	if err := d.WriteTemplate("statetest", stateTestImpl, templateData); err != nil {
+		return err
+	}
+
 	return d.Write()
 }
 
 func (m *migrator) generateTemplateData() (*templateData, error) {
+	sbModels := strings.Builder{}
 	sbSchema := strings.Builder{}
 	sbStruct := strings.Builder{}
 	emitter := &emitter{
+		Attributes:   make(map[string]translate.Attribute),
 		Generator:    m.Generator,
 		IsDataSource: m.IsDataSource,
+		ModelWriter:  &sbModels,
 		SchemaWriter: &sbSchema,
 		StructWriter: &sbStruct,
 	}
@@ -152,17 +180,54 @@ func (m *migrator) generateTemplateData() (*templateData, error) {
 		DefaultDeleteTimeout:         emitter.DefaultDeleteTimeout,
 		EmitResourceImportState:      m.Resource.Importer != nil,
 		EmitResourceModifyPlan:       !m.IsDataSource && emitter.HasTopLevelTagsAllMap && emitter.HasTopLevelTagsMap,
+		EmitResourceSetTagsAll:       !m.IsDataSource && emitter.HasTopLevelTagsAllMap && emitter.HasTopLevelTagsMap,
 		EmitResourceUpdateSkeleton:   m.Resource.Update != nil || m.Resource.UpdateContext != nil || m.Resource.UpdateWithoutTimeout != nil,
 		HasTimeouts:                  emitter.HasTimeouts,
 		ImportFrameworkAttr:          emitter.ImportFrameworkAttr,
 		ImportProviderFrameworkTypes: emitter.ImportProviderFrameworkTypes,
+		Models:                       sbModels.String(),
 		Name:                         m.Name,
 		PackageName:                  m.PackageName,
 		Schema:                       sbSchema.String(),
+		SchemaVersion:                m.Resource.SchemaVersion,
 		Struct:                       sbStruct.String(),
 		TFTypeName:                   m.TFTypeName,
 	}
 
+	if !m.IsDataSource {
+		if v := m.Tags; v != nil {
+			templateData.TagsIdentifierAttribute = v.IdentifierAttribute
+			templateData.TagsResourceType = v.ResourceType
+		}
+
+		if v := m.Resource.CustomizeDiff; v != nil {
+			if f, ok := functionOf(v); ok && f.String() != "verify.SetTagsDiff" {
+				templateData.CustomizeDiff = f.String()
+				templateData.EmitResourceModifyPlan = true
+			}
+		}
+
+		m.translateHandlers(templateData, emitter.Attributes)
+
+		sbUpgradeState := strings.Builder{}
+		emitted, err := m.emitStateUpgraders(&sbUpgradeState, emitter.Attributes)
+
+		if err != nil {
+			m.Generator.Warnf("state upgraders not migrated: %s", err)
+		} else if emitted {
+			templateData.UpgradeState = sbUpgradeState.String()
+			if strings.Contains(templateData.UpgradeState, "attr.Type") {
+				templateData.ImportFrameworkAttr = true
+			}
+		}
+
+		templateData.State, err = m.sampleState()
+
+		if err != nil {
+			return nil, fmt.Errorf("generating sample state: %w", err)
+		}
+	}
+
 	for _, v := range emitter.FrameworkPlanModifierPackages {
 		if !slices.Contains(templateData.FrameworkPlanModifierPackages, v) {
 			templateData.FrameworkPlanModifierPackages = append(templateData.FrameworkPlanModifierPackages, v)
@@ -187,6 +252,7 @@ func (m *migrator) infof(format string, a ...interface{}) {
 }
 
 type emitter struct {
+	Attributes                    map[string]translate.Attribute // Top-level attributes and blocks, keyed by name.
 	DefaultCreateTimeout          int64
 	DefaultReadTimeout            int64
 	DefaultUpdateTimeout          int64
@@ -200,7 +266,8 @@ type emitter struct {
 	ImportFrameworkAttr           bool
 	ImportProviderFrameworkTypes  bool
 	IsDataSource                  bool
-	ProviderPlanModifierPackages  []string // Package names for any provider plan modifiers. May contain duplicates.
+	ModelWriter                   io.Writer // Model structs for nested blocks.
+	ProviderPlanModifierPackages  []string  // Package names for any provider plan modifiers. May contain duplicates.
 	SchemaWriter                  io.Writer
 	StructWriter                  io.Writer
 }
@@ -286,10 +353,7 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 		}
 
 		fprintf(e.SchemaWriter, "%q:", name)
-
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "%s ", naming.ToCamelCase(name))
-		}
+		fprintf(e.StructWriter, "%s ", naming.ToCamelCase(name))
 
 		err := e.emitAttributeProperty(append(path, name), property)
 
@@ -297,8 +361,10 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 			return err
 		}
 
+		fprintf(e.StructWriter, " `tfsdk:%q`\n", name)
+
 		if isTopLevelAttribute {
-			fprintf(e.StructWriter, " `tfsdk:%q`\n", name)
+			e.Attributes[name] = translate.Attribute{Field: naming.ToCamelCase(name), Type: translateType(property)}
 		}
 
 		fprintf(e.SchemaWriter, ",\n")
@@ -321,6 +387,7 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 		}
 
 		fprintf(e.SchemaWriter, "%q:", name)
+		fprintf(e.StructWriter, "%s ", naming.ToCamelCase(name))
 
 		err := e.emitBlockProperty(append(path, name), property)
 
@@ -328,6 +395,12 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 			return err
 		}
 
+		fprintf(e.StructWriter, " `tfsdk:%q`\n", name)
+
+		if isTopLevelAttribute {
+			e.Attributes[name] = translate.Attribute{Field: naming.ToCamelCase(name), Type: translate.Complex}
+		}
+
 		fprintf(e.SchemaWriter, ",\n")
 	}
 	if emittedFieldName {
@@ -354,9 +427,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 	case schema.TypeBool:
 		fprintf(e.SchemaWriter, "schema.BoolAttribute{\n")
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Bool")
-		}
+		fprintf(e.StructWriter, "types.Bool")
 
 		fwPlanModifierPackage = "boolplanmodifier"
 		fwPlanModifierType = "Bool"
@@ -364,9 +435,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 	case schema.TypeFloat:
 		fprintf(e.SchemaWriter, "schema.Float64Attribute{\n")
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Float64")
-		}
+		fprintf(e.StructWriter, "types.Float64")
 
 		fwPlanModifierPackage = "float64planmodifier"
 		fwPlanModifierType = "Float64"
@@ -374,9 +443,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 	case schema.TypeInt:
 		fprintf(e.SchemaWriter, "schema.Int64Attribute{\n")
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Int64")
-		}
+		fprintf(e.StructWriter, "types.Int64")
 
 		fwPlanModifierPackage = "int64planmodifier"
 		fwPlanModifierType = "Int64"
@@ -389,9 +456,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			fprintf(e.SchemaWriter, "schema.StringAttribute{\n")
 			fprintf(e.SchemaWriter, "CustomType:fwtypes.ARNType,\n")
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "fwtypes.ARN")
-			}
+			fprintf(e.StructWriter, "fwtypes.ARN")
 		} else {
 			if isTopLevelAttribute && attributeName == "id" {
 				fprintf(e.SchemaWriter, "// TODO framework.IDAttribute()\n")
@@ -399,9 +464,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 
 			fprintf(e.SchemaWriter, "schema.StringAttribute{\n")
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.String")
-			}
+			fprintf(e.StructWriter, "types.String")
 		}
 
 		fwPlanModifierPackage = "stringplanmodifier"
@@ -418,9 +481,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			aggregateSchemaFactory = "schema.ListAttribute{"
 			typeName = "list"
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.List")
-			}
+			fprintf(e.StructWriter, "types.List")
 
 			fwPlanModifierPackage = "listplanmodifier"
 			fwPlanModifierType = "List"
@@ -431,9 +492,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			aggregateSchemaFactory = "schema.MapAttribute{"
 			typeName = "map"
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.Map")
-			}
+			fprintf(e.StructWriter, "types.Map")
 
 			fwPlanModifierPackage = "mapplanmodifier"
 			fwPlanModifierType = "Map"
@@ -444,9 +503,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			aggregateSchemaFactory = "schema.SetAttribute{"
 			typeName = "set"
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.Set")
-			}
+			fprintf(e.StructWriter, "types.Set")
 
 			fwPlanModifierPackage = "setplanmodifier"
 			fwPlanModifierType = "Set"
@@ -614,10 +671,15 @@ func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) erro
 			fwValidatorsPackage = "listvalidator"
 			fwValidatorType = "List"
 
+			model := modelName(path)
+			e.ImportProviderFrameworkTypes = true
+
 			fprintf(e.SchemaWriter, "schema.ListNestedBlock{\n")
+			fprintf(e.SchemaWriter, "CustomType:fwtypes.NewListNestedObjectTypeOf[%s](ctx),\n", model)
 			fprintf(e.SchemaWriter, "NestedObject:schema.NestedBlockObject{\n")
+			fprintf(e.StructWriter, "fwtypes.ListNestedObjectValueOf[%s]", model)
 
-			err := e.emitAttributesAndBlocks(path, v.Schema)
+			err := e.emitNestedModel(path, model, v.Schema)
 
 			if err != nil {
 				return err
@@ -637,10 +699,15 @@ func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) erro
 			fwValidatorsPackage = "setvalidator"
 			fwValidatorType = "Set"
 
+			model := modelName(path)
+			e.ImportProviderFrameworkTypes = true
+
 			fprintf(e.SchemaWriter, "schema.SetNestedBlock{\n")
+			fprintf(e.SchemaWriter, "CustomType:fwtypes.NewSetNestedObjectTypeOf[%s](ctx),\n", model)
 			fprintf(e.SchemaWriter, "NestedObject:schema.NestedBlockObject{\n")
+			fprintf(e.StructWriter, "fwtypes.SetNestedObjectValueOf[%s]", model)
 
-			err := e.emitAttributesAndBlocks(path, v.Schema)
+			err := e.emitNestedModel(path, model, v.Schema)
 
 			if err != nil {
 				return err
@@ -712,6 +779,26 @@ func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) erro
 	return nil
 }
 
+// emitNestedModel generates the Plugin Framework code for a nested block's attributes and blocks
+// and emits the block's model struct to the emitter's ModelWriter.
+func (e *emitter) emitNestedModel(path []string, model string, schema map[string]*schema.Schema) error {
+	structWriter := e.StructWriter
+	sb := strings.Builder{}
+	e.StructWriter = &sb
+
+	err := e.emitAttributesAndBlocks(path, schema)
+
+	e.StructWriter = structWriter
+
+	if err != nil {
+		return err
+	}
+
+	fprintf(e.ModelWriter, "type %s struct {\n%s}\n\n", model, sb.String())
+
+	return nil
+}
+
 // emitComputedOnlyBlock generates the Plugin Framework code for a Plugin SDK Computed-only nested block
 // and emits the generated code to the emitter's Writer.
 // See https://github.com/hashicorp/terraform-plugin-sdk/blob/6ffc92796f0716c07502e4d36aaafa5fd85e94cf/internal/configs/configschema/implied_type.go#L12.
@@ -878,29 +965,84 @@ func isAttribute(property *schema.Schema) bool {
 	return false
 }
 
+// modelName returns the name of the model struct for the nested block at the specified path.
+func modelName(path []string) string {
+	s := naming.ToCamelCase(strings.Join(path, "_"))
+
+	return strings.ToLower(s[:1]) + s[1:] + "Model"
+}
+
+// translateType returns the type of a top-level attribute's model struct field.
+func translateType(property *schema.Schema) translate.Type {
+	switch property.Type {
+	case schema.TypeBool:
+		return translate.Bool
+	case schema.TypeFloat:
+		return translate.Float64
+	case schema.TypeInt:
+		return translate.Int64
+	case schema.TypeString:
+		return translate.String
+	default:
+		return translate.Complex
+	}
+}
+
 func unsupportedTypeError(path []string, typ string) error {
 	return fmt.Errorf("%s is of unsupported type: %s", strings.Join(path, "/"), typ)
 }
 
 type templateData struct {
+	CreateBody                    string // Translated Plugin SDK v2 Create handler body.
+	CustomizeDiff                 string // e.g. verify.SetTagsDiff
 	DefaultCreateTimeout          int64
 	DefaultReadTimeout            int64
 	DefaultUpdateTimeout          int64
 	DefaultDeleteTimeout          int64
+	DeleteBody                    string
 	EmitResourceImportState       bool
 	EmitResourceModifyPlan        bool
+	EmitResourceSetTagsAll        bool
 	EmitResourceUpdateSkeleton    bool
 	FrameworkPlanModifierPackages []string
 	FrameworkValidatorsPackages   []string
 	HasTimeouts                   bool
 	ImportFrameworkAttr           bool
 	ImportProviderFrameworkTypes  bool
+	Models                        string // Model structs for nested blocks.
 	Name                          string // e.g. Instance
 	PackageName                   string // e.g. ec2
 	ProviderPlanModifierPackages  []string
+	ReadBody                      string
 	Schema                        string
+	SchemaVersion                 int
+	State                         string // JSON-encoded sample Plugin SDK v2 state.
 	Struct                        string
+	TagsIdentifierAttribute       string
+	TagsResourceType              string
 	TFTypeName                    string // e.g. aws_instance
+	UpdateBody                    string
+	UpgradeState                  string
+}
+
+// Duration returns Go code for a human-friendly time.Duration.
+func (templateData) Duration(nanoseconds int64) string {
+	d := time.Duration(nanoseconds)
+
+	for _, v := range []struct {
+		unit time.Duration
+		name string
+	}{
+		{time.Hour, "time.Hour"},
+		{time.Minute, "time.Minute"},
+		{time.Second, "time.Second"},
+	} {
+		if d%v.unit == 0 {
+			return fmt.Sprintf("%d * %s", d/v.unit, v.name)
+		}
+	}
+
+	return fmt.Sprintf("%d * time.Nanosecond", d)
 }
 
 //go:embed datasource.tmpl
@@ -908,3 +1050,6 @@ var datasourceImpl string
 
 //go:embed resource.tmpl
 var resourceImpl string
+
+//go:embed statetest.tmpl
+var stateTestImpl string
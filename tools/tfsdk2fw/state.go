@@ -0,0 +1,257 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/terraform-provider-aws/tools/tfsdk2fw/naming"
+	"github.com/hashicorp/terraform-provider-aws/tools/tfsdk2fw/translate"
+)
+
+// emitStateUpgraders generates the Plugin Framework UpgradeState handlers for the resource's Plugin SDK StateUpgraders
+// and emits the generated code to the Writer.
+// Plugin SDK state upgraders are applied in sequence whereas each Plugin Framework state upgrader
+// must upgrade directly to the current schema version, so the generated handlers need review.
+func (m *migrator) emitStateUpgraders(w io.Writer, attributes map[string]translate.Attribute) (bool, error) {
+	upgraders := m.Resource.StateUpgraders
+	if len(upgraders) == 0 {
+		return false, nil
+	}
+
+	sbMap := strings.Builder{}
+	sbFuncs := strings.Builder{}
+	for _, upgrader := range upgraders {
+		version := upgrader.Version
+
+		if !upgrader.Type.IsObjectType() {
+			return false, fmt.Errorf("state upgrader for version %d: unsupported prior state type: %s", version, upgrader.Type.FriendlyName())
+		}
+
+		priorTypes := upgrader.Type.AttributeTypes()
+		names := make([]string, 0, len(priorTypes))
+		for name := range priorTypes {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		sbSchema := strings.Builder{}
+		sbStruct := strings.Builder{}
+		sbCopy := strings.Builder{}
+		for _, name := range names {
+			typ := priorTypes[name]
+			field := naming.ToCamelCase(name)
+
+			attribute, err := priorSchemaAttribute(typ)
+			if err != nil {
+				return false, fmt.Errorf("state upgrader for version %d: %s: %w", version, name, err)
+			}
+
+			fprintf(&sbSchema, "%q: %s,\n", name, attribute)
+			fprintf(&sbStruct, "%s %s `tfsdk:%q`\n", field, priorModelType(typ), name)
+
+			if v, ok := attributes[name]; ok && v.Field == field && ((v.Type == translate.String && typ == cty.String) || (v.Type == translate.Bool && typ == cty.Bool)) {
+				fprintf(&sbCopy, "data.%[1]s = dataV%[2]d.%[1]s\n", field, version)
+			}
+		}
+
+		fprintf(&sbMap, "%d: {\n", version)
+		fprintf(&sbMap, "PriorSchema: &schema.Schema{\nAttributes: map[string]schema.Attribute{\n%s},\n},\n", sbSchema.String())
+		fprintf(&sbMap, "StateUpgrader: r.upgradeStateFromV%d,\n", version)
+		fprintf(&sbMap, "},\n")
+
+		fprintf(&sbFuncs, "\ntype resource%[1]sDataV%[2]d struct {\n%[3]s}\n", m.Name, version, sbStruct.String())
+		fprintf(&sbFuncs, "\nfunc (r *resource%[1]s) upgradeStateFromV%[2]d(ctx context.Context, request resource.UpgradeStateRequest, response *resource.UpgradeStateResponse) {\n", m.Name, version)
+		fprintf(&sbFuncs, "var dataV%[2]d resource%[1]sDataV%[2]d\n\n", m.Name, version)
+		fprintf(&sbFuncs, "response.Diagnostics.Append(request.State.Get(ctx, &dataV%d)...)\n\n", version)
+		fprintf(&sbFuncs, "if response.Diagnostics.HasError() {\nreturn\n}\n\n")
+		if f, ok := functionOf(upgrader.Upgrade); ok {
+			fprintf(&sbFuncs, "// TODO Port the Plugin SDK v2 state upgrade function %s (%s:%d)\n", f, f.Filename[strings.LastIndex(f.Filename, "/")+1:], f.Line)
+		} else {
+			fprintf(&sbFuncs, "// TODO Port the Plugin SDK v2 state upgrade function\n")
+		}
+		fprintf(&sbFuncs, "// and any later upgrade functions. The upgraded state must conform to the current schema version.\n")
+		fprintf(&sbFuncs, "var data resource%sData\n", m.Name)
+		sbFuncs.WriteString(sbCopy.String())
+		fprintf(&sbFuncs, "\nresponse.Diagnostics.Append(response.State.Set(ctx, &data)...)\n}\n")
+	}
+
+	fprintf(w, `
+// UpgradeState returns the state upgraders for each prior schema version.
+func (r *resource%[1]s) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+%[2]s}
+}
+%[3]s`, m.Name, sbMap.String(), sbFuncs.String())
+
+	return true, nil
+}
+
+// priorSchemaAttribute returns the Plugin Framework schema attribute used to decode a prior state value of the specified type.
+func priorSchemaAttribute(typ cty.Type) (string, error) {
+	switch {
+	case typ == cty.String:
+		return "schema.StringAttribute{Optional: true}", nil
+	case typ == cty.Number:
+		return "schema.NumberAttribute{Optional: true}", nil
+	case typ == cty.Bool:
+		return "schema.BoolAttribute{Optional: true}", nil
+	case typ.IsListType(), typ.IsSetType(), typ.IsMapType():
+		elemType, err := attrType(typ.ElementType())
+		if err != nil {
+			return "", err
+		}
+
+		kind := "List"
+		if typ.IsSetType() {
+			kind = "Set"
+		} else if typ.IsMapType() {
+			kind = "Map"
+		}
+
+		return fmt.Sprintf("schema.%sAttribute{ElementType: %s, Optional: true}", kind, elemType), nil
+	case typ.IsObjectType():
+		attrTypes, err := attrTypes(typ)
+		if err != nil {
+			return "", err
+		}
+
+		return fmt.Sprintf("schema.ObjectAttribute{AttributeTypes: %s, Optional: true}", attrTypes), nil
+	}
+
+	return "", fmt.Errorf("unsupported type: %s", typ.FriendlyName())
+}
+
+// attrType returns the Plugin Framework attr.Type for a value of the specified type.
+func attrType(typ cty.Type) (string, error) {
+	switch {
+	case typ == cty.String:
+		return "types.StringType", nil
+	case typ == cty.Number:
+		return "types.NumberType", nil
+	case typ == cty.Bool:
+		return "types.BoolType", nil
+	case typ.IsListType(), typ.IsSetType(), typ.IsMapType():
+		elemType, err := attrType(typ.ElementType())
+		if err != nil {
+			return "", err
+		}
+
+		kind := "List"
+		if typ.IsSetType() {
+			kind = "Set"
+		} else if typ.IsMapType() {
+			kind = "Map"
+		}
+
+		return fmt.Sprintf("types.%sType{ElemType: %s}", kind, elemType), nil
+	case typ.IsObjectType():
+		attrTypes, err := attrTypes(typ)
+		if err != nil {
+			return "", err
+		}
+
+		return fmt.Sprintf("types.ObjectType{AttrTypes: %s}", attrTypes), nil
+	}
+
+	return "", fmt.Errorf("unsupported type: %s", typ.FriendlyName())
+}
+
+func attrTypes(typ cty.Type) (string, error) {
+	attributeTypes := typ.AttributeTypes()
+	names := make([]string, 0, len(attributeTypes))
+	for name := range attributeTypes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	sb := strings.Builder{}
+	sb.WriteString("map[string]attr.Type{\n")
+	for _, name := range names {
+		v, err := attrType(attributeTypes[name])
+		if err != nil {
+			return "", err
+		}
+
+		fprintf(&sb, "%q: %s,\n", name, v)
+	}
+	sb.WriteString("}")
+
+	return sb.String(), nil
+}
+
+// priorModelType returns the type of a prior state model struct field.
+func priorModelType(typ cty.Type) string {
+	switch {
+	case typ == cty.String:
+		return "types.String"
+	case typ == cty.Number:
+		return "types.Number"
+	case typ == cty.Bool:
+		return "types.Bool"
+	case typ.IsListType():
+		return "types.List"
+	case typ.IsSetType():
+		return "types.Set"
+	case typ.IsMapType():
+		return "types.Map"
+	default:
+		return "types.Object"
+	}
+}
+
+// sampleState returns the JSON encoding of a sample Plugin SDK state value for the resource.
+// Every attribute and nested block is populated so that any not present in the Plugin Framework schema fails to decode.
+func (m *migrator) sampleState() (string, error) {
+	typ := m.Resource.CoreConfigSchema().ImpliedType()
+
+	b, err := ctyjson.Marshal(sampleValue("", typ), typ)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// sampleValue returns a known value of the specified type for the named attribute.
+func sampleValue(name string, typ cty.Type) cty.Value {
+	switch {
+	case typ == cty.String:
+		if name == "arn" || strings.HasSuffix(name, "_arn") {
+			return cty.StringVal("arn:aws:service:us-west-2:123456789012:" + name) //lintignore:AWSAT003,AWSAT005
+		}
+		return cty.StringVal(name)
+	case typ == cty.Number:
+		return cty.NumberIntVal(1)
+	case typ == cty.Bool:
+		return cty.True
+	case typ.IsListType():
+		return cty.ListVal([]cty.Value{sampleValue(name, typ.ElementType())})
+	case typ.IsSetType():
+		return cty.SetVal([]cty.Value{sampleValue(name, typ.ElementType())})
+	case typ.IsMapType():
+		return cty.MapVal(map[string]cty.Value{"key": sampleValue(name, typ.ElementType())})
+	case typ.IsObjectType():
+		if name == "timeouts" {
+			return cty.NullVal(typ)
+		}
+
+		attributes := make(map[string]cty.Value)
+		for k, v := range typ.AttributeTypes() {
+			attributes[k] = sampleValue(k, v)
+		}
+		if len(attributes) == 0 {
+			return cty.EmptyObjectVal
+		}
+
+		return cty.ObjectVal(attributes)
+	}
+
+	return cty.NullVal(typ)
+}
@@ -0,0 +1,528 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package translate rewrites the bodies of Plugin SDK v2 CRUD handlers as Plugin Framework resource method bodies.
+//
+// Translation is syntactic: common *schema.ResourceData access patterns are rewritten in terms of the
+// Plugin Framework resource's model struct, and anything else is left in place and reported.
+package translate
+
+import (
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"strconv"
+	"strings"
+)
+
+// Type is the type of a top-level attribute's model struct field.
+type Type int
+
+const (
+	Unknown Type = iota
+	String
+	Int64
+	Float64
+	Bool
+	Complex // Lists, sets, maps and nested blocks, converted with AutoFlex.
+)
+
+// Attribute is a top-level attribute of the resource's model struct.
+type Attribute struct {
+	Field string // e.g. RuleName
+	Type  Type
+}
+
+// Options configures a translation.
+type Options struct {
+	Operation  string               // One of Create, Read, Update or Delete.
+	Attributes map[string]Attribute // Keyed by attribute name, e.g. rule_name.
+	AttrConsts map[string]string    // names package constant name to attribute name, e.g. AttrName to name.
+}
+
+// Result is a translated handler body.
+type Result struct {
+	Body  string   // Statements, without enclosing braces.
+	TODOs []string // Constructs that were not translated.
+}
+
+// Func translates the body of the Plugin SDK v2 handler function named funcName in the specified Go source.
+func Func(filename string, src []byte, funcName string, opts Options) (*Result, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	var decl *ast.FuncDecl
+	for _, v := range file.Decls {
+		if v, ok := v.(*ast.FuncDecl); ok && v.Recv == nil && v.Name.Name == funcName {
+			decl = v
+			break
+		}
+	}
+	if decl == nil || decl.Body == nil {
+		return nil, fmt.Errorf("function %s not found in %s", funcName, filename)
+	}
+
+	// Plugin SDK v2 handlers have the signature (ctx context.Context, d *schema.ResourceData, meta interface{}).
+	var params []string
+	for _, field := range decl.Type.Params.List {
+		for _, name := range field.Names {
+			params = append(params, name.Name)
+		}
+	}
+	if len(params) != 3 {
+		return nil, fmt.Errorf("function %s is not a Plugin SDK v2 CRUD handler", funcName)
+	}
+
+	t := &translator{
+		fset:  fset,
+		src:   src,
+		opts:  opts,
+		d:     params[1],
+		meta:  params[2],
+		model: "data",
+		bound: make(map[string]Attribute),
+	}
+	if opts.Operation == "Update" {
+		t.model = "new"
+	}
+	if n := len(decl.Body.List); n > 0 {
+		t.last = decl.Body.List[n-1]
+	}
+
+	body := t.rewrite(decl.Body)
+	body = strings.TrimSpace(body[1 : len(body)-1])
+
+	// Validate and tidy the result.
+	wrapped := "package p\nfunc f() {\n" + body + "\n}\n"
+	formatted, err := format.Source([]byte(wrapped))
+	if err != nil {
+		return nil, fmt.Errorf("translating %s: %w", funcName, err)
+	}
+	body = string(formatted)
+	body = body[strings.Index(body, "{\n")+2 : strings.LastIndex(body, "}")]
+
+	return &Result{Body: strings.TrimRight(body, "\n"), TODOs: t.todos}, nil
+}
+
+type translator struct {
+	fset  *token.FileSet
+	src   []byte
+	opts  Options
+	d     string // Name of the *schema.ResourceData parameter.
+	meta  string // Name of the meta parameter.
+	model string // Name of the model struct variable.
+	last  ast.Stmt
+	bound map[string]Attribute // Variables bound to attributes by d.GetOk.
+	depth int                  // Function literal nesting depth.
+	todos []string
+}
+
+func (t *translator) offset(pos token.Pos) int {
+	return t.fset.Position(pos).Offset
+}
+
+func (t *translator) text(n ast.Node) string {
+	return string(t.src[t.offset(n.Pos()):t.offset(n.End())])
+}
+
+func (t *translator) todo(n ast.Node, format string, a ...any) {
+	t.todos = append(t.todos, fmt.Sprintf("line %d: %s", t.fset.Position(n.Pos()).Line, fmt.Sprintf(format, a...)))
+}
+
+// rewrite returns the source of n with any translatable descendants rewritten.
+func (t *translator) rewrite(n ast.Node) string {
+	var sb strings.Builder
+	last := t.offset(n.Pos())
+
+	ast.Inspect(n, func(c ast.Node) bool {
+		if c == nil || c == n {
+			return true
+		}
+
+		if s, ok := t.translate(c); ok {
+			sb.Write(t.src[last:t.offset(c.Pos())])
+			sb.WriteString(s)
+			last = t.offset(c.End())
+			return false
+		}
+
+		return true
+	})
+
+	sb.Write(t.src[last:t.offset(n.End())])
+
+	return sb.String()
+}
+
+// expr returns the source of n, translated if it is a recognized pattern.
+func (t *translator) expr(n ast.Node) string {
+	if s, ok := t.translate(n); ok {
+		return s
+	}
+
+	return t.rewrite(n)
+}
+
+// translate returns the translation of a node, if it is a recognized pattern.
+func (t *translator) translate(n ast.Node) (string, bool) {
+	switch n := n.(type) {
+	case *ast.DeclStmt:
+		// var diags diag.Diagnostics
+		if decl, ok := n.Decl.(*ast.GenDecl); ok && decl.Tok == token.VAR && len(decl.Specs) == 1 {
+			if spec, ok := decl.Specs[0].(*ast.ValueSpec); ok && len(spec.Values) == 0 && t.text(spec.Type) == "diag.Diagnostics" {
+				return "", true
+			}
+		}
+
+	case *ast.FuncLit:
+		// Returns within function literals are not the handler's.
+		t.depth++
+		defer func() { t.depth-- }()
+		return t.rewrite(n), true
+
+	case *ast.ReturnStmt:
+		if t.depth > 0 {
+			return "", false
+		}
+		return t.returnStmt(n)
+
+	case *ast.ExprStmt:
+		if call, ok := t.dCall(n.X); ok {
+			switch call.Fun.(*ast.SelectorExpr).Sel.Name {
+			case "Set":
+				return t.set(call)
+			case "SetId":
+				return t.setID(call)
+			}
+		}
+
+	case *ast.IfStmt:
+		// if err := d.Set(...); err != nil { ... }
+		if assign, ok := n.Init.(*ast.AssignStmt); ok && n.Else == nil && len(assign.Rhs) == 1 {
+			if call, ok := t.dCall(assign.Rhs[0]); ok && call.Fun.(*ast.SelectorExpr).Sel.Name == "Set" {
+				return t.set(call)
+			}
+		}
+
+	case *ast.AssignStmt:
+		// v, ok := d.GetOk(...)
+		if len(n.Lhs) == 2 && len(n.Rhs) == 1 {
+			if call, ok := t.dCall(n.Rhs[0]); ok && call.Fun.(*ast.SelectorExpr).Sel.Name == "GetOk" {
+				a, ok := t.attribute(call.Args[0])
+				if !ok {
+					return "", false
+				}
+
+				if v, ok := n.Lhs[0].(*ast.Ident); ok {
+					t.bound[v.Name] = a
+				}
+				field := t.model + "." + a.Field
+				return fmt.Sprintf("%s, %s %s %s, !%s.IsNull()", t.text(n.Lhs[0]), t.text(n.Lhs[1]), n.Tok, field, field), true
+			}
+		}
+
+	case *ast.BinaryExpr:
+		// !d.IsNewResource() && tfresource.NotFound(err)
+		if n.Op == token.LAND {
+			if u, ok := n.X.(*ast.UnaryExpr); ok && u.Op == token.NOT {
+				if call, ok := t.dCall(u.X); ok && call.Fun.(*ast.SelectorExpr).Sel.Name == "IsNewResource" {
+					return t.expr(n.Y), true
+				}
+			}
+		}
+
+	case *ast.TypeAssertExpr:
+		// meta.(*conns.AWSClient)
+		if ident, ok := n.X.(*ast.Ident); ok && ident.Name == t.meta {
+			return "r.Meta()", true
+		}
+
+		// d.Get("name").(string)
+		if call, ok := t.dCall(n.X); ok && call.Fun.(*ast.SelectorExpr).Sel.Name == "Get" {
+			a, ok := t.attribute(call.Args[0])
+			if !ok {
+				return "", false
+			}
+
+			return t.value(n, t.model+"."+a.Field, a), true
+		}
+
+		// v.(string), where v, ok := d.GetOk("name")
+		if ident, ok := n.X.(*ast.Ident); ok {
+			if a, ok := t.bound[ident.Name]; ok {
+				return t.value(n, ident.Name, a), true
+			}
+		}
+
+	case *ast.CallExpr:
+		return t.call(n)
+	}
+
+	return "", false
+}
+
+// value returns the Go value of a model struct field asserted to a Plugin SDK v2 type.
+func (t *translator) value(n *ast.TypeAssertExpr, field string, a Attribute) string {
+	switch typ := t.text(n.Type); {
+	case typ == "string" && a.Type == String:
+		return field + ".ValueString()"
+	case typ == "int" && a.Type == Int64:
+		return "int(" + field + ".ValueInt64())"
+	case typ == "bool" && a.Type == Bool:
+		return field + ".ValueBool()"
+	case typ == "float64" && a.Type == Float64:
+		return field + ".ValueFloat64()"
+	default:
+		t.todo(n, "%s is %s; use fwflex.Expand", field, typ)
+		return field
+	}
+}
+
+func (t *translator) call(n *ast.CallExpr) (string, bool) {
+	call, ok := t.dCall(n)
+	if !ok {
+		return "", false
+	}
+
+	switch name := call.Fun.(*ast.SelectorExpr).Sel.Name; name {
+	case "Id":
+		return t.model + ".ID.ValueString()", true
+
+	case "Get":
+		a, ok := t.attribute(call.Args[0])
+		if !ok {
+			return "", false
+		}
+		t.todo(n, "%s.Get(%s) used without a type assertion", t.d, t.text(call.Args[0]))
+		return t.model + "." + a.Field, true
+
+	case "HasChange", "HasChanges":
+		var changes []string
+		for _, arg := range call.Args {
+			a, ok := t.attribute(arg)
+			if !ok {
+				return "", false
+			}
+			changes = append(changes, fmt.Sprintf("!new.%[1]s.Equal(old.%[1]s)", a.Field))
+		}
+		if len(changes) == 1 {
+			return changes[0], true
+		}
+		return "(" + strings.Join(changes, " || ") + ")", true
+
+	case "Timeout":
+		if v, ok := strings.CutPrefix(t.text(call.Args[0]), "schema.Timeout"); ok {
+			return fmt.Sprintf("r.%sTimeout(ctx, %s.Timeouts)", v, t.model), true
+		}
+	}
+
+	t.todo(n, "%s not translated", t.text(n.Fun))
+
+	return "", false
+}
+
+// set translates d.Set(k, v).
+func (t *translator) set(call *ast.CallExpr) (string, bool) {
+	a, ok := t.attribute(call.Args[0])
+	if !ok {
+		return "", false
+	}
+
+	field := t.model + "." + a.Field
+	v := call.Args[1]
+	arg := t.expr(v)
+
+	// d.Set("name", d.Id())
+	if c, ok := t.dCall(v); ok && c.Fun.(*ast.SelectorExpr).Sel.Name == "Id" && a.Type == String {
+		return fmt.Sprintf("%s = %s.ID", field, t.model), true
+	}
+
+	// aws.ToString(x) etc. dereference an AWS SDK pointer.
+	pointer := ""
+	if c, ok := v.(*ast.CallExpr); ok && len(c.Args) == 1 {
+		if fun := t.text(c.Fun); strings.HasPrefix(fun, "aws.To") {
+			pointer = t.expr(c.Args[0])
+		}
+	}
+	_, isIdent := v.(*ast.Ident)
+
+	switch a.Type {
+	case String:
+		switch {
+		case pointer != "":
+			return fmt.Sprintf("%s = fwflex.StringToFramework(ctx, %s)", field, pointer), true
+		case isIdent:
+			return fmt.Sprintf("%s = fwflex.StringValueToFramework(ctx, %s)", field, arg), true
+		default:
+			return fmt.Sprintf("%s = fwflex.StringToFramework(ctx, %s)", field, arg), true
+		}
+
+	case Int64:
+		switch {
+		case pointer != "":
+			return fmt.Sprintf("%s = fwflex.%sToFramework(ctx, %s)", field, strings.TrimPrefix(t.text(v.(*ast.CallExpr).Fun), "aws.To"), pointer), true
+		case isIdent:
+			return fmt.Sprintf("%s = types.Int64Value(int64(%s))", field, arg), true
+		default:
+			return fmt.Sprintf("%s = fwflex.Int64ToFramework(ctx, %s)", field, arg), true
+		}
+
+	case Bool:
+		switch {
+		case pointer != "":
+			return fmt.Sprintf("%s = fwflex.BoolToFramework(ctx, %s)", field, pointer), true
+		case isIdent:
+			return fmt.Sprintf("%s = types.BoolValue(%s)", field, arg), true
+		default:
+			return fmt.Sprintf("%s = fwflex.BoolToFramework(ctx, %s)", field, arg), true
+		}
+
+	case Float64:
+		switch {
+		case pointer != "":
+			return fmt.Sprintf("%s = fwflex.Float64ToFramework(ctx, %s)", field, pointer), true
+		case isIdent:
+			return fmt.Sprintf("%s = types.Float64Value(%s)", field, arg), true
+		default:
+			return fmt.Sprintf("%s = fwflex.Float64ToFramework(ctx, %s)", field, arg), true
+		}
+
+	case Complex:
+		// d.Set("x", flattenX(v)) becomes fwflex.Flatten(ctx, v, &data.X).
+		if c, ok := v.(*ast.CallExpr); ok && len(c.Args) == 1 {
+			if fun, ok := c.Fun.(*ast.Ident); ok && strings.HasPrefix(fun.Name, "flatten") {
+				arg = t.expr(c.Args[0])
+			}
+		}
+		return fmt.Sprintf("response.Diagnostics.Append(fwflex.Flatten(ctx, %s, &%s)...)", arg, field), true
+	}
+
+	return "", false
+}
+
+// setID translates d.SetId(v).
+func (t *translator) setID(call *ast.CallExpr) (string, bool) {
+	if lit, ok := call.Args[0].(*ast.BasicLit); ok && lit.Value == `""` {
+		return "response.State.RemoveResource(ctx)", true
+	}
+
+	return fmt.Sprintf("%s.ID = types.StringValue(%s)", t.model, t.expr(call.Args[0])), true
+}
+
+func (t *translator) returnStmt(n *ast.ReturnStmt) (string, bool) {
+	ret := "return"
+	if n == t.last {
+		// Fall through to the setting of state.
+		ret = ""
+	}
+
+	if len(n.Results) != 1 {
+		return "", false
+	}
+
+	switch v := n.Results[0].(type) {
+	case *ast.Ident:
+		// return diags
+		if v.Name == "diags" || v.Name == "nil" {
+			return ret, true
+		}
+
+	case *ast.CallExpr:
+		fun := t.text(v.Fun)
+
+		switch {
+		case fun == "append" && v.Ellipsis.IsValid() && len(v.Args) == 2 && t.text(v.Args[0]) == "diags":
+			// return append(diags, resourceXRead(ctx, d, meta)...)
+			return ret, true
+
+		case fun == "sdkdiag.AppendErrorf" && len(v.Args) >= 3:
+			// return sdkdiag.AppendErrorf(diags, "creating X (%s): %s", id, err)
+			format, err := strconv.Unquote(t.text(v.Args[1]))
+			if err != nil {
+				return "", false
+			}
+			args := v.Args[2:]
+			format = strings.TrimSuffix(strings.TrimSuffix(format, ": %s"), ": %w")
+			var summary string
+			if len(args) == 1 {
+				summary = strconv.Quote(format)
+			} else {
+				var list []string
+				for _, arg := range args[:len(args)-1] {
+					list = append(list, t.expr(arg))
+				}
+				summary = fmt.Sprintf("fmt.Sprintf(%s, %s)", strconv.Quote(format), strings.Join(list, ", "))
+			}
+			return fmt.Sprintf("response.Diagnostics.AddError(%s, %s.Error())\n\n%s", summary, t.expr(args[len(args)-1]), ret), true
+
+		case fun == "create.AppendDiagError" && len(v.Args) == 6:
+			// return create.AppendDiagError(diags, names.X, create.ErrActionCreating, ResNameX, id, err)
+			var list []string
+			for _, arg := range v.Args[1:5] {
+				list = append(list, t.expr(arg))
+			}
+			return fmt.Sprintf("response.Diagnostics.AddError(create.ProblemStandardMessage(%s, nil), %s.Error())\n\n%s", strings.Join(list, ", "), t.expr(v.Args[5]), ret), true
+
+		case (fun == "sdkdiag.AppendFromErr" || fun == "diag.FromErr") && len(v.Args) >= 1:
+			err := v.Args[len(v.Args)-1]
+			return fmt.Sprintf("response.Diagnostics.AddError(%q, %s.Error())\n\n%s", strings.ToLower(t.opts.Operation), t.expr(err), ret), true
+		}
+	}
+
+	return "", false
+}
+
+// dCall returns the call expression if e is a method call on the *schema.ResourceData parameter.
+func (t *translator) dCall(e ast.Expr) (*ast.CallExpr, bool) {
+	call, ok := e.(*ast.CallExpr)
+	if !ok {
+		return nil, false
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return nil, false
+	}
+	ident, ok := sel.X.(*ast.Ident)
+	if !ok || ident.Name != t.d {
+		return nil, false
+	}
+
+	switch sel.Sel.Name {
+	case "Get", "GetOk", "Set", "HasChange":
+		if len(call.Args) < 1 {
+			return nil, false
+		}
+	}
+
+	return call, true
+}
+
+// attribute returns the top-level attribute named by a d.Get, d.Set etc. key argument.
+func (t *translator) attribute(e ast.Expr) (Attribute, bool) {
+	var name string
+
+	switch e := e.(type) {
+	case *ast.BasicLit:
+		v, err := strconv.Unquote(e.Value)
+		if err != nil {
+			return Attribute{}, false
+		}
+		name = v
+	case *ast.SelectorExpr:
+		if pkg, ok := e.X.(*ast.Ident); ok && pkg.Name == "names" {
+			name = t.opts.AttrConsts[e.Sel.Name]
+		}
+	}
+
+	a, ok := t.opts.Attributes[name]
+	if !ok {
+		t.todo(e, "%s is not a top-level attribute", t.text(e))
+	}
+
+	return a, ok
+}
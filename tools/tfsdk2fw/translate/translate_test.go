@@ -0,0 +1,212 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package translate
+
+import (
+	"strings"
+	"testing"
+)
+
+const testSource = `package example
+
+func resourceExampleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ExampleClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &example.CreateExampleInput{
+		Name: aws.String(name),
+		Size: aws.Int32(int32(d.Get("size").(int))),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("rule"); ok {
+		input.Rules = expandRules(v.([]interface{}))
+	}
+
+	output, err := conn.CreateExample(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Example (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.Id))
+
+	if _, err := waitExampleCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Example (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceExampleRead(ctx, d, meta)...)
+}
+
+func resourceExampleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ExampleClient(ctx)
+
+	output, err := findExampleByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Example (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.Example, create.ErrActionReading, ResNameExample, d.Id(), err)
+	}
+
+	d.Set(names.AttrName, output.Name)
+	d.Set("size", aws.ToInt32(output.Size))
+	if err := d.Set("rule", flattenRules(output.Rules)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting rule: %s", err)
+	}
+	d.Set("unknown", output.Unknown)
+
+	return diags
+}
+
+func resourceExampleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ExampleClient(ctx)
+
+	if d.HasChanges(names.AttrDescription, "size") {
+		err := tfresource.Retry(ctx, d.Timeout(schema.TimeoutUpdate), func() *retry.RetryError {
+			_, err := conn.UpdateExample(ctx, &example.UpdateExampleInput{
+				Id:          aws.String(d.Id()),
+				Description: aws.String(d.Get(names.AttrDescription).(string)),
+			})
+
+			if err != nil {
+				return retry.NonRetryableError(err)
+			}
+
+			return nil
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Example (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceExampleRead(ctx, d, meta)...)
+}
+`
+
+func testOptions(operation string) Options {
+	return Options{
+		Operation: operation,
+		Attributes: map[string]Attribute{
+			"description": {Field: "Description", Type: String},
+			"name":        {Field: "Name", Type: String},
+			"rule":        {Field: "Rule", Type: Complex},
+			"size":        {Field: "Size", Type: Int64},
+		},
+		AttrConsts: map[string]string{
+			"AttrDescription": "description",
+			"AttrName":        "name",
+		},
+	}
+}
+
+func TestFunc(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName  string
+		FuncName  string
+		Operation string
+		Expected  []string
+		Absent    []string
+		TODOs     int
+	}{
+		{
+			TestName:  "create",
+			FuncName:  "resourceExampleCreate",
+			Operation: "Create",
+			Expected: []string{
+				"conn := r.Meta().ExampleClient(ctx)",
+				"name := data.Name.ValueString()",
+				"aws.Int32(int32(int(data.Size.ValueInt64())))",
+				"if v, ok := data.Description, !data.Description.IsNull(); ok {",
+				"input.Description = aws.String(v.ValueString())",
+				"input.Rules = expandRules(v)",
+				`response.Diagnostics.AddError(fmt.Sprintf("creating Example (%s)", name), err.Error())`,
+				"data.ID = types.StringValue(aws.ToString(output.Id))",
+				"waitExampleCreated(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))",
+			},
+			Absent: []string{"diags", "resourceExampleRead"},
+			TODOs:  1, // v.([]interface{})
+		},
+		{
+			TestName:  "read",
+			FuncName:  "resourceExampleRead",
+			Operation: "Read",
+			Expected: []string{
+				"if tfresource.NotFound(err) {",
+				"response.State.RemoveResource(ctx)",
+				`response.Diagnostics.AddError(create.ProblemStandardMessage(names.Example, create.ErrActionReading, ResNameExample, data.ID.ValueString(), nil), err.Error())`,
+				"data.Name = fwflex.StringToFramework(ctx, output.Name)",
+				"data.Size = fwflex.Int32ToFramework(ctx, output.Size)",
+				"response.Diagnostics.Append(fwflex.Flatten(ctx, output.Rules, &data.Rule)...)",
+				`d.Set("unknown", output.Unknown)`,
+			},
+			Absent: []string{"diags", "IsNewResource", "setting rule"},
+			TODOs:  2, // "unknown" attribute and d.Set.
+		},
+		{
+			TestName:  "update",
+			FuncName:  "resourceExampleUpdate",
+			Operation: "Update",
+			Expected: []string{
+				"if !new.Description.Equal(old.Description) || !new.Size.Equal(old.Size) {",
+				"tfresource.Retry(ctx, r.UpdateTimeout(ctx, new.Timeouts), func() *retry.RetryError {",
+				"Id:          aws.String(new.ID.ValueString()),",
+				"Description: aws.String(new.Description.ValueString()),",
+				"return retry.NonRetryableError(err)",
+				"return nil",
+				`response.Diagnostics.AddError(fmt.Sprintf("updating Example (%s)", new.ID.ValueString()), err.Error())`,
+			},
+			Absent: []string{"diags"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := Func("example.go", []byte(testSource), testCase.FuncName, testOptions(testCase.Operation))
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			for _, want := range testCase.Expected {
+				if !strings.Contains(result.Body, want) {
+					t.Errorf("body does not contain %q:\n%s", want, result.Body)
+				}
+			}
+			for _, v := range testCase.Absent {
+				if strings.Contains(result.Body, v) {
+					t.Errorf("body contains %q:\n%s", v, result.Body)
+				}
+			}
+			if got, want := len(result.TODOs), testCase.TODOs; got != want {
+				t.Errorf("got %d TODOs, want %d: %v", got, want, result.TODOs)
+			}
+		})
+	}
+}
+
+func TestFuncNotFound(t *testing.T) {
+	t.Parallel()
+
+	if _, err := Func("example.go", []byte(testSource), "resourceExampleDelete", testOptions("Delete")); err == nil {
+		t.Error("expected error")
+	}
+}